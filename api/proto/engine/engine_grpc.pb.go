@@ -30,10 +30,30 @@ const (
 	EngineService_AddToFavorites_FullMethodName             = "/engine.EngineService/AddToFavorites"
 	EngineService_RemoveFromFavorites_FullMethodName        = "/engine.EngineService/RemoveFromFavorites"
 	EngineService_ResendConfirmationCode_FullMethodName     = "/engine.EngineService/ResendConfirmationCode"
+	EngineService_CancelPayout_FullMethodName               = "/engine.EngineService/CancelPayout"
 	EngineService_ReportOperations_FullMethodName           = "/engine.EngineService/ReportOperations"
 	EngineService_GetOperationExternalStatus_FullMethodName = "/engine.EngineService/GetOperationExternalStatus"
 	EngineService_RecoverTool_FullMethodName                = "/engine.EngineService/RecoverTool"
 	EngineService_ChangeOperationStatus_FullMethodName      = "/engine.EngineService/ChangeOperationStatus"
+	EngineService_ReportOperationsAnalytics_FullMethodName  = "/engine.EngineService/ReportOperationsAnalytics"
+	EngineService_Reconcile_FullMethodName                  = "/engine.EngineService/Reconcile"
+	EngineService_ReconciliationRuns_FullMethodName         = "/engine.EngineService/ReconciliationRuns"
+	EngineService_GetReconciliationRun_FullMethodName       = "/engine.EngineService/GetReconciliationRun"
+	EngineService_ReportStatusDrifts_FullMethodName         = "/engine.EngineService/ReportStatusDrifts"
+	EngineService_SchedulerTasks_FullMethodName             = "/engine.EngineService/SchedulerTasks"
+	EngineService_PauseSchedulerTask_FullMethodName         = "/engine.EngineService/PauseSchedulerTask"
+	EngineService_ResumeSchedulerTask_FullMethodName        = "/engine.EngineService/ResumeSchedulerTask"
+	EngineService_TriggerSchedulerTask_FullMethodName       = "/engine.EngineService/TriggerSchedulerTask"
+	EngineService_UpdateSchedulerTask_FullMethodName        = "/engine.EngineService/UpdateSchedulerTask"
+	EngineService_SchedulerTaskRuns_FullMethodName          = "/engine.EngineService/SchedulerTaskRuns"
+	EngineService_DeadLetterOperations_FullMethodName       = "/engine.EngineService/DeadLetterOperations"
+	EngineService_RetryDeadLetterOperation_FullMethodName   = "/engine.EngineService/RetryDeadLetterOperation"
+	EngineService_ResolveDeadLetterOperation_FullMethodName = "/engine.EngineService/ResolveDeadLetterOperation"
+	EngineService_ReportAuditLog_FullMethodName             = "/engine.EngineService/ReportAuditLog"
+	EngineService_ReportBlockList_FullMethodName            = "/engine.EngineService/ReportBlockList"
+	EngineService_CreateBlockEntry_FullMethodName           = "/engine.EngineService/CreateBlockEntry"
+	EngineService_UpdateBlockEntry_FullMethodName           = "/engine.EngineService/UpdateBlockEntry"
+	EngineService_DeleteBlockEntry_FullMethodName           = "/engine.EngineService/DeleteBlockEntry"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -50,10 +70,30 @@ type EngineServiceClient interface {
 	AddToFavorites(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveFromFavorites(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ResendConfirmationCode(ctx context.Context, in *ResendConfirmationCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CancelPayout(ctx context.Context, in *CancelPayoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ReportOperations(ctx context.Context, in *ReportOperationsRequest, opts ...grpc.CallOption) (*ReportOperationsResponse, error)
 	GetOperationExternalStatus(ctx context.Context, in *GetOperationExternalStatusRequest, opts ...grpc.CallOption) (*GetOperationExternalStatusResponse, error)
 	RecoverTool(ctx context.Context, in *RecoverToolRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ChangeOperationStatus(ctx context.Context, in *ChangeOperationStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReportOperationsAnalytics(ctx context.Context, in *ReportOperationsAnalyticsRequest, opts ...grpc.CallOption) (*ReportOperationsAnalyticsResponse, error)
+	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	ReconciliationRuns(ctx context.Context, in *ReconciliationRunsRequest, opts ...grpc.CallOption) (*ReconciliationRunsResponse, error)
+	GetReconciliationRun(ctx context.Context, in *GetReconciliationRunRequest, opts ...grpc.CallOption) (*GetReconciliationRunResponse, error)
+	ReportStatusDrifts(ctx context.Context, in *ReportStatusDriftsRequest, opts ...grpc.CallOption) (*ReportStatusDriftsResponse, error)
+	SchedulerTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SchedulerTasksResponse, error)
+	PauseSchedulerTask(ctx context.Context, in *SchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResumeSchedulerTask(ctx context.Context, in *SchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TriggerSchedulerTask(ctx context.Context, in *SchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateSchedulerTask(ctx context.Context, in *UpdateSchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SchedulerTaskRuns(ctx context.Context, in *SchedulerTaskRunsRequest, opts ...grpc.CallOption) (*SchedulerTaskRunsResponse, error)
+	DeadLetterOperations(ctx context.Context, in *DeadLetterOperationsRequest, opts ...grpc.CallOption) (*DeadLetterOperationsResponse, error)
+	RetryDeadLetterOperation(ctx context.Context, in *RetryDeadLetterOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolveDeadLetterOperation(ctx context.Context, in *ResolveDeadLetterOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReportAuditLog(ctx context.Context, in *ReportAuditLogRequest, opts ...grpc.CallOption) (*ReportAuditLogResponse, error)
+	ReportBlockList(ctx context.Context, in *ReportBlockListRequest, opts ...grpc.CallOption) (*ReportBlockListResponse, error)
+	CreateBlockEntry(ctx context.Context, in *CreateBlockEntryRequest, opts ...grpc.CallOption) (*BlockEntryResponse, error)
+	UpdateBlockEntry(ctx context.Context, in *UpdateBlockEntryRequest, opts ...grpc.CallOption) (*BlockEntryResponse, error)
+	DeleteBlockEntry(ctx context.Context, in *DeleteBlockEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type engineServiceClient struct {
@@ -154,6 +194,15 @@ func (c *engineServiceClient) ResendConfirmationCode(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *engineServiceClient) CancelPayout(ctx context.Context, in *CancelPayoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_CancelPayout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) ReportOperations(ctx context.Context, in *ReportOperationsRequest, opts ...grpc.CallOption) (*ReportOperationsResponse, error) {
 	out := new(ReportOperationsResponse)
 	err := c.cc.Invoke(ctx, EngineService_ReportOperations_FullMethodName, in, out, opts...)
@@ -190,6 +239,177 @@ func (c *engineServiceClient) ChangeOperationStatus(ctx context.Context, in *Cha
 	return out, nil
 }
 
+func (c *engineServiceClient) ReportOperationsAnalytics(ctx context.Context, in *ReportOperationsAnalyticsRequest, opts ...grpc.CallOption) (*ReportOperationsAnalyticsResponse, error) {
+	out := new(ReportOperationsAnalyticsResponse)
+	err := c.cc.Invoke(ctx, EngineService_ReportOperationsAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error) {
+	out := new(ReconcileResponse)
+	err := c.cc.Invoke(ctx, EngineService_Reconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ReconciliationRuns(ctx context.Context, in *ReconciliationRunsRequest, opts ...grpc.CallOption) (*ReconciliationRunsResponse, error) {
+	out := new(ReconciliationRunsResponse)
+	err := c.cc.Invoke(ctx, EngineService_ReconciliationRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetReconciliationRun(ctx context.Context, in *GetReconciliationRunRequest, opts ...grpc.CallOption) (*GetReconciliationRunResponse, error) {
+	out := new(GetReconciliationRunResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetReconciliationRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ReportStatusDrifts(ctx context.Context, in *ReportStatusDriftsRequest, opts ...grpc.CallOption) (*ReportStatusDriftsResponse, error) {
+	out := new(ReportStatusDriftsResponse)
+	err := c.cc.Invoke(ctx, EngineService_ReportStatusDrifts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) SchedulerTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SchedulerTasksResponse, error) {
+	out := new(SchedulerTasksResponse)
+	err := c.cc.Invoke(ctx, EngineService_SchedulerTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) PauseSchedulerTask(ctx context.Context, in *SchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_PauseSchedulerTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ResumeSchedulerTask(ctx context.Context, in *SchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_ResumeSchedulerTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) TriggerSchedulerTask(ctx context.Context, in *SchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_TriggerSchedulerTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) UpdateSchedulerTask(ctx context.Context, in *UpdateSchedulerTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_UpdateSchedulerTask_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) SchedulerTaskRuns(ctx context.Context, in *SchedulerTaskRunsRequest, opts ...grpc.CallOption) (*SchedulerTaskRunsResponse, error) {
+	out := new(SchedulerTaskRunsResponse)
+	err := c.cc.Invoke(ctx, EngineService_SchedulerTaskRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) DeadLetterOperations(ctx context.Context, in *DeadLetterOperationsRequest, opts ...grpc.CallOption) (*DeadLetterOperationsResponse, error) {
+	out := new(DeadLetterOperationsResponse)
+	err := c.cc.Invoke(ctx, EngineService_DeadLetterOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) RetryDeadLetterOperation(ctx context.Context, in *RetryDeadLetterOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_RetryDeadLetterOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ResolveDeadLetterOperation(ctx context.Context, in *ResolveDeadLetterOperationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_ResolveDeadLetterOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ReportAuditLog(ctx context.Context, in *ReportAuditLogRequest, opts ...grpc.CallOption) (*ReportAuditLogResponse, error) {
+	out := new(ReportAuditLogResponse)
+	err := c.cc.Invoke(ctx, EngineService_ReportAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ReportBlockList(ctx context.Context, in *ReportBlockListRequest, opts ...grpc.CallOption) (*ReportBlockListResponse, error) {
+	out := new(ReportBlockListResponse)
+	err := c.cc.Invoke(ctx, EngineService_ReportBlockList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) CreateBlockEntry(ctx context.Context, in *CreateBlockEntryRequest, opts ...grpc.CallOption) (*BlockEntryResponse, error) {
+	out := new(BlockEntryResponse)
+	err := c.cc.Invoke(ctx, EngineService_CreateBlockEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) UpdateBlockEntry(ctx context.Context, in *UpdateBlockEntryRequest, opts ...grpc.CallOption) (*BlockEntryResponse, error) {
+	out := new(BlockEntryResponse)
+	err := c.cc.Invoke(ctx, EngineService_UpdateBlockEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) DeleteBlockEntry(ctx context.Context, in *DeleteBlockEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_DeleteBlockEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations must embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -204,10 +424,30 @@ type EngineServiceServer interface {
 	AddToFavorites(context.Context, *FavoritesRequest) (*emptypb.Empty, error)
 	RemoveFromFavorites(context.Context, *FavoritesRequest) (*emptypb.Empty, error)
 	ResendConfirmationCode(context.Context, *ResendConfirmationCodeRequest) (*emptypb.Empty, error)
+	CancelPayout(context.Context, *CancelPayoutRequest) (*emptypb.Empty, error)
 	ReportOperations(context.Context, *ReportOperationsRequest) (*ReportOperationsResponse, error)
 	GetOperationExternalStatus(context.Context, *GetOperationExternalStatusRequest) (*GetOperationExternalStatusResponse, error)
 	RecoverTool(context.Context, *RecoverToolRequest) (*emptypb.Empty, error)
 	ChangeOperationStatus(context.Context, *ChangeOperationStatusRequest) (*emptypb.Empty, error)
+	ReportOperationsAnalytics(context.Context, *ReportOperationsAnalyticsRequest) (*ReportOperationsAnalyticsResponse, error)
+	Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	ReconciliationRuns(context.Context, *ReconciliationRunsRequest) (*ReconciliationRunsResponse, error)
+	GetReconciliationRun(context.Context, *GetReconciliationRunRequest) (*GetReconciliationRunResponse, error)
+	ReportStatusDrifts(context.Context, *ReportStatusDriftsRequest) (*ReportStatusDriftsResponse, error)
+	SchedulerTasks(context.Context, *emptypb.Empty) (*SchedulerTasksResponse, error)
+	PauseSchedulerTask(context.Context, *SchedulerTaskRequest) (*emptypb.Empty, error)
+	ResumeSchedulerTask(context.Context, *SchedulerTaskRequest) (*emptypb.Empty, error)
+	TriggerSchedulerTask(context.Context, *SchedulerTaskRequest) (*emptypb.Empty, error)
+	UpdateSchedulerTask(context.Context, *UpdateSchedulerTaskRequest) (*emptypb.Empty, error)
+	SchedulerTaskRuns(context.Context, *SchedulerTaskRunsRequest) (*SchedulerTaskRunsResponse, error)
+	DeadLetterOperations(context.Context, *DeadLetterOperationsRequest) (*DeadLetterOperationsResponse, error)
+	RetryDeadLetterOperation(context.Context, *RetryDeadLetterOperationRequest) (*emptypb.Empty, error)
+	ResolveDeadLetterOperation(context.Context, *ResolveDeadLetterOperationRequest) (*emptypb.Empty, error)
+	ReportAuditLog(context.Context, *ReportAuditLogRequest) (*ReportAuditLogResponse, error)
+	ReportBlockList(context.Context, *ReportBlockListRequest) (*ReportBlockListResponse, error)
+	CreateBlockEntry(context.Context, *CreateBlockEntryRequest) (*BlockEntryResponse, error)
+	UpdateBlockEntry(context.Context, *UpdateBlockEntryRequest) (*BlockEntryResponse, error)
+	DeleteBlockEntry(context.Context, *DeleteBlockEntryRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedEngineServiceServer()
 }
 
@@ -245,6 +485,9 @@ func (UnimplementedEngineServiceServer) RemoveFromFavorites(context.Context, *Fa
 func (UnimplementedEngineServiceServer) ResendConfirmationCode(context.Context, *ResendConfirmationCodeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendConfirmationCode not implemented")
 }
+func (UnimplementedEngineServiceServer) CancelPayout(context.Context, *CancelPayoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayout not implemented")
+}
 func (UnimplementedEngineServiceServer) ReportOperations(context.Context, *ReportOperationsRequest) (*ReportOperationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportOperations not implemented")
 }
@@ -257,6 +500,63 @@ func (UnimplementedEngineServiceServer) RecoverTool(context.Context, *RecoverToo
 func (UnimplementedEngineServiceServer) ChangeOperationStatus(context.Context, *ChangeOperationStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeOperationStatus not implemented")
 }
+func (UnimplementedEngineServiceServer) ReportOperationsAnalytics(context.Context, *ReportOperationsAnalyticsRequest) (*ReportOperationsAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportOperationsAnalytics not implemented")
+}
+func (UnimplementedEngineServiceServer) Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedEngineServiceServer) ReconciliationRuns(context.Context, *ReconciliationRunsRequest) (*ReconciliationRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconciliationRuns not implemented")
+}
+func (UnimplementedEngineServiceServer) GetReconciliationRun(context.Context, *GetReconciliationRunRequest) (*GetReconciliationRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationRun not implemented")
+}
+func (UnimplementedEngineServiceServer) ReportStatusDrifts(context.Context, *ReportStatusDriftsRequest) (*ReportStatusDriftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportStatusDrifts not implemented")
+}
+func (UnimplementedEngineServiceServer) SchedulerTasks(context.Context, *emptypb.Empty) (*SchedulerTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulerTasks not implemented")
+}
+func (UnimplementedEngineServiceServer) PauseSchedulerTask(context.Context, *SchedulerTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedulerTask not implemented")
+}
+func (UnimplementedEngineServiceServer) ResumeSchedulerTask(context.Context, *SchedulerTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedulerTask not implemented")
+}
+func (UnimplementedEngineServiceServer) TriggerSchedulerTask(context.Context, *SchedulerTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerSchedulerTask not implemented")
+}
+func (UnimplementedEngineServiceServer) UpdateSchedulerTask(context.Context, *UpdateSchedulerTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedulerTask not implemented")
+}
+func (UnimplementedEngineServiceServer) SchedulerTaskRuns(context.Context, *SchedulerTaskRunsRequest) (*SchedulerTaskRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulerTaskRuns not implemented")
+}
+func (UnimplementedEngineServiceServer) DeadLetterOperations(context.Context, *DeadLetterOperationsRequest) (*DeadLetterOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetterOperations not implemented")
+}
+func (UnimplementedEngineServiceServer) RetryDeadLetterOperation(context.Context, *RetryDeadLetterOperationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeadLetterOperation not implemented")
+}
+func (UnimplementedEngineServiceServer) ResolveDeadLetterOperation(context.Context, *ResolveDeadLetterOperationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDeadLetterOperation not implemented")
+}
+func (UnimplementedEngineServiceServer) ReportAuditLog(context.Context, *ReportAuditLogRequest) (*ReportAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportAuditLog not implemented")
+}
+func (UnimplementedEngineServiceServer) ReportBlockList(context.Context, *ReportBlockListRequest) (*ReportBlockListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportBlockList not implemented")
+}
+func (UnimplementedEngineServiceServer) CreateBlockEntry(context.Context, *CreateBlockEntryRequest) (*BlockEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlockEntry not implemented")
+}
+func (UnimplementedEngineServiceServer) UpdateBlockEntry(context.Context, *UpdateBlockEntryRequest) (*BlockEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockEntry not implemented")
+}
+func (UnimplementedEngineServiceServer) DeleteBlockEntry(context.Context, *DeleteBlockEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlockEntry not implemented")
+}
 func (UnimplementedEngineServiceServer) mustEmbedUnimplementedEngineServiceServer() {}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -450,6 +750,24 @@ func _EngineService_ResendConfirmationCode_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_CancelPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CancelPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_CancelPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CancelPayout(ctx, req.(*CancelPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_ReportOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportOperationsRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +840,348 @@ func _EngineService_ChangeOperationStatus_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_ReportOperationsAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportOperationsAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ReportOperationsAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ReportOperationsAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ReportOperationsAnalytics(ctx, req.(*ReportOperationsAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_Reconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).Reconcile(ctx, req.(*ReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ReconciliationRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconciliationRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ReconciliationRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ReconciliationRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ReconciliationRuns(ctx, req.(*ReconciliationRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetReconciliationRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetReconciliationRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetReconciliationRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetReconciliationRun(ctx, req.(*GetReconciliationRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ReportStatusDrifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportStatusDriftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ReportStatusDrifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ReportStatusDrifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ReportStatusDrifts(ctx, req.(*ReportStatusDriftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_SchedulerTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).SchedulerTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_SchedulerTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).SchedulerTasks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_PauseSchedulerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).PauseSchedulerTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_PauseSchedulerTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).PauseSchedulerTask(ctx, req.(*SchedulerTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ResumeSchedulerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ResumeSchedulerTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ResumeSchedulerTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ResumeSchedulerTask(ctx, req.(*SchedulerTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_TriggerSchedulerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).TriggerSchedulerTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_TriggerSchedulerTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).TriggerSchedulerTask(ctx, req.(*SchedulerTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_UpdateSchedulerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchedulerTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).UpdateSchedulerTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_UpdateSchedulerTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).UpdateSchedulerTask(ctx, req.(*UpdateSchedulerTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_SchedulerTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulerTaskRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).SchedulerTaskRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_SchedulerTaskRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).SchedulerTaskRuns(ctx, req.(*SchedulerTaskRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_DeadLetterOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeadLetterOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).DeadLetterOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_DeadLetterOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).DeadLetterOperations(ctx, req.(*DeadLetterOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_RetryDeadLetterOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeadLetterOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).RetryDeadLetterOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_RetryDeadLetterOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).RetryDeadLetterOperation(ctx, req.(*RetryDeadLetterOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ResolveDeadLetterOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDeadLetterOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ResolveDeadLetterOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ResolveDeadLetterOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ResolveDeadLetterOperation(ctx, req.(*ResolveDeadLetterOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ReportAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ReportAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ReportAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ReportAuditLog(ctx, req.(*ReportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ReportBlockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportBlockListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ReportBlockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ReportBlockList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ReportBlockList(ctx, req.(*ReportBlockListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_CreateBlockEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlockEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CreateBlockEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_CreateBlockEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CreateBlockEntry(ctx, req.(*CreateBlockEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_UpdateBlockEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBlockEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).UpdateBlockEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_UpdateBlockEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).UpdateBlockEntry(ctx, req.(*UpdateBlockEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_DeleteBlockEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlockEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).DeleteBlockEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_DeleteBlockEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).DeleteBlockEntry(ctx, req.(*DeleteBlockEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -569,6 +1229,10 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResendConfirmationCode",
 			Handler:    _EngineService_ResendConfirmationCode_Handler,
 		},
+		{
+			MethodName: "CancelPayout",
+			Handler:    _EngineService_CancelPayout_Handler,
+		},
 		{
 			MethodName: "ReportOperations",
 			Handler:    _EngineService_ReportOperations_Handler,
@@ -585,6 +1249,82 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeOperationStatus",
 			Handler:    _EngineService_ChangeOperationStatus_Handler,
 		},
+		{
+			MethodName: "ReportOperationsAnalytics",
+			Handler:    _EngineService_ReportOperationsAnalytics_Handler,
+		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _EngineService_Reconcile_Handler,
+		},
+		{
+			MethodName: "ReconciliationRuns",
+			Handler:    _EngineService_ReconciliationRuns_Handler,
+		},
+		{
+			MethodName: "GetReconciliationRun",
+			Handler:    _EngineService_GetReconciliationRun_Handler,
+		},
+		{
+			MethodName: "ReportStatusDrifts",
+			Handler:    _EngineService_ReportStatusDrifts_Handler,
+		},
+		{
+			MethodName: "SchedulerTasks",
+			Handler:    _EngineService_SchedulerTasks_Handler,
+		},
+		{
+			MethodName: "PauseSchedulerTask",
+			Handler:    _EngineService_PauseSchedulerTask_Handler,
+		},
+		{
+			MethodName: "ResumeSchedulerTask",
+			Handler:    _EngineService_ResumeSchedulerTask_Handler,
+		},
+		{
+			MethodName: "TriggerSchedulerTask",
+			Handler:    _EngineService_TriggerSchedulerTask_Handler,
+		},
+		{
+			MethodName: "UpdateSchedulerTask",
+			Handler:    _EngineService_UpdateSchedulerTask_Handler,
+		},
+		{
+			MethodName: "SchedulerTaskRuns",
+			Handler:    _EngineService_SchedulerTaskRuns_Handler,
+		},
+		{
+			MethodName: "DeadLetterOperations",
+			Handler:    _EngineService_DeadLetterOperations_Handler,
+		},
+		{
+			MethodName: "RetryDeadLetterOperation",
+			Handler:    _EngineService_RetryDeadLetterOperation_Handler,
+		},
+		{
+			MethodName: "ResolveDeadLetterOperation",
+			Handler:    _EngineService_ResolveDeadLetterOperation_Handler,
+		},
+		{
+			MethodName: "ReportAuditLog",
+			Handler:    _EngineService_ReportAuditLog_Handler,
+		},
+		{
+			MethodName: "ReportBlockList",
+			Handler:    _EngineService_ReportBlockList_Handler,
+		},
+		{
+			MethodName: "CreateBlockEntry",
+			Handler:    _EngineService_CreateBlockEntry_Handler,
+		},
+		{
+			MethodName: "UpdateBlockEntry",
+			Handler:    _EngineService_UpdateBlockEntry_Handler,
+		},
+		{
+			MethodName: "DeleteBlockEntry",
+			Handler:    _EngineService_DeleteBlockEntry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/engine/engine.proto",
@@ -421,6 +421,57 @@ const docTemplate = `{
                 }
             }
         },
+        "/payout/{id}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Выплаты"
+                ],
+                "summary": "Отменить неподтвержденный запрос на вывод средств",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.payoutCancelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.payoutCancelResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/payout/{id}/confirm": {
             "put": {
                 "security": [
@@ -1127,6 +1178,44 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.payoutCancelRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии клиента",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор клиента",
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "v1.payoutCancelResponse": {
+            "type": "object",
+            "required": [
+                "success"
+            ],
+            "properties": {
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
         "v1.payoutConfirmRequest": {
             "type": "object",
             "required": [
@@ -1247,10 +1336,16 @@ const docTemplate = `{
         "v1.payoutCreateResponse": {
             "type": "object",
             "required": [
+                "confirmation_expires_at",
                 "operation_id",
                 "success"
             ],
             "properties": {
+                "confirmation_expires_at": {
+                    "description": "Время в формате UNIX Timestamp, до которого необходимо подтвердить выплату",
+                    "type": "integer",
+                    "example": 1716974447
+                },
                 "operation_id": {
                     "description": "Идентификатор созданной выплаты",
                     "type": "integer",
@@ -1345,6 +1440,16 @@ const docTemplate = `{
                         }
                     ]
                 },
+                "expired": {
+                    "description": "Флаг, что срок действия платежного средства истек и его нельзя использовать для операций",
+                    "type": "boolean",
+                    "example": false
+                },
+                "expires_at": {
+                    "description": "Время окончания срока действия платежного средства в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1730419200
+                },
                 "external_method": {
                     "description": "Внутренний код платежного метода платежной системы, к которой относится платежное средство",
                     "type": "string",
@@ -1375,6 +1480,16 @@ const docTemplate = `{
                     "type": "string",
                     "example": "Sberbank"
                 },
+                "card_brand": {
+                    "description": "Платежная система банковской карты по справочнику BIN",
+                    "type": "string",
+                    "example": "VISA"
+                },
+                "card_funding": {
+                    "description": "Тип банковской карты по источнику средств: \"debit\", \"credit\" или \"prepaid\"",
+                    "type": "string",
+                    "example": "debit"
+                },
                 "card_holder": {
                     "description": "Владелец банковской карты",
                     "type": "string",
@@ -1395,6 +1510,11 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 2023
                 },
+                "issuer_country": {
+                    "description": "Страна банка, выпустившего банковскую карту, код по ISO 3166-1 alpha-2",
+                    "type": "string",
+                    "example": "RU"
+                },
                 "wallet_number": {
                     "description": "Номер электронного кошелька",
                     "type": "string",
@@ -1547,7 +1667,7 @@ const docTemplate = `{
     },
     "securityDefinitions": {
         "ApiKeyAuth": {
-            "description": "Секретный ключ",
+            "description": "Статический секретный ключ (режим совместимости). Основной способ аутентификации - подпись запроса: заголовки X-Api-Key-Id (идентификатор ключа), X-Api-Timestamp (время отправки в формате UNIX Timestamp) и X-Api-Signature (HMAC-SHA256 в шестнадцатеричном виде от строки \"\u003ctimestamp\u003e\\n\u003cmethod\u003e\\n\u003curi\u003e\\n\u003cbody\u003e\")",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
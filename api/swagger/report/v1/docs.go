@@ -18,7 +18,7 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/operation": {
+        "/analytics/operations": {
             "get": {
                 "security": [
                     {
@@ -29,9 +29,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Операции"
+                    "Аналитика"
                 ],
-                "summary": "Получить список операций по заданным фильтрам",
+                "summary": "Получить агрегированные показатели по операциям в разрезе временных интервалов",
                 "parameters": [
                     {
                         "type": "string",
@@ -55,21 +55,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Идентификатор операции",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Размер временного интервала: hour, day или week (по умолчанию - day)",
+                        "name": "granularity",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Идентификатор операции на стороне платежной системы",
-                        "name": "external_id",
+                        "description": "Часовой пояс в формате базы IANA (по умолчанию - UTC)",
+                        "name": "time_zone",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Идентификатор клиента",
-                        "name": "client_id",
+                        "description": "Поля для группировки, перечисленные через запятую: external_system, external_method, currency, type",
+                        "name": "group_by",
                         "in": "query"
                     },
                     {
@@ -80,46 +80,36 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Внутренние статусы операций, перечисленные через запятую",
-                        "name": "statuses",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Время создания операции в формате UNIX Timestamp, с которого возвращать результирующие операции",
-                        "name": "created_at_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Время создания операции в формате UNIX Timestamp, до которого возвращать результирующие операции",
-                        "name": "created_at_to",
+                        "description": "Внутренние коды платежных систем, перечисленные через запятую",
+                        "name": "external_systems",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Поле для сортировки результирующего списка (по умолчанию - id)",
-                        "name": "order_field",
+                        "description": "Идентификатор магазина (по умолчанию - default)",
+                        "name": "merchant_id",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Тип сортировки (по умолчанию - DESC, по убыванию)",
-                        "name": "order_type",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Время создания операции в формате UNIX Timestamp, с которого учитывать операции",
+                        "name": "created_at_from",
+                        "in": "query",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Флаг о необходимости вернуть результат в формате CSV (по умолчанию - false)",
-                        "name": "csv",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Время создания операции в формате UNIX Timestamp, до которого учитывать операции",
+                        "name": "created_at_to",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Успешный ответ",
                         "schema": {
-                            "$ref": "#/definitions/v1.operationListResponse"
+                            "$ref": "#/definitions/v1.analyticsOperationsResponse"
                         }
                     },
                     "default": {
@@ -131,39 +121,102 @@ const docTemplate = `{
                 }
             }
         },
-        "/operation/{id}/change-status": {
-            "put": {
+        "/audit": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Операции"
+                    "Аудит"
                 ],
-                "summary": "Изменить состояние транзакции",
+                "summary": "Получить записи журнала аудита административных действий",
                 "parameters": [
                     {
-                        "description": "Тело запроса",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.operationChangeStatusRequest"
-                        }
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста, выполнившего действие",
+                        "name": "actor_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Действие (TOOL_RECOVER, TOOL_REMOVE, OPERATION_CHANGE_STATUS, BLOCK_ENTRY_CREATE, BLOCK_ENTRY_UPDATE, BLOCK_ENTRY_DELETE)",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тип объекта (TOOL, OPERATION, BLOCK_ENTRY)",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объекта",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор клиента",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина",
+                        "name": "merchant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Время действия в формате UNIX Timestamp, с которого возвращать результат",
+                        "name": "created_at_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Время действия в формате UNIX Timestamp, до которого возвращать результат",
+                        "name": "created_at_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Флаг о необходимости вернуть результат в формате CSV (по умолчанию - false)",
+                        "name": "csv",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Успешный ответ",
                         "schema": {
-                            "$ref": "#/definitions/v1.operationChangeStatusResponse"
+                            "$ref": "#/definitions/v1.auditListResponse"
                         }
                     },
                     "default": {
@@ -175,7 +228,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/operation/{id}/external-status": {
+        "/block-list": {
             "get": {
                 "security": [
                     {
@@ -186,19 +239,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Операции"
+                    "Список блокировок"
                 ],
-                "summary": "Запросить статус операции на стороне платежной системы",
+                "summary": "Получить записи списка блокировок",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Идентификатор операции",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
+                        "type": "string",
                         "description": "Идентификатор специалиста техподдержки",
                         "name": "user_id",
                         "in": "query",
@@ -217,13 +263,37 @@ const docTemplate = `{
                         "name": "lang_code",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина",
+                        "name": "merchant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Признак блокировки (USER, CARD, BIN_RANGE, WALLET, EMAIL, IP)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Значение признака",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Флаг о необходимости вернуть только действующие записи (по умолчанию - false)",
+                        "name": "active_only",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Успешный ответ",
                         "schema": {
-                            "$ref": "#/definitions/v1.operationExternalStatusResponse"
+                            "$ref": "#/definitions/v1.blockListResponse"
                         }
                     },
                     "default": {
@@ -233,57 +303,39 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tool": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Платежные средства"
+                    "Список блокировок"
                 ],
-                "summary": "Получить список платежных средств клиента",
+                "summary": "Добавить запись в список блокировок",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Идентификатор специалиста техподдержки",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Идентификатор сессии специалиста техподдержки",
-                        "name": "session_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Код языка, обозначение по RFC 5646",
-                        "name": "lang_code",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Идентификатор клиента",
-                        "name": "client_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.blockEntryCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Успешный ответ",
                         "schema": {
-                            "$ref": "#/definitions/v1.toolListResponse"
+                            "$ref": "#/definitions/v1.blockEntryResponse"
                         }
                     },
                     "default": {
@@ -295,8 +347,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/tool/delete": {
-            "delete": {
+        "/block-list/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -309,17 +361,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Платежные средства"
+                    "Список блокировок"
                 ],
-                "summary": "Удалить платежное средство",
+                "summary": "Изменить срок действия и причину записи списка блокировок",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор записи",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "Тело запроса",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.toolRemoveRequest"
+                            "$ref": "#/definitions/v1.blockEntryUpdateRequest"
                         }
                     }
                 ],
@@ -327,7 +386,7 @@ const docTemplate = `{
                     "200": {
                         "description": "Успешный ответ",
                         "schema": {
-                            "$ref": "#/definitions/v1.toolRemoveResponse"
+                            "$ref": "#/definitions/v1.blockEntryResponse"
                         }
                     },
                     "default": {
@@ -337,10 +396,8 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/tool/recover": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -353,17 +410,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Платежные средства"
+                    "Список блокировок"
                 ],
-                "summary": "Установить платежное средство готовым к восстановлению",
+                "summary": "Удалить запись из списка блокировок",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор записи",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "Тело запроса",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.toolRecoverRequest"
+                            "$ref": "#/definitions/v1.blockEntryDeleteRequest"
                         }
                     }
                 ],
@@ -371,7 +435,7 @@ const docTemplate = `{
                     "200": {
                         "description": "Успешный ответ",
                         "schema": {
-                            "$ref": "#/definitions/v1.toolRecoverResponse"
+                            "$ref": "#/definitions/v1.blockEntryDeleteResponse"
                         }
                     },
                     "default": {
@@ -382,141 +446,2302 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "v1.errorContent": {
-            "type": "object",
-            "required": [
-                "code",
-                "description"
-            ],
-            "properties": {
-                "code": {
-                    "description": "Код ошибки",
-                    "type": "string",
-                    "example": "InvalidRequest"
+        },
+        "/operation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Получить список операций по заданным фильтрам",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор операции на стороне платежной системы",
+                        "name": "external_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина",
+                        "name": "merchant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор клиента",
+                        "name": "client_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тип операции",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Внутренние статусы операций, перечисленные через запятую",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Время создания операции в формате UNIX Timestamp, с которого возвращать результирующие операции",
+                        "name": "created_at_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Время создания операции в формате UNIX Timestamp, до которого возвращать результирующие операции",
+                        "name": "created_at_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Поле для сортировки результирующего списка (по умолчанию - id)",
+                        "name": "order_field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тип сортировки (по умолчанию - DESC, по убыванию)",
+                        "name": "order_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Флаг о необходимости вернуть результат в формате CSV (по умолчанию - false)",
+                        "name": "csv",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationListResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/dead-letter": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Такие операции не обрабатываются планировщиком, пока их не вернут в обработку или не завершат вручную",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Получить список операций, исчерпавших лимит попыток фоновой обработки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "operation_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина",
+                        "name": "merchant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Максимальное количество операций в ответе (по умолчанию - 1000)",
+                        "name": "max_count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationDeadLetterListResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/drift": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Получить список расхождений статусов операций с платежными системами",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "operation_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина",
+                        "name": "merchant_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Фильтр по признаку автоматического исправления",
+                        "name": "fixed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Время обнаружения расхождения в формате UNIX Timestamp, с которого возвращать результат",
+                        "name": "detected_at_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Время обнаружения расхождения в формате UNIX Timestamp, до которого возвращать результат",
+                        "name": "detected_at_to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationDriftListResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/{id}/change-status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Изменить состояние транзакции",
+                "parameters": [
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationChangeStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationChangeStatusResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/{id}/dead-letter/resolve": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Вручную завершить операцию, исчерпавшую лимит попыток фоновой обработки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationDeadLetterResolveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationDeadLetterResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/{id}/dead-letter/retry": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Вернуть операцию, исчерпавшую лимит попыток, в фоновую обработку",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationDeadLetterRetryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationDeadLetterResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/operation/{id}/external-status": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Операции"
+                ],
+                "summary": "Запросить статус операции на стороне платежной системы",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор операции",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.operationExternalStatusResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Сверка"
+                ],
+                "summary": "Получить список последних запусков сверки",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Внутренний код платежной системы",
+                        "name": "external_system",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина",
+                        "name": "merchant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.reconciliationListResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Сверка"
+                ],
+                "summary": "Сверить операции с реестром платежной системы",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Внутренний код платежной системы, реестр которой сверяется",
+                        "name": "external_system",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Начало периода сверки в формате UNIX Timestamp",
+                        "name": "period_from",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Конец периода сверки в формате UNIX Timestamp",
+                        "name": "period_to",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Формат реестра: csv или json (по умолчанию определяется по расширению файла)",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина, операции которого сверяются (по умолчанию - магазин по умолчанию)",
+                        "name": "merchant_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл реестра с колонками external_id, amount, currency, status",
+                        "name": "registry",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.reconciliationRunResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/reconciliation/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Сверка"
+                ],
+                "summary": "Получить результат запуска сверки с отчетом о расхождениях",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор запуска сверки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Флаг о необходимости вернуть отчет о расхождениях в формате CSV (по умолчанию - false)",
+                        "name": "csv",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.reconciliationRunResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scheduler/task": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Планировщик"
+                ],
+                "summary": "Получить список фоновых задач с их действующими параметрами",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskListResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scheduler/task/{name}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Планировщик"
+                ],
+                "summary": "Изменить интервал запуска и размер пачки операций фоновой задачи",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Название фоновой задачи",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scheduler/task/{name}/pause": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Планировщик"
+                ],
+                "summary": "Приостановить запуски фоновой задачи по расписанию",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Название фоновой задачи",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scheduler/task/{name}/resume": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Планировщик"
+                ],
+                "summary": "Возобновить запуски фоновой задачи по расписанию",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Название фоновой задачи",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scheduler/task/{name}/runs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Запуски по расписанию, в которых не было обработано ни одной операции, не сохраняются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Планировщик"
+                ],
+                "summary": "Получить статистику последних запусков фоновой задачи",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Название фоновой задачи",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Количество последних запусков (по умолчанию - 100)",
+                        "name": "max_count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskRunsResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/scheduler/task/{name}/trigger": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Планировщик"
+                ],
+                "summary": "Запустить фоновую задачу вне очереди",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Название фоновой задачи",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.schedulerTaskActionResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tool": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Платежные средства"
+                ],
+                "summary": "Получить список платежных средств клиента",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор специалиста техподдержки",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сессии специалиста техподдержки",
+                        "name": "session_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Код языка, обозначение по RFC 5646",
+                        "name": "lang_code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор клиента",
+                        "name": "client_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор магазина клиента (по умолчанию - default)",
+                        "name": "merchant_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.toolListResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Платежные средства"
+                ],
+                "summary": "Удалить платежное средство",
+                "parameters": [
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.toolRemoveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.toolRemoveResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tool/recover": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Платежные средства"
+                ],
+                "summary": "Установить платежное средство готовым к восстановлению",
+                "parameters": [
+                    {
+                        "description": "Тело запроса",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.toolRecoverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "$ref": "#/definitions/v1.toolRecoverResponse"
+                        }
+                    },
+                    "default": {
+                        "description": "Ответ с ошибкой",
+                        "schema": {
+                            "$ref": "#/definitions/v1.errorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "v1.analyticsItem": {
+            "type": "object",
+            "required": [
+                "conversion_rate",
+                "created",
+                "failed",
+                "median_processing_time",
+                "period",
+                "period_start",
+                "succeeded",
+                "volume"
+            ],
+            "properties": {
+                "conversion_rate": {
+                    "description": "Доля успешных операций среди созданных",
+                    "type": "number",
+                    "example": 0.8333
+                },
+                "created": {
+                    "description": "Количество созданных операций",
+                    "type": "integer",
+                    "example": 120
+                },
+                "currency": {
+                    "description": "Валюта операций (объем всегда считается в разрезе валюты)",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "external_method": {
+                    "description": "Внутренний код платежного метода (передается при группировке по external_method)",
+                    "type": "string",
+                    "example": "yookassa_bank_card"
+                },
+                "external_system": {
+                    "description": "Внутренний код платежной системы (передается при группировке по external_system)",
+                    "type": "string",
+                    "example": "yookassa"
+                },
+                "failed": {
+                    "description": "Количество отклоненных операций",
+                    "type": "integer",
+                    "example": 15
+                },
+                "median_processing_time": {
+                    "description": "Медианное время от создания операции до её завершения на стороне платежной системы в секундах",
+                    "type": "integer",
+                    "example": 42
+                },
+                "period": {
+                    "description": "Начало временного интервала в формате RFC 3339 в запрошенном часовом поясе",
+                    "type": "string",
+                    "example": "2024-05-17T00:00:00+03:00"
+                },
+                "period_start": {
+                    "description": "Начало временного интервала в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715904000
+                },
+                "succeeded": {
+                    "description": "Количество успешных операций",
+                    "type": "integer",
+                    "example": 100
+                },
+                "type": {
+                    "description": "Тип операций (передается при группировке по type)",
+                    "type": "string",
+                    "example": "payment"
+                },
+                "volume": {
+                    "description": "Сумма успешных операций",
+                    "type": "number",
+                    "example": 12100.5
+                }
+            }
+        },
+        "v1.analyticsOperationsResponse": {
+            "type": "object",
+            "required": [
+                "items",
+                "success"
+            ],
+            "properties": {
+                "items": {
+                    "description": "Массив агрегированных показателей по временным интервалам",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.analyticsItem"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.auditEntry": {
+            "type": "object",
+            "required": [
+                "action",
+                "actor_id",
+                "after",
+                "before",
+                "client_id",
+                "created_at",
+                "id",
+                "merchant_id",
+                "target_id",
+                "target_type"
+            ],
+            "properties": {
+                "action": {
+                    "description": "Действие:\n* Восстановление платежного средства - \"TOOL_RECOVER\"\n* Удаление платежного средства - \"TOOL_REMOVE\"\n* Изменение статуса операции - \"OPERATION_CHANGE_STATUS\"\n* Добавление записи в список блокировок - \"BLOCK_ENTRY_CREATE\"\n* Изменение записи списка блокировок - \"BLOCK_ENTRY_UPDATE\"\n* Удаление записи из списка блокировок - \"BLOCK_ENTRY_DELETE\"",
+                    "type": "string",
+                    "example": "TOOL_REMOVE"
+                },
+                "actor_id": {
+                    "description": "Идентификатор специалиста, выполнившего действие",
+                    "type": "string",
+                    "example": "1"
+                },
+                "after": {
+                    "description": "Состояние объекта после действия",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "before": {
+                    "description": "Состояние объекта до действия",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "client_id": {
+                    "description": "Идентификатор клиента, которому принадлежит объект",
+                    "type": "string",
+                    "example": "1"
+                },
+                "created_at": {
+                    "description": "Время действия в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "id": {
+                    "description": "Идентификатор записи журнала аудита",
+                    "type": "integer",
+                    "example": 1
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Причина действия",
+                    "type": "string",
+                    "example": "Подозрение на мошенничество"
+                },
+                "target_id": {
+                    "description": "Идентификатор объекта",
+                    "type": "string",
+                    "example": "2dc32aa0-000f-5000-8000-16d7bc6cd09f"
+                },
+                "target_type": {
+                    "description": "Тип объекта: \"TOOL\", \"OPERATION\" или \"BLOCK_ENTRY\"",
+                    "type": "string",
+                    "example": "TOOL"
+                }
+            }
+        },
+        "v1.auditListResponse": {
+            "type": "object",
+            "required": [
+                "entries",
+                "success"
+            ],
+            "properties": {
+                "entries": {
+                    "description": "Массив записей журнала аудита, подходящих под фильтры запроса",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.auditEntry"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.blockEntry": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "created_by",
+                "id",
+                "merchant_id",
+                "reason",
+                "type",
+                "updated_at",
+                "value"
+            ],
+            "properties": {
+                "active": {
+                    "description": "Флаг, что запись действует",
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "description": "Время создания записи в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "created_by": {
+                    "description": "Идентификатор специалиста, добавившего запись",
+                    "type": "string",
+                    "example": "1"
+                },
+                "expires_at": {
+                    "description": "Время окончания действия записи в формате UNIX Timestamp (отсутствует у бессрочных записей)",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "id": {
+                    "description": "Идентификатор записи",
+                    "type": "integer",
+                    "example": 1
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Причина блокировки",
+                    "type": "string",
+                    "example": "Подозрение на мошенничество"
+                },
+                "type": {
+                    "description": "Признак блокировки:\n* Клиент - \"USER\"\n* Отпечаток банковской карты (идентификатор платежного средства) - \"CARD\"\n* Диапазон BIN банковских карт - \"BIN_RANGE\"\n* Номер электронного кошелька - \"WALLET\"\n* Адрес электронной почты - \"EMAIL\"\n* IP-адрес или подсеть в нотации CIDR - \"IP\"",
+                    "type": "string",
+                    "example": "USER"
+                },
+                "updated_at": {
+                    "description": "Время последнего обновления записи в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "value": {
+                    "description": "Значение признака (для BIN_RANGE - нижняя граница диапазона)",
+                    "type": "string",
+                    "example": "1"
+                },
+                "value_to": {
+                    "description": "Верхняя граница диапазона BIN",
+                    "type": "string",
+                    "example": "220299"
+                }
+            }
+        },
+        "v1.blockEntryCreateRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "reason",
+                "session_id",
+                "type",
+                "user_id",
+                "value"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "Время окончания действия записи в формате UNIX Timestamp (по умолчанию - бессрочно)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1715974447
+                },
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина (по умолчанию - \"default\")",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Причина блокировки, сохраняется в записи и в журнале аудита",
+                    "type": "string",
+                    "example": "Подозрение на мошенничество"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста поддержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "type": {
+                    "description": "Признак блокировки: \"USER\", \"CARD\", \"BIN_RANGE\", \"WALLET\", \"EMAIL\" или \"IP\"",
+                    "type": "string",
+                    "enum": [
+                        "USER",
+                        "CARD",
+                        "BIN_RANGE",
+                        "WALLET",
+                        "EMAIL",
+                        "IP"
+                    ],
+                    "example": "BIN_RANGE"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "string",
+                    "example": "1"
+                },
+                "value": {
+                    "description": "Значение признака (для BIN_RANGE - нижняя граница диапазона из шести цифр)",
+                    "type": "string",
+                    "example": "220200"
+                },
+                "value_to": {
+                    "description": "Верхняя граница диапазона BIN (по умолчанию совпадает с нижней)",
+                    "type": "string",
+                    "example": "220299"
+                }
+            }
+        },
+        "v1.blockEntryDeleteRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "reason",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Причина удаления, сохраняется в журнале аудита",
+                    "type": "string",
+                    "example": "Блокировка установлена ошибочно"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста поддержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "v1.blockEntryDeleteResponse": {
+            "type": "object",
+            "required": [
+                "success"
+            ],
+            "properties": {
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.blockEntryResponse": {
+            "type": "object",
+            "required": [
+                "entry",
+                "success"
+            ],
+            "properties": {
+                "entry": {
+                    "description": "Запись списка блокировок",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.blockEntry"
+                        }
+                    ]
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.blockEntryUpdateRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "reason",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "expires_at": {
+                    "description": "Новое время окончания действия записи в формате UNIX Timestamp (0 - бессрочно)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1715974447
+                },
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Новая причина блокировки, сохраняется в записи и в журнале аудита",
+                    "type": "string",
+                    "example": "Клиент подтвердил личность, блокировка до конца проверки"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста поддержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "v1.blockListResponse": {
+            "type": "object",
+            "required": [
+                "entries",
+                "success"
+            ],
+            "properties": {
+                "entries": {
+                    "description": "Массив записей списка блокировок, подходящих под фильтры запроса",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.blockEntry"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.deadLetterOperation": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "dead_lettered_at",
+                "external_method",
+                "external_system",
+                "last_attempt_at",
+                "last_error",
+                "operation_id",
+                "operation_status",
+                "operation_type",
+                "task_name"
+            ],
+            "properties": {
+                "attempts": {
+                    "description": "Количество неудачных попыток обработки подряд",
+                    "type": "integer",
+                    "example": 5
+                },
+                "dead_lettered_at": {
+                    "description": "Время перевода операции в очередь недоставленных в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "external_method": {
+                    "description": "Внутренний код платежного метода платежной системы",
+                    "type": "string",
+                    "example": "yookassa_bank_card"
+                },
+                "external_system": {
+                    "description": "Внутренний код платежной системы",
+                    "type": "string",
+                    "example": "yookassa"
+                },
+                "last_attempt_at": {
+                    "description": "Время последней попытки обработки в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "last_error": {
+                    "description": "Ошибка последней попытки обработки",
+                    "type": "string",
+                    "example": "request payout from integration: context deadline exceeded"
+                },
+                "operation_id": {
+                    "description": "Идентификатор операции",
+                    "type": "integer",
+                    "example": 1
+                },
+                "operation_status": {
+                    "description": "Внутренний статус операции",
+                    "type": "string",
+                    "example": "CONFIRMED"
+                },
+                "operation_type": {
+                    "description": "Тип операции",
+                    "type": "string",
+                    "example": "payout"
+                },
+                "task_name": {
+                    "description": "Название фоновой задачи, обрабатывавшей операцию",
+                    "type": "string",
+                    "example": "request_payouts"
+                }
+            }
+        },
+        "v1.errorContent": {
+            "type": "object",
+            "required": [
+                "code",
+                "description"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Код ошибки",
+                    "type": "string",
+                    "example": "InvalidRequest"
+                },
+                "description": {
+                    "description": "Описание ошибки для разработки",
+                    "type": "string",
+                    "example": "user_id param is required"
+                },
+                "message": {
+                    "description": "Сообщение об ошибке для клиента",
+                    "type": "string",
+                    "example": "Internal server error occurred. Please try again later."
+                }
+            }
+        },
+        "v1.errorResponse": {
+            "type": "object",
+            "required": [
+                "error",
+                "success"
+            ],
+            "properties": {
+                "error": {
+                    "description": "Развернутая информация об ошибке",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.errorContent"
+                        }
+                    ]
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда false)",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "v1.operation": {
+            "type": "object",
+            "required": [
+                "amount",
+                "client_id",
+                "created_at",
+                "currency",
+                "id",
+                "merchant_id",
+                "status",
+                "type",
+                "updated_at"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Сумма операции",
+                    "type": "number",
+                    "example": 121.01
+                },
+                "client_id": {
+                    "description": "Идентификатор клиента",
+                    "type": "string",
+                    "example": "1"
+                },
+                "created_at": {
+                    "description": "Время создания операции в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "currency": {
+                    "description": "Валюта операции",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "external_id": {
+                    "description": "Идентификатор операции на стороне платежной системы (маскируется для ролей viewer и support)",
+                    "type": "string",
+                    "example": "ew01r01w0gfw1fw1"
+                },
+                "external_status": {
+                    "description": "Статус операции на стороне платежной системы",
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "fail_reason": {
+                    "description": "Причина отклонения операции",
+                    "type": "string",
+                    "example": "Technical error"
+                },
+                "id": {
+                    "description": "Идентификатор операции",
+                    "type": "integer",
+                    "example": 1
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина",
+                    "type": "string",
+                    "example": "default"
+                },
+                "processed_at": {
+                    "description": "Время завершения операции на стороне платежной системы в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "status": {
+                    "description": "Внутренний статус операции",
+                    "type": "string",
+                    "example": "SUCCESS"
+                },
+                "tool": {
+                    "description": "Платежное средство, используемое в операции (маскируется для ролей viewer и support)",
+                    "type": "string",
+                    "example": "5748********4124"
+                },
+                "type": {
+                    "description": "Тип операции",
+                    "type": "string",
+                    "example": "payment"
+                },
+                "updated_at": {
+                    "description": "Время последнего обновления операции в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                }
+            }
+        },
+        "v1.operationChangeStatusRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "new_external_status",
+                "new_status",
+                "reason",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "new_external_status": {
+                    "description": "Новый статус операции на стороне ПС",
+                    "type": "string",
+                    "example": "FAILED"
+                },
+                "new_status": {
+                    "description": "Новый внутренний статус операции",
+                    "type": "string",
+                    "example": "FAILED"
+                },
+                "reason": {
+                    "description": "Причина изменения статуса, сохраняется в журнале аудита",
+                    "type": "string",
+                    "example": "Платежная система подтвердила отклонение платежа в ответе на обращение"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста техподдержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "v1.operationChangeStatusResponse": {
+            "type": "object",
+            "required": [
+                "success"
+            ],
+            "properties": {
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.operationDeadLetterListResponse": {
+            "type": "object",
+            "required": [
+                "operations",
+                "success"
+            ],
+            "properties": {
+                "operations": {
+                    "description": "Массив операций, исчерпавших лимит попыток обработки",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.deadLetterOperation"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.operationDeadLetterResolveRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "new_external_status",
+                "new_status",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "new_external_status": {
+                    "description": "Итоговый статус операции на стороне ПС",
+                    "type": "string",
+                    "example": "FAILED"
+                },
+                "new_status": {
+                    "description": "Итоговый внутренний статус операции",
+                    "type": "string",
+                    "enum": [
+                        "SUCCESS",
+                        "FAILED"
+                    ],
+                    "example": "FAILED"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста техподдержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "v1.operationDeadLetterResponse": {
+            "type": "object",
+            "required": [
+                "success"
+            ],
+            "properties": {
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.operationDeadLetterRetryRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста техподдержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "v1.operationDriftListResponse": {
+            "type": "object",
+            "required": [
+                "drifts",
+                "success"
+            ],
+            "properties": {
+                "drifts": {
+                    "description": "Массив обнаруженных расхождений статусов",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.statusDrift"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.operationExternalStatusResponse": {
+            "type": "object",
+            "required": [
+                "external_status",
+                "message",
+                "success"
+            ],
+            "properties": {
+                "external_status": {
+                    "description": "Статус операции на стороне платежной системы",
+                    "type": "string",
+                    "example": "PENDING"
+                },
+                "message": {
+                    "description": "Информативное сообщение, описывающее статус транзакции на стороне платежной системы",
+                    "type": "string",
+                    "example": "Транзакция на стороне ПС еще не имеет конечный статус."
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.operationListResponse": {
+            "type": "object",
+            "required": [
+                "operations",
+                "success",
+                "total_amount",
+                "total_count"
+            ],
+            "properties": {
+                "operations": {
+                    "description": "Массив операций, подходящих под фильтры и условия запроса",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.operation"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total_amount": {
+                    "description": "Сумма всех операций из результирующего массива",
+                    "type": "number",
+                    "example": 1421.1
+                },
+                "total_count": {
+                    "description": "Количество всех операций из результирующего массива",
+                    "type": "integer",
+                    "example": 15
+                }
+            }
+        },
+        "v1.reconciliationDiscrepancy": {
+            "type": "object",
+            "required": [
+                "external_id",
+                "type"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Сумма операции",
+                    "type": "number",
+                    "example": 121.01
+                },
+                "currency": {
+                    "description": "Валюта операции",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "external_id": {
+                    "description": "Идентификатор операции на стороне платежной системы",
+                    "type": "string",
+                    "example": "2dc32aa0-000f-5000-8000-16d7bc6cd09f"
+                },
+                "operation_id": {
+                    "description": "Идентификатор операции",
+                    "type": "integer",
+                    "example": 1
+                },
+                "registry_amount": {
+                    "description": "Сумма операции по данным реестра",
+                    "type": "number",
+                    "example": 120.01
+                },
+                "registry_currency": {
+                    "description": "Валюта операции по данным реестра",
+                    "type": "string",
+                    "example": "RUB"
+                },
+                "registry_status": {
+                    "description": "Статус операции по данным реестра",
+                    "type": "string",
+                    "example": "SUCCESS"
+                },
+                "status": {
+                    "description": "Внутренний статус операции",
+                    "type": "string",
+                    "example": "SUCCESS"
+                },
+                "type": {
+                    "description": "Тип расхождения:\n* Успешная операция отсутствует в реестре - \"MISSING\"\n* Строка реестра не соответствует ни одной операции - \"EXTRA\"\n* Не совпадает сумма или валюта - \"AMOUNT_MISMATCH\"\n* Не совпадает статус - \"STATUS_MISMATCH\"",
+                    "type": "string",
+                    "example": "AMOUNT_MISMATCH"
+                }
+            }
+        },
+        "v1.reconciliationListResponse": {
+            "type": "object",
+            "required": [
+                "runs",
+                "success"
+            ],
+            "properties": {
+                "runs": {
+                    "description": "Массив последних запусков сверки",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.reconciliationRun"
+                    }
+                },
+                "success": {
+                    "description": "Результат обработки запроса (всегда true)",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "v1.reconciliationRun": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "discrepancy_count",
+                "external_system",
+                "id",
+                "matched_count",
+                "merchant_id",
+                "period_from",
+                "period_to",
+                "registry_count"
+            ],
+            "properties": {
+                "created_at": {
+                    "description": "Время запуска сверки в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715990400
+                },
+                "discrepancies": {
+                    "description": "Массив найденных расхождений (передается при запросе конкретного запуска)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.reconciliationDiscrepancy"
+                    }
                 },
-                "description": {
-                    "description": "Описание ошибки для разработки",
+                "discrepancy_count": {
+                    "description": "Количество найденных расхождений",
+                    "type": "integer",
+                    "example": 3
+                },
+                "external_system": {
+                    "description": "Внутренний код платежной системы",
                     "type": "string",
-                    "example": "user_id param is required"
+                    "example": "yookassa"
                 },
-                "message": {
-                    "description": "Сообщение об ошибке для клиента",
+                "id": {
+                    "description": "Идентификатор запуска сверки",
+                    "type": "integer",
+                    "example": 1
+                },
+                "matched_count": {
+                    "description": "Количество строк реестра, сопоставленных с операциями",
+                    "type": "integer",
+                    "example": 118
+                },
+                "merchant_id": {
+                    "description": "Идентификатор магазина, операции которого сверялись",
                     "type": "string",
-                    "example": "Internal server error occurred. Please try again later."
+                    "example": "default"
+                },
+                "period_from": {
+                    "description": "Начало периода сверки в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715904000
+                },
+                "period_to": {
+                    "description": "Конец периода сверки в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715990400
+                },
+                "registry_count": {
+                    "description": "Количество строк реестра",
+                    "type": "integer",
+                    "example": 120
                 }
             }
         },
-        "v1.errorResponse": {
+        "v1.reconciliationRunResponse": {
             "type": "object",
             "required": [
-                "error",
+                "run",
                 "success"
             ],
             "properties": {
-                "error": {
-                    "description": "Развернутая информация об ошибке",
+                "run": {
+                    "description": "Результат сверки",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/v1.errorContent"
+                            "$ref": "#/definitions/v1.reconciliationRun"
                         }
                     ]
                 },
                 "success": {
-                    "description": "Результат обработки запроса (всегда false)",
+                    "description": "Результат обработки запроса (всегда true)",
                     "type": "boolean",
-                    "example": false
+                    "example": true
                 }
             }
         },
-        "v1.operation": {
+        "v1.schedulerTask": {
             "type": "object",
             "required": [
-                "amount",
-                "client_id",
-                "created_at",
-                "currency",
-                "id",
-                "status",
-                "type",
-                "updated_at"
+                "default_interval",
+                "default_operation_batch_size",
+                "interval",
+                "is_paused",
+                "name",
+                "operation_batch_size"
             ],
             "properties": {
-                "amount": {
-                    "description": "Сумма операции",
-                    "type": "number",
-                    "example": 121.01
-                },
-                "client_id": {
-                    "description": "Идентификатор клиента",
-                    "type": "string",
-                    "example": "1"
-                },
-                "created_at": {
-                    "description": "Время создания операции в формате UNIX Timestamp",
+                "default_interval": {
+                    "description": "Интервал запуска в секундах из конфигурации",
                     "type": "integer",
-                    "example": 1715974447
-                },
-                "currency": {
-                    "description": "Валюта операции",
-                    "type": "string",
-                    "example": "RUB"
-                },
-                "external_id": {
-                    "description": "Идентификатор операции на стороне платежной системы",
-                    "type": "string",
-                    "example": "ew01r01w0gfw1fw1"
-                },
-                "external_status": {
-                    "description": "Статус операции на стороне платежной системы",
-                    "type": "string",
-                    "example": "PENDING"
-                },
-                "fail_reason": {
-                    "description": "Причина отклонения операции",
-                    "type": "string",
-                    "example": "Technical error"
+                    "example": 5
                 },
-                "id": {
-                    "description": "Идентификатор операции",
+                "default_operation_batch_size": {
+                    "description": "Размер пачки обрабатываемых операций из конфигурации",
                     "type": "integer",
-                    "example": 1
+                    "example": 100
                 },
-                "processed_at": {
-                    "description": "Время завершения операции на стороне платежной системы в формате UNIX Timestamp",
+                "interval": {
+                    "description": "Действующий интервал запуска в секундах",
                     "type": "integer",
-                    "example": 1715974447
-                },
-                "status": {
-                    "description": "Внутренний статус операции",
-                    "type": "string",
-                    "example": "SUCCESS"
+                    "example": 5
                 },
-                "tool": {
-                    "description": "Платежное средство, используемое в операции",
-                    "type": "string",
-                    "example": "5748********4124"
+                "is_paused": {
+                    "description": "Флаг о том, что запуски фоновой задачи по расписанию приостановлены",
+                    "type": "boolean",
+                    "example": false
                 },
-                "type": {
-                    "description": "Тип операции",
+                "name": {
+                    "description": "Название фоновой задачи",
                     "type": "string",
-                    "example": "payment"
+                    "example": "request_payouts"
                 },
-                "updated_at": {
-                    "description": "Время последнего обновления операции в формате UNIX Timestamp",
+                "operation_batch_size": {
+                    "description": "Действующий размер пачки обрабатываемых операций",
                     "type": "integer",
-                    "example": 1715974447
+                    "example": 100
                 }
             }
         },
-        "v1.operationChangeStatusRequest": {
+        "v1.schedulerTaskActionRequest": {
             "type": "object",
             "required": [
                 "lang_code",
-                "new_external_status",
-                "new_status",
                 "session_id",
                 "user_id"
             ],
@@ -526,16 +2751,6 @@ const docTemplate = `{
                     "type": "string",
                     "example": "en"
                 },
-                "new_external_status": {
-                    "description": "Новый статус операции на стороне ПС",
-                    "type": "string",
-                    "example": "FAILED"
-                },
-                "new_status": {
-                    "description": "Новый внутренний статус операции",
-                    "type": "string",
-                    "example": "FAILED"
-                },
                 "session_id": {
                     "description": "Идентификатор сессии специалиста техподдержки",
                     "type": "string",
@@ -543,12 +2758,12 @@ const docTemplate = `{
                 },
                 "user_id": {
                     "description": "Идентификатор специалиста поддержки",
-                    "type": "integer",
-                    "example": 1
+                    "type": "string",
+                    "example": "1"
                 }
             }
         },
-        "v1.operationChangeStatusResponse": {
+        "v1.schedulerTaskActionResponse": {
             "type": "object",
             "required": [
                 "success"
@@ -561,61 +2776,214 @@ const docTemplate = `{
                 }
             }
         },
-        "v1.operationExternalStatusResponse": {
+        "v1.schedulerTaskListResponse": {
             "type": "object",
             "required": [
-                "external_status",
-                "message",
-                "success"
+                "success",
+                "tasks"
             ],
             "properties": {
-                "external_status": {
-                    "description": "Статус операции на стороне платежной системы",
-                    "type": "string",
-                    "example": "PENDING"
-                },
-                "message": {
-                    "description": "Информативное сообщение, описывающее статус транзакции на стороне платежной системы",
-                    "type": "string",
-                    "example": "Транзакция на стороне ПС еще не имеет конечный статус."
-                },
                 "success": {
                     "description": "Результат обработки запроса (всегда true)",
                     "type": "boolean",
                     "example": true
+                },
+                "tasks": {
+                    "description": "Массив фоновых задач",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.schedulerTask"
+                    }
                 }
             }
         },
-        "v1.operationListResponse": {
+        "v1.schedulerTaskRun": {
             "type": "object",
             "required": [
-                "operations",
-                "success",
-                "total_amount",
-                "total_count"
+                "duration",
+                "errored",
+                "finished_at",
+                "id",
+                "instance_id",
+                "processed",
+                "started_at",
+                "succeeded",
+                "trigger"
             ],
             "properties": {
-                "operations": {
-                    "description": "Массив операций, подходящих под фильтры и условия запроса",
+                "duration": {
+                    "description": "Длительность запуска в миллисекундах",
+                    "type": "integer",
+                    "example": 1520
+                },
+                "errored": {
+                    "description": "Количество операций, при обработке которых произошла ошибка",
+                    "type": "integer",
+                    "example": 2
+                },
+                "finished_at": {
+                    "description": "Время окончания запуска в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974449
+                },
+                "id": {
+                    "description": "Идентификатор запуска",
+                    "type": "integer",
+                    "example": 1
+                },
+                "instance_id": {
+                    "description": "Идентификатор экземпляра сервиса, выполнившего запуск",
+                    "type": "string",
+                    "example": "engine-1-42"
+                },
+                "processed": {
+                    "description": "Количество операций, взятых в обработку",
+                    "type": "integer",
+                    "example": 100
+                },
+                "started_at": {
+                    "description": "Время начала запуска в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "succeeded": {
+                    "description": "Количество операций, обработанных без ошибок",
+                    "type": "integer",
+                    "example": 98
+                },
+                "trigger": {
+                    "description": "Источник запуска: \"SCHEDULE\" - по расписанию, \"MANUAL\" - по запросу",
+                    "type": "string",
+                    "example": "SCHEDULE"
+                }
+            }
+        },
+        "v1.schedulerTaskRunsResponse": {
+            "type": "object",
+            "required": [
+                "runs",
+                "success"
+            ],
+            "properties": {
+                "runs": {
+                    "description": "Массив последних запусков фоновой задачи",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v1.operation"
+                        "$ref": "#/definitions/v1.schedulerTaskRun"
                     }
                 },
                 "success": {
                     "description": "Результат обработки запроса (всегда true)",
                     "type": "boolean",
                     "example": true
+                }
+            }
+        },
+        "v1.schedulerTaskUpdateRequest": {
+            "type": "object",
+            "required": [
+                "lang_code",
+                "session_id",
+                "user_id"
+            ],
+            "properties": {
+                "interval": {
+                    "description": "Новый интервал запуска в секундах",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 10
                 },
-                "total_amount": {
-                    "description": "Сумма всех операций из результирующего массива",
-                    "type": "number",
-                    "example": 1421.1
+                "lang_code": {
+                    "description": "Код языка, обозначение по RFC 5646",
+                    "type": "string",
+                    "example": "en"
                 },
-                "total_count": {
-                    "description": "Количество всех операций из результирующего массива",
+                "operation_batch_size": {
+                    "description": "Новый размер пачки обрабатываемых операций",
                     "type": "integer",
-                    "example": 15
+                    "minimum": 1,
+                    "example": 50
+                },
+                "session_id": {
+                    "description": "Идентификатор сессии специалиста техподдержки",
+                    "type": "string",
+                    "example": "LRXZmXPGusPCfys48LadjFew"
+                },
+                "user_id": {
+                    "description": "Идентификатор специалиста поддержки",
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "v1.statusDrift": {
+            "type": "object",
+            "required": [
+                "detected_at",
+                "external_method",
+                "external_system",
+                "fixed",
+                "id",
+                "operation_id",
+                "operation_type",
+                "provider_status",
+                "status"
+            ],
+            "properties": {
+                "detected_at": {
+                    "description": "Время обнаружения расхождения в формате UNIX Timestamp",
+                    "type": "integer",
+                    "example": 1715974447
+                },
+                "external_method": {
+                    "description": "Внутренний код платежного метода платежной системы",
+                    "type": "string",
+                    "example": "yookassa_bank_card"
+                },
+                "external_status": {
+                    "description": "Сохраненный статус операции на стороне платежной системы",
+                    "type": "string",
+                    "example": "SUCCESS"
+                },
+                "external_system": {
+                    "description": "Внутренний код платежной системы",
+                    "type": "string",
+                    "example": "yookassa"
+                },
+                "fix_error": {
+                    "description": "Причина, по которой расхождение не удалось исправить автоматически",
+                    "type": "string",
+                    "example": "status transition is not supported"
+                },
+                "fixed": {
+                    "description": "Флаг о том, что расхождение было исправлено автоматически",
+                    "type": "boolean",
+                    "example": false
+                },
+                "id": {
+                    "description": "Идентификатор записи о расхождении",
+                    "type": "integer",
+                    "example": 1
+                },
+                "operation_id": {
+                    "description": "Идентификатор операции",
+                    "type": "integer",
+                    "example": 1
+                },
+                "operation_type": {
+                    "description": "Тип операции",
+                    "type": "string",
+                    "example": "payment"
+                },
+                "provider_status": {
+                    "description": "Актуальный статус операции на стороне платежной системы",
+                    "type": "string",
+                    "example": "FAILED"
+                },
+                "status": {
+                    "description": "Внутренний статус операции на момент обнаружения расхождения",
+                    "type": "string",
+                    "example": "SUCCESS"
                 }
             }
         },
@@ -643,7 +3011,7 @@ const docTemplate = `{
                     "example": 1715974447
                 },
                 "details": {
-                    "description": "Дополнительная информация о платежном средстве",
+                    "description": "Дополнительная информация о платежном средстве (для ролей viewer и support без данных владельца, срока действия и номера кошелька)",
                     "allOf": [
                         {
                             "$ref": "#/definitions/v1.toolDetails"
@@ -666,7 +3034,7 @@ const docTemplate = `{
                     "example": "Карта брата"
                 },
                 "status": {
-                    "description": "Статус платежного средства:\n* Доступен клиенту - \"ACTIVE\"\n* Удален клиентом - \"REMOVED_BY_CLIENT\"\n* Ожидает восстановления - \"PENDING_RECOVERY\"\n* Заблокирован техподдержкой - \"REMOVED_BY_ADMINISTRATOR\"",
+                    "description": "Статус платежного средства:\n* Доступен клиенту - \"ACTIVE\"\n* Удален клиентом - \"REMOVED_BY_CLIENT\"\n* Ожидает восстановления - \"PENDING_RECOVERY\"\n* Заблокирован техподдержкой - \"REMOVED_BY_ADMINISTRATOR\"\n* Истек срок действия - \"EXPIRED\"",
                     "type": "string",
                     "example": "ACTIVE"
                 },
@@ -745,6 +3113,7 @@ const docTemplate = `{
                 "external_method",
                 "id",
                 "lang_code",
+                "reason",
                 "session_id",
                 "user_id"
             ],
@@ -769,6 +3138,16 @@ const docTemplate = `{
                     "type": "string",
                     "example": "en"
                 },
+                "merchant_id": {
+                    "description": "Идентификатор магазина клиента (по умолчанию - \"default\")",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Причина восстановления, сохраняется в журнале аудита",
+                    "type": "string",
+                    "example": "Клиент подтвердил владение картой"
+                },
                 "session_id": {
                     "description": "Идентификатор сессии специалиста поддержки",
                     "type": "string",
@@ -807,6 +3186,7 @@ const docTemplate = `{
                 "external_method",
                 "id",
                 "lang_code",
+                "reason",
                 "session_id",
                 "user_id"
             ],
@@ -831,6 +3211,16 @@ const docTemplate = `{
                     "type": "string",
                     "example": "en"
                 },
+                "merchant_id": {
+                    "description": "Идентификатор магазина клиента (по умолчанию - \"default\")",
+                    "type": "string",
+                    "example": "default"
+                },
+                "reason": {
+                    "description": "Причина удаления, сохраняется в журнале аудита",
+                    "type": "string",
+                    "example": "Подозрение на мошенничество"
+                },
                 "session_id": {
                     "description": "Идентификатор сессии специалиста поддержки",
                     "type": "string",
@@ -865,7 +3255,7 @@ const docTemplate = `{
     },
     "securityDefinitions": {
         "ApiKeyAuth": {
-            "description": "Секретный ключ",
+            "description": "Статический секретный ключ (режим совместимости). Основной способ аутентификации - подпись запроса: заголовки X-Api-Key-Id (идентификатор ключа), X-Api-Timestamp (время отправки в формате UNIX Timestamp) и X-Api-Signature (HMAC-SHA256 в шестнадцатеричном виде от строки \"\u003ctimestamp\u003e\\n\u003cmethod\u003e\\n\u003curi\u003e\\n\u003cbody\u003e\")",
             "type": "apiKey",
             "name": "Authorization",
             "in": "header"
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config - настройки аутентификации клиентов HTTP API
+type Config struct {
+	// ReplayWindow - допустимое расхождение в секундах между временем подписи запроса и временем его получения
+	ReplayWindow int `yaml:"replay_window"`
+	// Clients - клиенты и выданные им ключи
+	Clients []ClientConfig `yaml:"clients"`
+}
+
+type ClientConfig struct {
+	ID string `yaml:"id"`
+	// MerchantID - магазин, от имени которого действует клиент; пустое значение - клиент не привязан к магазину
+	MerchantID string      `yaml:"merchant_id"`
+	Keys       []KeyConfig `yaml:"keys"`
+}
+
+type KeyConfig struct {
+	ID string `yaml:"id"`
+	// SecretEnv - имя переменной среды окружения, в которой хранится секрет ключа
+	SecretEnv string   `yaml:"secret_env"`
+	Scopes    []string `yaml:"scopes"`
+	// ValidFrom и ExpiresAt - период действия ключа в формате RFC 3339; пустое значение - без ограничения
+	ValidFrom string `yaml:"valid_from"`
+	ExpiresAt string `yaml:"expires_at"`
+}
+
+// Keys собирает ключи клиентов из конфигурации, подгружая секреты из переменных среды окружения.
+func (c Config) Keys() ([]Key, error) {
+	var keys []Key
+	for _, client := range c.Clients {
+		for _, keyCfg := range client.Keys {
+			key, err := keyCfg.key(client.ID, client.MerchantID)
+			if err != nil {
+				return nil, fmt.Errorf("client %q key %q: %w", client.ID, keyCfg.ID, err)
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (c Config) ReplayWindowDuration() time.Duration {
+	return time.Duration(c.ReplayWindow) * time.Second
+}
+
+func (c KeyConfig) key(clientID, merchantID string) (Key, error) {
+	secret, exists := os.LookupEnv(c.SecretEnv)
+	if !exists || secret == "" {
+		return Key{}, fmt.Errorf("secret environment variable %q is not set", c.SecretEnv)
+	}
+
+	key := Key{
+		ID:         c.ID,
+		ClientID:   clientID,
+		MerchantID: merchantID,
+		Secret:     []byte(secret),
+		Scopes:     c.Scopes,
+	}
+
+	var err error
+	if c.ValidFrom != "" {
+		if key.ValidFrom, err = time.Parse(time.RFC3339, c.ValidFrom); err != nil {
+			return Key{}, fmt.Errorf("parsing valid_from: %w", err)
+		}
+	}
+	if c.ExpiresAt != "" {
+		if key.ExpiresAt, err = time.Parse(time.RFC3339, c.ExpiresAt); err != nil {
+			return Key{}, fmt.Errorf("parsing expires_at: %w", err)
+		}
+	}
+
+	return key, nil
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ScopeAll - область доступа, включающая все остальные; выдается ключу в режиме совместимости
+const ScopeAll = "*"
+
+// Key - ключ подписи запросов клиента. У клиента может быть несколько действующих ключей одновременно:
+// при ротации новый ключ начинает действовать до истечения срока старого, чтобы клиент успел переключиться.
+type Key struct {
+	// ID - идентификатор ключа, передаваемый клиентом в заголовке запроса
+	ID string
+	// ClientID - идентификатор клиента, которому выдан ключ
+	ClientID string
+	// MerchantID - магазин, к которому привязан клиент (пустое - клиент не привязан к магазину)
+	MerchantID string
+	// Secret - секрет для вычисления HMAC-подписи
+	Secret []byte
+	// Scopes - области доступа, разрешенные ключу
+	Scopes []string
+	// ValidFrom - время начала действия ключа (нулевое - действует сразу)
+	ValidFrom time.Time
+	// ExpiresAt - время окончания действия ключа (нулевое - бессрочный)
+	ExpiresAt time.Time
+}
+
+// ActiveAt сообщает, действует ли ключ в момент t.
+func (k Key) ActiveAt(t time.Time) bool {
+	if !k.ValidFrom.IsZero() && t.Before(k.ValidFrom) {
+		return false
+	}
+	if !k.ExpiresAt.IsZero() && !t.Before(k.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+// Store - хранилище ключей клиентов.
+type Store interface {
+	Key(ctx context.Context, id string) (Key, bool, error)
+}
+
+// MemoryStore хранит ключи в памяти процесса. Ключи можно заменить целиком без перезапуска сервиса.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	keys map[string]Key
+}
+
+func NewMemoryStore(keys ...Key) *MemoryStore {
+	s := &MemoryStore{}
+	s.Replace(keys...)
+	return s
+}
+
+func (s *MemoryStore) Key(_ context.Context, id string) (Key, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[id]
+	return key, ok, nil
+}
+
+// Replace заменяет набор ключей хранилища.
+func (s *MemoryStore) Replace(keys ...Key) {
+	keysByID := make(map[string]Key, len(keys))
+	for _, key := range keys {
+		key.Scopes = slices.Clone(key.Scopes)
+		keysByID[key.ID] = key
+	}
+
+	s.mu.Lock()
+	s.keys = keysByID
+	s.mu.Unlock()
+}
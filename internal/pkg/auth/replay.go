@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ReplayCache хранит подписи принятых запросов до истечения окна повторной отправки. Реализация в памяти
+// защищает только от повторной отправки на тот же экземпляр сервиса; если экземпляров несколько,
+// запрос можно повторить на каждом из них, поэтому нужна реализация поверх общего хранилища.
+type ReplayCache interface {
+	// Remember запоминает подпись до момента expiresAt и возвращает false, если она уже встречалась.
+	Remember(ctx context.Context, signature string, expiresAt time.Time) (bool, error)
+}
+
+// MemoryReplayCache хранит подписи в памяти процесса.
+type MemoryReplayCache struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	cleanedAt time.Time
+}
+
+func NewMemoryReplayCache() *MemoryReplayCache {
+	return &MemoryReplayCache{
+		now:  time.Now,
+		seen: make(map[string]time.Time),
+	}
+}
+
+func (c *MemoryReplayCache) Remember(_ context.Context, signature string, expiresAt time.Time) (bool, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// устаревшие подписи удаляем не чаще раза в минуту, чтобы не обходить их при каждом запросе
+	if now.Sub(c.cleanedAt) > time.Minute {
+		for s, at := range c.seen {
+			if at.Before(now) {
+				delete(c.seen, s)
+			}
+		}
+		c.cleanedAt = now
+	}
+
+	if at, ok := c.seen[signature]; ok && !at.Before(now) {
+		return false, nil
+	}
+	c.seen[signature] = expiresAt
+
+	return true, nil
+}
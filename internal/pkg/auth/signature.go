@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Заголовки подписанного запроса.
+const (
+	HeaderKeyID     = "X-Api-Key-Id"
+	HeaderTimestamp = "X-Api-Timestamp"
+	HeaderSignature = "X-Api-Signature"
+)
+
+// Sign вычисляет подпись запроса: HMAC-SHA256 в шестнадцатеричном виде от строки
+// "<timestamp>\n<method>\n<uri>\n<body>", где timestamp - время отправки в формате UNIX Timestamp,
+// а uri - путь запроса вместе с параметрами.
+func Sign(secret []byte, timestamp int64, method, uri string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(method))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(uri))
+	mac.Write([]byte("\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,136 @@
+// Package auth предназначен для аутентификации запросов клиентов к HTTP API по подписи HMAC-SHA256.
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const defaultReplayWindow = 5 * time.Minute
+
+var (
+	// ErrUnauthenticated возвращается, если запрос не подписан или подпись неверна.
+	ErrUnauthenticated = errors.New("request is not authenticated")
+	// ErrReplayed возвращается для запроса вне окна допустимого времени или повторно отправленного запроса.
+	ErrReplayed = errors.New("request timestamp is outside the replay window or request has already been received")
+)
+
+// Request - данные HTTP-запроса, необходимые для проверки подписи.
+type Request struct {
+	// KeyID, Timestamp и Signature - значения заголовков HeaderKeyID, HeaderTimestamp и HeaderSignature
+	KeyID     string
+	Timestamp string
+	Signature string
+	// Authorization - значение заголовка Authorization для режима совместимости со статическим ключом
+	Authorization string
+	Method        string
+	URI           string
+	Body          []byte
+}
+
+// Principal - аутентифицированный клиент.
+type Principal struct {
+	ClientID string
+	// MerchantID - магазин, от имени которого действует клиент (пустое - клиент не привязан к магазину)
+	MerchantID string
+	KeyID      string
+	Scopes     []string
+	// Legacy - клиент аутентифицирован статическим ключом без подписи
+	Legacy bool
+}
+
+// HasScope сообщает, разрешена ли клиенту область доступа scope.
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, ScopeAll) || slices.Contains(p.Scopes, scope)
+}
+
+// Verifier проверяет подпись запросов по ключам из хранилища. Повторная отправка запроса
+// отсекается по времени подписи и по уже принятым подписям в пределах окна replayWindow;
+// подписи хранятся в replayCache, и защита действует в тех экземплярах сервиса, которые его разделяют.
+type Verifier struct {
+	store        Store
+	replayCache  ReplayCache
+	replayWindow time.Duration
+	legacyAPIKey string
+	now          func() time.Time
+}
+
+// NewVerifier создает проверку подписи. Непустой legacyAPIKey включает режим совместимости:
+// запрос без подписи с этим значением в заголовке Authorization получает полный доступ.
+func NewVerifier(store Store, replayCache ReplayCache, replayWindow time.Duration, legacyAPIKey string) *Verifier {
+	if replayWindow <= 0 {
+		replayWindow = defaultReplayWindow
+	}
+
+	return &Verifier{
+		store:        store,
+		replayCache:  replayCache,
+		replayWindow: replayWindow,
+		legacyAPIKey: legacyAPIKey,
+		now:          time.Now,
+	}
+}
+
+func (v *Verifier) Verify(ctx context.Context, request Request) (Principal, error) {
+	if request.KeyID == "" {
+		return v.verifyLegacy(request)
+	}
+
+	key, found, err := v.store.Key(ctx, request.KeyID)
+	if err != nil {
+		return Principal{}, fmt.Errorf("receiving key %q: %w", request.KeyID, err)
+	}
+
+	now := v.now()
+	if !found || !key.ActiveAt(now) {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	timestamp, err := strconv.ParseInt(request.Timestamp, 10, 64)
+	if err != nil {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-v.replayWindow)) || signedAt.After(now.Add(v.replayWindow)) {
+		return Principal{}, ErrReplayed
+	}
+
+	expected := Sign(key.Secret, timestamp, request.Method, request.URI, request.Body)
+	if !hmac.Equal([]byte(expected), []byte(request.Signature)) {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	// подпись действительна, пока время подписи не вышло за окно, поэтому и хранить её дольше не нужно
+	fresh, err := v.replayCache.Remember(ctx, request.KeyID+":"+request.Signature, signedAt.Add(v.replayWindow))
+	if err != nil {
+		return Principal{}, fmt.Errorf("remembering request signature: %w", err)
+	}
+	if !fresh {
+		return Principal{}, ErrReplayed
+	}
+
+	return Principal{
+		ClientID:   key.ClientID,
+		MerchantID: key.MerchantID,
+		KeyID:      key.ID,
+		Scopes:     key.Scopes,
+	}, nil
+}
+
+func (v *Verifier) verifyLegacy(request Request) (Principal, error) {
+	if v.legacyAPIKey == "" || subtle.ConstantTimeCompare([]byte(request.Authorization), []byte(v.legacyAPIKey)) != 1 {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	return Principal{
+		Scopes: []string{ScopeAll},
+		Legacy: true,
+	}, nil
+}
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifierVerify(t *testing.T) {
+	now := time.Unix(1715974447, 0)
+	secret := []byte("secret")
+
+	store := NewMemoryStore(
+		Key{ID: "active", ClientID: "client", MerchantID: "shop", Secret: secret, Scopes: []string{"operations:read"}},
+		Key{ID: "expired", ClientID: "client", Secret: secret, ExpiresAt: now},
+		Key{ID: "future", ClientID: "client", Secret: secret, ValidFrom: now.Add(time.Hour)},
+	)
+
+	signed := func(keyID string, timestamp time.Time) Request {
+		return Request{
+			KeyID:     keyID,
+			Timestamp: strconv.FormatInt(timestamp.Unix(), 10),
+			Signature: Sign(secret, timestamp.Unix(), "GET", "/api/v1/operations?id=1", nil),
+			Method:    "GET",
+			URI:       "/api/v1/operations?id=1",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		legacyAPIKey  string
+		request       func() Request
+		wantPrincipal Principal
+		wantErr       error
+	}{
+		{
+			name:    "valid signature",
+			request: func() Request { return signed("active", now) },
+			wantPrincipal: Principal{
+				ClientID:   "client",
+				MerchantID: "shop",
+				KeyID:      "active",
+				Scopes:     []string{"operations:read"},
+			},
+		},
+		{
+			name:    "unknown key",
+			request: func() Request { return signed("unknown", now) },
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:    "expired key",
+			request: func() Request { return signed("expired", now) },
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:    "key not yet valid",
+			request: func() Request { return signed("future", now) },
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name: "malformed timestamp",
+			request: func() Request {
+				r := signed("active", now)
+				r.Timestamp = "yesterday"
+				return r
+			},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:    "timestamp before replay window",
+			request: func() Request { return signed("active", now.Add(-6*time.Minute)) },
+			wantErr: ErrReplayed,
+		},
+		{
+			name:    "timestamp after replay window",
+			request: func() Request { return signed("active", now.Add(6*time.Minute)) },
+			wantErr: ErrReplayed,
+		},
+		{
+			name: "tampered body",
+			request: func() Request {
+				r := signed("active", now)
+				r.Body = []byte(`{"amount":1000}`)
+				return r
+			},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name: "tampered uri",
+			request: func() Request {
+				r := signed("active", now)
+				r.URI = "/api/v1/operations?id=2"
+				return r
+			},
+			wantErr: ErrUnauthenticated,
+		},
+		{
+			name:         "legacy key",
+			legacyAPIKey: "legacy",
+			request:      func() Request { return Request{Authorization: "legacy"} },
+			wantPrincipal: Principal{
+				Scopes: []string{ScopeAll},
+				Legacy: true,
+			},
+		},
+		{
+			name:         "wrong legacy key",
+			legacyAPIKey: "legacy",
+			request:      func() Request { return Request{Authorization: "other"} },
+			wantErr:      ErrUnauthenticated,
+		},
+		{
+			name:    "legacy mode disabled",
+			request: func() Request { return Request{Authorization: ""} },
+			wantErr: ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVerifier(store, NewMemoryReplayCache(), 5*time.Minute, tt.legacyAPIKey)
+			v.now = func() time.Time { return now }
+
+			principal, err := v.Verify(context.Background(), tt.request())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if principal.ClientID != tt.wantPrincipal.ClientID ||
+				principal.MerchantID != tt.wantPrincipal.MerchantID ||
+				principal.KeyID != tt.wantPrincipal.KeyID ||
+				principal.Legacy != tt.wantPrincipal.Legacy ||
+				!slices.Equal(principal.Scopes, tt.wantPrincipal.Scopes) {
+				t.Errorf("Verify() principal = %+v, want %+v", principal, tt.wantPrincipal)
+			}
+		})
+	}
+}
+
+func TestVerifierVerifyReplayedSignature(t *testing.T) {
+	now := time.Unix(1715974447, 0)
+	secret := []byte("secret")
+
+	replayCache := NewMemoryReplayCache()
+	replayCache.now = func() time.Time { return now }
+
+	v := NewVerifier(NewMemoryStore(Key{ID: "active", Secret: secret}), replayCache, 5*time.Minute, "")
+	v.now = func() time.Time { return now }
+
+	request := Request{
+		KeyID:     "active",
+		Timestamp: strconv.FormatInt(now.Unix(), 10),
+		Signature: Sign(secret, now.Unix(), "POST", "/api/v1/payment/create", []byte(`{}`)),
+		Method:    "POST",
+		URI:       "/api/v1/payment/create",
+		Body:      []byte(`{}`),
+	}
+
+	if _, err := v.Verify(context.Background(), request); err != nil {
+		t.Fatalf("first Verify() error = %v, want nil", err)
+	}
+	if _, err := v.Verify(context.Background(), request); !errors.Is(err, ErrReplayed) {
+		t.Fatalf("second Verify() error = %v, want %v", err, ErrReplayed)
+	}
+}
+
+func TestMemoryReplayCacheRemember(t *testing.T) {
+	now := time.Unix(1715974447, 0)
+
+	tests := []struct {
+		name      string
+		seen      map[string]time.Time
+		signature string
+		want      bool
+	}{
+		{
+			name:      "new signature",
+			seen:      map[string]time.Time{},
+			signature: "a",
+			want:      true,
+		},
+		{
+			name:      "signature within window",
+			seen:      map[string]time.Time{"a": now.Add(time.Minute)},
+			signature: "a",
+			want:      false,
+		},
+		{
+			name:      "signature with expired window",
+			seen:      map[string]time.Time{"a": now.Add(-time.Second)},
+			signature: "a",
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMemoryReplayCache()
+			c.now = func() time.Time { return now }
+			c.seen = tt.seen
+
+			got, err := c.Remember(context.Background(), tt.signature, now.Add(5*time.Minute))
+			if err != nil {
+				t.Fatalf("Remember() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Remember() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
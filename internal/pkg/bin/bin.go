@@ -0,0 +1,122 @@
+// Package bin предоставляет справочник BIN (первых цифр номера банковской карты) для определения
+// платежной системы, типа карты и страны банка-эмитента без обращения к внешним сервисам.
+package bin
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	minBINLength = 6
+	maxBINLength = 8
+)
+
+// Типы карт по источнику средств.
+const (
+	FundingDebit   = "debit"
+	FundingCredit  = "credit"
+	FundingPrepaid = "prepaid"
+)
+
+// Info - сведения о диапазоне карт с общим BIN.
+type Info struct {
+	BIN string
+	// Brand - платежная система, например "VISA" или "MIR"
+	Brand string
+	// Funding - тип карты: "debit", "credit" или "prepaid"
+	Funding string
+	// Country - страна банка-эмитента, код по ISO 3166-1 alpha-2
+	Country string
+	// Issuer - название банка-эмитента
+	Issuer string
+}
+
+// Database - справочник BIN, загружаемый из локального файла. Файл перечитывается при изменении,
+// а поиск во время перечитывания продолжает работать по прежней версии справочника.
+type Database struct {
+	path    string
+	entries atomic.Pointer[map[string]Info]
+	modTime time.Time
+}
+
+// Open загружает справочник из файла в формате CSV или JSON (формат определяется по расширению).
+func Open(path string) (*Database, error) {
+	db := &Database{
+		path: path,
+	}
+	if _, err := db.reload(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Lookup ищет сведения о карте по ее первым цифрам; при совпадении нескольких записей выбирается
+// самый длинный BIN. Номер карты целиком передавать не нужно: достаточно первых восьми цифр.
+func (db *Database) Lookup(prefix string) (Info, bool) {
+	if db == nil {
+		return Info{}, false
+	}
+
+	entries := *db.entries.Load()
+	for length := min(len(prefix), maxBINLength); length >= minBINLength; length-- {
+		if info, ok := entries[prefix[:length]]; ok {
+			return info, true
+		}
+	}
+	return Info{}, false
+}
+
+// Watch проверяет файл справочника раз в interval и перечитывает его, если файл изменился,
+// до отмены контекста. Ошибка перечитывания не сбрасывает уже загруженный справочник.
+func (db *Database) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reloaded, err := db.reload()
+			if err != nil {
+				slog.ErrorContext(ctx, "failed to reload bin database",
+					"path", db.path,
+					"error", err,
+				)
+				continue
+			}
+			if reloaded {
+				slog.InfoContext(ctx, "bin database has been reloaded",
+					"path", db.path,
+					"entries", len(*db.entries.Load()),
+				)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reload перечитывает файл, если он изменился с момента последней загрузки.
+func (db *Database) reload() (bool, error) {
+	stat, err := os.Stat(db.path)
+	if err != nil {
+		return false, err
+	}
+
+	if db.entries.Load() != nil && stat.ModTime().Equal(db.modTime) {
+		return false, nil
+	}
+
+	entries, err := load(db.path)
+	if err != nil {
+		return false, err
+	}
+
+	db.entries.Store(&entries)
+	db.modTime = stat.ModTime()
+
+	return true, nil
+}
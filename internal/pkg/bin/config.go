@@ -0,0 +1,9 @@
+package bin
+
+// Config - настройки справочника BIN.
+type Config struct {
+	// File - путь к файлу справочника в формате CSV или JSON; пустое значение отключает справочник
+	File string `yaml:"file"`
+	// ReloadInterval - период проверки файла на изменения в секундах
+	ReloadInterval int `yaml:"reload_interval"`
+}
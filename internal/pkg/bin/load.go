@@ -0,0 +1,60 @@
+package bin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gocarina/gocsv"
+)
+
+// record - строка файла справочника.
+type record struct {
+	BIN     string `csv:"bin" json:"bin"`
+	Brand   string `csv:"brand" json:"brand"`
+	Funding string `csv:"funding" json:"funding"`
+	Country string `csv:"country" json:"country"`
+	Issuer  string `csv:"issuer" json:"issuer"`
+}
+
+func load(path string) (map[string]Info, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []record
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		if err = gocsv.Unmarshal(file, &records); err != nil {
+			return nil, fmt.Errorf("unmarshal csv bin database: %w", err)
+		}
+	case ".json":
+		if err = json.NewDecoder(file).Decode(&records); err != nil {
+			return nil, fmt.Errorf("unmarshal json bin database: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unresolved bin database format: %q", ext)
+	}
+
+	entries := make(map[string]Info, len(records))
+	for i, r := range records {
+		if len(r.BIN) < minBINLength || len(r.BIN) > maxBINLength || strings.Trim(r.BIN, "0123456789") != "" {
+			return nil, fmt.Errorf("bin database row %v: bin must contain %v-%v digits, got %q", i+1, minBINLength, maxBINLength, r.BIN)
+		}
+
+		entries[r.BIN] = Info{
+			BIN:     r.BIN,
+			Brand:   strings.ToUpper(r.Brand),
+			Funding: strings.ToLower(r.Funding),
+			Country: strings.ToUpper(r.Country),
+			Issuer:  r.Issuer,
+		}
+	}
+
+	return entries, nil
+}
@@ -146,6 +146,8 @@ func ToolStatusFromProto(toolStatus pb.ToolStatus) model.ToolStatus {
 		return model.ToolStatusPendingRecovery
 	case pb.ToolStatus_REMOVED_BY_ADMINISTRATOR:
 		return model.ToolStatusRemovedByAdministrator
+	case pb.ToolStatus_EXPIRED:
+		return model.ToolStatusExpired
 	default:
 		return ""
 	}
@@ -154,6 +156,7 @@ func ToolStatusFromProto(toolStatus pb.ToolStatus) model.ToolStatus {
 func ToolFromProto(tool *pb.Tool) *model.Tool {
 	result := &model.Tool{
 		ID:             tool.GetId(),
+		MerchantID:     tool.GetMerchantId(),
 		UserID:         tool.GetUserId(),
 		ExternalMethod: tool.GetExternalMethod(),
 		Displayed:      tool.GetDisplayed(),
@@ -168,6 +171,10 @@ func ToolFromProto(tool *pb.Tool) *model.Tool {
 		result.Type = ToolTypeFromProto(tool.GetType())
 	}
 
+	if tool.ExpiresAt != nil {
+		result.ExpiresAt = time.Unix(tool.GetExpiresAt(), 0).UTC()
+	}
+
 	if tool.Details != nil {
 		result.Details = tool.Details.AsMap()
 	}
@@ -156,6 +156,8 @@ func ToolStatusToProto(toolStatus model.ToolStatus) pb.ToolStatus {
 		return pb.ToolStatus_PENDING_RECOVERY
 	case model.ToolStatusRemovedByAdministrator:
 		return pb.ToolStatus_REMOVED_BY_ADMINISTRATOR
+	case model.ToolStatusExpired:
+		return pb.ToolStatus_EXPIRED
 	default:
 		return -1
 	}
@@ -164,6 +166,7 @@ func ToolStatusToProto(toolStatus model.ToolStatus) pb.ToolStatus {
 func ToolToProto(tool *model.Tool) *pb.Tool {
 	result := &pb.Tool{
 		Id:             tool.ID,
+		MerchantId:     tool.MerchantID,
 		UserId:         tool.UserID,
 		ExternalMethod: tool.ExternalMethod,
 		Displayed:      tool.Displayed,
@@ -179,6 +182,11 @@ func ToolToProto(tool *model.Tool) *pb.Tool {
 		result.Type = &pbToolType
 	}
 
+	if !tool.ExpiresAt.IsZero() {
+		expiresAt := tool.ExpiresAt.UTC().Unix()
+		result.ExpiresAt = &expiresAt
+	}
+
 	if tool.Details != nil {
 		pbDetails, err := structpb.NewStruct(tool.Details)
 		if err == nil {
@@ -0,0 +1,29 @@
+package encryption
+
+import "fmt"
+
+// Источники мастер-ключей.
+const (
+	ProviderFile = "file"
+)
+
+// Config - настройки источника мастер-ключей.
+type Config struct {
+	// Provider - источник ключей; по умолчанию "file"
+	Provider string `yaml:"provider"`
+	// KeyFile - путь к YAML-файлу с версиями ключей для провайдера "file"
+	KeyFile string `yaml:"key_file"`
+}
+
+// NewKeyProvider создает источник мастер-ключей, выбранный в конфигурации.
+func NewKeyProvider(cfg Config) (KeyProvider, error) {
+	switch cfg.Provider {
+	case "", ProviderFile:
+		if cfg.KeyFile == "" {
+			return nil, fmt.Errorf("key file is not set")
+		}
+		return NewFileKeyProvider(cfg.KeyFile)
+	default:
+		return nil, fmt.Errorf("unresolved key provider: %q", cfg.Provider)
+	}
+}
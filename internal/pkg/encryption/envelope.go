@@ -0,0 +1,127 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Envelope - зашифрованные данные вместе с зашифрованным ключом данных и версией мастер-ключа.
+// Бинарные значения хранятся в base64, чтобы конверт можно было сохранить в JSON как есть.
+type Envelope struct {
+	KeyVersion   string `json:"key_version"`
+	EncryptedKey string `json:"encrypted_key"`
+	Ciphertext   string `json:"ciphertext"`
+}
+
+type Encrypter struct {
+	keys KeyProvider
+}
+
+func NewEncrypter(keys KeyProvider) *Encrypter {
+	return &Encrypter{
+		keys: keys,
+	}
+}
+
+// Seal шифрует plaintext новым ключом данных. Дополнительные данные aad не шифруются, но связываются
+// с конвертом: расшифровать его получится только с теми же aad.
+func (e *Encrypter) Seal(ctx context.Context, plaintext, aad []byte) (Envelope, error) {
+	masterKey, err := e.keys.Current(ctx)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("getting current master key: %w", err)
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err = rand.Read(dataKey); err != nil {
+		return Envelope{}, fmt.Errorf("generating data key: %w", err)
+	}
+
+	ciphertext, err := sealAESGCM(dataKey, plaintext, aad)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("encrypting data: %w", err)
+	}
+
+	encryptedKey, err := sealAESGCM(masterKey.Material, dataKey, []byte(masterKey.Version))
+	if err != nil {
+		return Envelope{}, fmt.Errorf("encrypting data key: %w", err)
+	}
+
+	return Envelope{
+		KeyVersion:   masterKey.Version,
+		EncryptedKey: base64.StdEncoding.EncodeToString(encryptedKey),
+		Ciphertext:   base64.StdEncoding.EncodeToString(ciphertext),
+	}, nil
+}
+
+// Open расшифровывает конверт мастер-ключом той версии, которой он был зашифрован.
+func (e *Encrypter) Open(ctx context.Context, envelope Envelope, aad []byte) ([]byte, error) {
+	masterKey, err := e.keys.Get(ctx, envelope.KeyVersion)
+	if err != nil {
+		return nil, fmt.Errorf("getting master key: %w", err)
+	}
+
+	encryptedKey, err := base64.StdEncoding.DecodeString(envelope.EncryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("decoding data key: %w", err)
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(envelope.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ciphertext: %w", err)
+	}
+
+	dataKey, err := openAESGCM(masterKey.Material, encryptedKey, []byte(masterKey.Version))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data key: %w", err)
+	}
+
+	plaintext, err := openAESGCM(dataKey, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting data: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// sealAESGCM шифрует данные в режиме AES-GCM; случайный nonce записывается перед шифротекстом.
+func sealAESGCM(key, plaintext, aad []byte) ([]byte, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func openAESGCM(key, data, aad []byte) ([]byte, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
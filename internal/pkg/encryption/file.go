@@ -0,0 +1,82 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileKeyProvider хранит мастер-ключи, загруженные из локального YAML-файла вида:
+//
+//	current_version: "2"
+//	keys:
+//	  "1": "<ключ в base64>"
+//	  "2": "<ключ в base64>"
+//
+// Для ротации в файл добавляется новый ключ и на него переключается current_version;
+// прежние ключи остаются в файле, пока ими зашифрованы сохраненные данные.
+type FileKeyProvider struct {
+	current string
+	keys    map[string][]byte
+}
+
+type keyFile struct {
+	CurrentVersion string            `yaml:"current_version"`
+	Keys           map[string]string `yaml:"keys"`
+}
+
+func NewFileKeyProvider(path string) (*FileKeyProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var kf keyFile
+	if err = yaml.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("parsing key file: %w", err)
+	}
+
+	provider := &FileKeyProvider{
+		current: kf.CurrentVersion,
+		keys:    make(map[string][]byte, len(kf.Keys)),
+	}
+
+	for version, encoded := range kf.Keys {
+		material, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decoding key %q: %w", version, err)
+		}
+		if len(material) != KeySize {
+			return nil, fmt.Errorf("key %q must be %v bytes long, got %v", version, KeySize, len(material))
+		}
+		provider.keys[version] = material
+	}
+
+	if _, ok := provider.keys[provider.current]; !ok {
+		return nil, fmt.Errorf("current key version %q is not present in key file", provider.current)
+	}
+
+	return provider, nil
+}
+
+func (p *FileKeyProvider) Current(ctx context.Context) (Key, error) {
+	return p.Get(ctx, p.current)
+}
+
+func (p *FileKeyProvider) Get(_ context.Context, version string) (Key, error) {
+	material, ok := p.keys[version]
+	if !ok {
+		return Key{}, fmt.Errorf("%w: version %q", ErrKeyNotFound, version)
+	}
+	return Key{Version: version, Material: material}, nil
+}
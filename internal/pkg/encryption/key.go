@@ -0,0 +1,27 @@
+// Package encryption реализует конвертное шифрование: данные шифруются случайным ключом данных,
+// который, в свою очередь, шифруется версионированным мастер-ключом из KeyProvider.
+package encryption
+
+import (
+	"context"
+	"errors"
+)
+
+// KeySize - размер мастер-ключа и ключа данных в байтах (AES-256).
+const KeySize = 32
+
+// ErrKeyNotFound возвращается, если провайдер не знает ключ запрошенной версии.
+var ErrKeyNotFound = errors.New("encryption key not found")
+
+// Key - мастер-ключ определенной версии.
+type Key struct {
+	Version  string
+	Material []byte
+}
+
+// KeyProvider выдает мастер-ключи. Новые данные шифруются текущим ключом, а для расшифровки
+// провайдер должен помнить все версии, которыми могли быть зашифрованы сохраненные данные.
+type KeyProvider interface {
+	Current(ctx context.Context) (Key, error)
+	Get(ctx context.Context, version string) (Key, error)
+}
@@ -25,8 +25,11 @@ const (
 	CodeObjectNotFound               Code = "object not found"
 	CodeUnresolvedStatusConflict     Code = "unresolved status for action"
 	CodeToolHasBeenRemoved           Code = "payment tool has been removed"
+	CodeToolHasExpired               Code = "payment tool has expired"
 	CodeWrongConfirmationCode        Code = "wrong confirmation code"
 	CodeConfirmationAttemptsExceeded Code = "confirmation attempts exceeded"
+	CodeBlocked                      Code = "blocked by block list"
+	CodeInvalidRequest               Code = "invalid request"
 )
 
 type Error struct {
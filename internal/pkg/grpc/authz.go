@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Имена сервисов в Common Name их клиентских сертификатов.
+const (
+	CallerGateway     = "gateway"
+	CallerReport      = "report"
+	CallerEngine      = "engine"
+	CallerIntegration = "integration"
+)
+
+// Policy ограничивает круг сервисов, которым разрешен вызов метода: ключ - полное имя метода,
+// значение - допустимые клиенты. Методы без записи и с пустым списком клиентов запрещены для всех.
+type Policy map[string][]string
+
+// Validate проверяет, что для каждого зарегистрированного на сервере метода явно указаны допустимые клиенты,
+// чтобы новый метод не оказался недоступным незаметно для разработчика.
+func (p Policy) Validate(server *grpc.Server) error {
+	var missing []string
+	for serviceName, info := range server.GetServiceInfo() {
+		for _, method := range info.Methods {
+			fullMethod := "/" + serviceName + "/" + method.Name
+			if _, ok := p[fullMethod]; !ok {
+				missing = append(missing, fullMethod)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return fmt.Errorf("access policy is not defined for methods: %v", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// allows сообщает, разрешен ли клиенту caller вызов метода fullMethod.
+func (p Policy) allows(fullMethod, caller string) bool {
+	return slices.Contains(p[fullMethod], caller)
+}
+
+type callerKey struct{}
+
+// Caller возвращает имя вызвавшего сервиса. Второе значение false означает, что mTLS выключен
+// и клиент не аутентифицирован.
+func Caller(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerKey{}).(string)
+	return caller, ok
+}
+
+// Authorize проверяет, что запрос пришел от одного из callers. Используется, когда права зависят
+// не только от метода, но и от содержимого запроса. При выключенном mTLS проверка не выполняется.
+func Authorize(ctx context.Context, callers ...string) error {
+	caller, ok := Caller(ctx)
+	if !ok || slices.Contains(callers, caller) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "caller %q is not allowed to perform this request", caller)
+}
+
+// authorizationServerInterceptor определяет клиента по проверенному сертификату и применяет к запросу policy.
+func authorizationServerInterceptor(policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		caller, err := authorize(ctx, policy, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(context.WithValue(ctx, callerKey{}, caller), req)
+	}
+}
+
+// authorizationStreamServerInterceptor применяет policy к потоковым методам, например к подписке на состояние сервиса.
+func authorizationStreamServerInterceptor(policy Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if _, err := authorize(ss.Context(), policy, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, policy Policy, fullMethod string) (string, error) {
+	caller := callerFromPeer(ctx)
+	if caller == "" {
+		return "", status.Error(codes.Unauthenticated, "client certificate is required")
+	}
+
+	if !policy.allows(fullMethod, caller) {
+		return "", status.Errorf(codes.PermissionDenied, "caller %q is not allowed to call %s", caller, fullMethod)
+	}
+
+	return caller, nil
+}
+
+func callerFromPeer(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	return certificateName(tlsInfo.State.VerifiedChains[0][0])
+}
+
+func certificateName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	healthCheckMethod = "/grpc.health.v1.Health/Check"
+	healthWatchMethod = "/grpc.health.v1.Health/Watch"
+	healthListMethod  = "/grpc.health.v1.Health/List"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	tests := []struct {
+		name    string
+		policy  Policy
+		wantErr bool
+	}{
+		{
+			name: "all methods defined",
+			policy: Policy{
+				healthCheckMethod: {CallerGateway},
+				healthWatchMethod: {CallerGateway},
+				healthListMethod:  {CallerGateway},
+			},
+		},
+		{
+			name: "method with empty callers is defined",
+			policy: Policy{
+				healthCheckMethod: {CallerGateway},
+				healthWatchMethod: {},
+				healthListMethod:  {},
+			},
+		},
+		{
+			name: "method is missing",
+			policy: Policy{
+				healthCheckMethod: {CallerGateway},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "empty policy",
+			policy:  Policy{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate(server)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	policy := Policy{
+		healthCheckMethod: {CallerGateway, CallerReport},
+		healthWatchMethod: {},
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		fullMethod string
+		wantCaller string
+		wantCode   codes.Code
+	}{
+		{
+			name:       "allowed caller",
+			ctx:        peerContext(&x509.Certificate{Subject: pkix.Name{CommonName: CallerReport}}),
+			fullMethod: healthCheckMethod,
+			wantCaller: CallerReport,
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "caller from DNS name",
+			ctx:        peerContext(&x509.Certificate{DNSNames: []string{CallerGateway}}),
+			fullMethod: healthCheckMethod,
+			wantCaller: CallerGateway,
+			wantCode:   codes.OK,
+		},
+		{
+			name:       "caller is not allowed",
+			ctx:        peerContext(&x509.Certificate{Subject: pkix.Name{CommonName: CallerIntegration}}),
+			fullMethod: healthCheckMethod,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "method with empty callers",
+			ctx:        peerContext(&x509.Certificate{Subject: pkix.Name{CommonName: CallerGateway}}),
+			fullMethod: healthWatchMethod,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "method without policy",
+			ctx:        peerContext(&x509.Certificate{Subject: pkix.Name{CommonName: CallerGateway}}),
+			fullMethod: healthListMethod,
+			wantCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "no client certificate",
+			ctx:        peerContext(nil),
+			fullMethod: healthCheckMethod,
+			wantCode:   codes.Unauthenticated,
+		},
+		{
+			name:       "no peer",
+			ctx:        context.Background(),
+			fullMethod: healthCheckMethod,
+			wantCode:   codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := authorize(tt.ctx, policy, tt.fullMethod)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("authorize() code = %v, want %v (error: %v)", code, tt.wantCode, err)
+			}
+			if caller != tt.wantCaller {
+				t.Errorf("authorize() caller = %q, want %q", caller, tt.wantCaller)
+			}
+		})
+	}
+}
+
+func peerContext(cert *x509.Certificate) context.Context {
+	var state tls.ConnectionState
+	if cert != nil {
+		state.VerifiedChains = [][]*x509.Certificate{{cert}}
+	}
+
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: state},
+	})
+}
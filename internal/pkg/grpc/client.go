@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Dial подключается к gRPC-серверу с общей цепочкой клиентских перехватчиков.
+// При выключенном в tlsCfg mTLS соединение устанавливается без шифрования.
+func Dial(target string, tlsCfg TLSConfig) (*grpc.ClientConn, error) {
+	creds, err := clientCredentials(tlsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("configuring mTLS: %w", err)
+	}
+
+	return grpc.Dial(target,
+		creds,
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(
+			requestIDClientInterceptor,
+			loggingClientInterceptor,
+			deadlineClientInterceptor(DefaultClientTimeout),
+		),
+	)
+}
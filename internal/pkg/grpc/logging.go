@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+)
+
+// healthServicePrefix - методы проверки доступности вызываются по таймеру и не журналируются
+const healthServicePrefix = "/grpc.health.v1.Health/"
+
+func loggingServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+		return handler(ctx, req)
+	}
+
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	logCall(ctx, "request has been processed", info.FullMethod, req, resp, err, time.Since(start))
+
+	return resp, err
+}
+
+func loggingClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if strings.HasPrefix(method, healthServicePrefix) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	start := time.Now()
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	logCall(ctx, "outgoing request has been processed", method, req, reply, err, time.Since(start))
+
+	return err
+}
+
+func logCall(ctx context.Context, msg, method string, req, resp any, err error, latency time.Duration) {
+	attrs := []any{
+		"method", method,
+		"request_id", RequestID(ctx),
+		"code", status.Code(err).String(),
+		"latency", latency.Milliseconds(),
+		"request", redactMessage(req),
+	}
+
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	} else {
+		attrs = append(attrs, "response", redactMessage(resp))
+	}
+
+	slog.Log(ctx, logLevel(err), msg, attrs...)
+}
+
+// logLevel отделяет сбои сервиса от ожидаемых бизнес-ошибок вроде отсутствующего объекта.
+func logLevel(err error) slog.Level {
+	if err == nil {
+		return slog.LevelInfo
+	}
+
+	if perr := perror.FromProto(err); perr != nil && perr.Code != "" {
+		return slog.LevelWarn
+	}
+
+	switch status.Code(err) {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded:
+		return slog.LevelError
+	default:
+		return slog.LevelWarn
+	}
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryServerInterceptor перехватывает панику в обработчике и возвращает клиенту codes.Internal,
+// не давая ей завершить процесс.
+func recoveryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.ErrorContext(
+				ctx,
+				"panic in gRPC handler",
+				"method", info.FullMethod,
+				"request_id", RequestID(ctx),
+				"panic", r,
+				"stack", string(debug.Stack()),
+			)
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
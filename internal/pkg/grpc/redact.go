@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+)
+
+var marshalOptions = protojson.MarshalOptions{UseProtoNames: true}
+
+// redactMessage возвращает представление сообщения для журнала, очищенное по правилам redact.Default.
+func redactMessage(msg any) any {
+	protoMsg, ok := msg.(proto.Message)
+	if !ok || protoMsg == nil {
+		return nil
+	}
+
+	data, err := marshalOptions.Marshal(protoMsg)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]any
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	return redact.Default().Value(fields)
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDMetadataKey - ключ метаданных gRPC, в котором передается идентификатор запроса
+const RequestIDMetadataKey = "x-request-id"
+
+type requestIDKey struct{}
+
+// WithRequestID возвращает контекст с идентификатором запроса.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID возвращает идентификатор запроса из контекста или пустую строку.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// requestIDServerInterceptor берет идентификатор запроса из входящих метаданных, а при его отсутствии создает новый.
+func requestIDServerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	return handler(WithRequestID(ctx, requestID), req)
+}
+
+// requestIDClientInterceptor передает идентификатор запроса из контекста в исходящие метаданные,
+// благодаря чему вся цепочка вызовов между сервисами получает один идентификатор.
+func requestIDClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	requestID := RequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.NewString()
+		ctx = WithRequestID(ctx, requestID)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, requestID)
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,54 @@
+// Package grpc содержит общие для сервисов цепочки перехватчиков gRPC-сервера и клиента.
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+type ServerOptions struct {
+	TLS TLSConfig
+	// Policy требует включенного mTLS, так как без него клиент неизвестен
+	Policy Policy
+}
+
+// NewServer создает gRPC-сервер с общей цепочкой перехватчиков. Восстановление после паники
+// стоит после метрик и журналирования, чтобы запрос с паникой учитывался в них как codes.Internal.
+func NewServer(opts ServerOptions) (*grpc.Server, error) {
+	interceptors := []grpc.UnaryServerInterceptor{
+		requestIDServerInterceptor,
+		metrics.UnaryServerInterceptor(),
+		loggingServerInterceptor,
+		recoveryServerInterceptor,
+	}
+
+	serverOpts := []grpc.ServerOption{tracing.ServerOption()}
+
+	switch {
+	case opts.TLS.IsEnabled:
+		creds, err := serverCredentials(opts.TLS)
+		if err != nil {
+			return nil, fmt.Errorf("configuring mTLS: %w", err)
+		}
+		serverOpts = append(serverOpts, creds)
+		if opts.Policy != nil {
+			interceptors = append(interceptors, authorizationServerInterceptor(opts.Policy))
+			serverOpts = append(serverOpts, grpc.ChainStreamInterceptor(authorizationStreamServerInterceptor(opts.Policy)))
+		}
+	case opts.Policy != nil && !opts.TLS.IsInsecureAllowed:
+		return nil, errors.New("access policy requires mTLS to be enabled")
+	case opts.Policy != nil:
+		slog.Default().Warn("mTLS is disabled, access policy is not enforced")
+	}
+
+	interceptors = append(interceptors, deadlineServerInterceptor(DefaultServerTimeout))
+	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(interceptors...))
+
+	return grpc.NewServer(serverOpts...), nil
+}
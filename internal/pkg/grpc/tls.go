@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TLSConfig - настройки mTLS для соединений между сервисами. Сертификаты всех сервисов подписываются
+// одним удостоверяющим центром, а имя сервиса указывается в Common Name его сертификата.
+type TLSConfig struct {
+	IsEnabled bool `yaml:"is_enabled"`
+	// IsInsecureAllowed разрешает запуск сервера без mTLS и, следовательно, без проверки прав клиентов;
+	// допустимо только для локального окружения
+	IsInsecureAllowed bool `yaml:"is_insecure_allowed"`
+	// CertFile и KeyFile - сертификат сервиса и его закрытый ключ в формате PEM
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// CAFile - сертификат удостоверяющего центра, которым проверяются сертификаты других сервисов
+	CAFile string `yaml:"ca_file"`
+	// ServerName - имя сервера в его сертификате; используется только при подключении к серверу
+	ServerName string `yaml:"server_name"`
+}
+
+func serverCredentials(cfg TLSConfig) (grpc.ServerOption, error) {
+	cert, caPool, err := loadCertificates(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	})), nil
+}
+
+func clientCredentials(cfg TLSConfig) (grpc.DialOption, error) {
+	if !cfg.IsEnabled {
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+
+	cert, caPool, err := loadCertificates(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		ServerName:   cfg.ServerName,
+		MinVersion:   tls.VersionTLS12,
+	})), nil
+}
+
+func loadCertificates(cfg TLSConfig) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("loading key pair: %w", err)
+	}
+
+	caData, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caData) {
+		return tls.Certificate{}, nil, errors.New("CA certificate file contains no valid certificates")
+	}
+
+	return cert, caPool, nil
+}
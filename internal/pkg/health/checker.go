@@ -0,0 +1,111 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const defaultCheckTimeout = 3 * time.Second
+
+// Check проверяет доступность зависимости сервиса.
+type Check func(ctx context.Context) error
+
+// Checker проверяет доступность зависимостей сервиса, от которых зависит его готовность обслуживать запросы.
+type Checker struct {
+	timeout time.Duration
+	names   []string
+	checks  map[string]Check
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+
+	return &Checker{
+		timeout: timeout,
+		checks:  make(map[string]Check),
+	}
+}
+
+func (c *Checker) Add(name string, check Check) {
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.checks[name] = check
+}
+
+// Check параллельно выполняет все проверки и возвращает ошибки недоступных зависимостей.
+func (c *Checker) Check(ctx context.Context) map[string]error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		errs = make(map[string]error)
+	)
+
+	for _, name := range c.names {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			if err := check(ctx); err != nil {
+				mu.Lock()
+				errs[name] = err
+				mu.Unlock()
+			}
+		}(name, c.checks[name])
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// Watch периодически выполняет проверки и выставляет gRPC-сервису статус обслуживания до отмены контекста.
+func (c *Checker) Watch(ctx context.Context, server *health.Server, interval time.Duration) {
+	c.update(ctx, server)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.update(ctx, server)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Checker) update(ctx context.Context, server *health.Server) {
+	status := healthpb.HealthCheckResponse_SERVING
+	if len(c.Check(ctx)) > 0 {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	server.SetServingStatus("", status)
+}
+
+// GRPCCheck проверяет готовность gRPC-сервиса через стандартный протокол grpc.health.v1.
+func GRPCCheck(conn grpc.ClientConnInterface) Check {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return err
+		}
+
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("unexpected serving status: %v", resp.GetStatus())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package health
+
+import "github.com/gofiber/fiber/v2"
+
+type response struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+// LivenessHandler сообщает, что процесс сервиса запущен и обрабатывает запросы.
+func LivenessHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(&response{Status: statusOK})
+	}
+}
+
+// ReadinessHandler сообщает, доступны ли зависимости сервиса.
+func (c *Checker) ReadinessHandler() fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		errs := c.Check(ctx.Context())
+
+		resp := &response{
+			Status: statusOK,
+			Checks: make(map[string]string, len(c.names)),
+		}
+		for _, name := range c.names {
+			resp.Checks[name] = statusOK
+			if err, ok := errs[name]; ok {
+				resp.Checks[name] = err.Error()
+			}
+		}
+
+		if len(errs) > 0 {
+			resp.Status = statusUnavailable
+			return ctx.Status(fiber.StatusServiceUnavailable).JSON(resp)
+		}
+		return ctx.JSON(resp)
+	}
+}
@@ -6,6 +6,13 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 type Client struct {
@@ -23,6 +30,19 @@ func NewClient(timeout time.Duration, transport http.RoundTripper) *Client {
 }
 
 func (c *Client) Do(request *http.Request) (*http.Response, error) {
+	ctx, span := tracing.StartSpan(request.Context(), "HTTP "+request.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(request.Method),
+			semconv.ServerAddress(request.URL.Host),
+			semconv.URLPath(request.URL.Path),
+		),
+	)
+	defer span.End()
+
+	// контекст трассировки не передаем во внешнюю систему: спан нужен только для замера вызова с нашей стороны
+	request = request.WithContext(ctx)
+
 	var body []byte
 	if request.Body != nil {
 		bodyReaderCopy, err := request.GetBody()
@@ -35,20 +55,28 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 		}
 	}
 
-	slog.Info(
+	redactor := redact.Default()
+	loggedBody := redactor.Body(body)
+
+	slog.InfoContext(
+		ctx,
 		"outgoing request",
 		"method", request.Method,
 		"url", request.URL.String(),
-		"body", string(body),
+		"body", loggedBody,
 	)
 
 	response, err := c.client.Do(request)
 	if err != nil {
-		slog.Error(
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		slog.ErrorContext(
+			ctx,
 			"request error",
 			"method", request.Method,
 			"url", request.URL.String(),
-			"body", string(body),
+			"body", loggedBody,
 			"error", err,
 		)
 		return nil, err
@@ -59,11 +87,17 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 		return nil, err
 	}
 
-	slog.Info(
+	span.SetAttributes(semconv.HTTPResponseStatusCode(response.StatusCode))
+	if response.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, response.Status)
+	}
+
+	slog.InfoContext(
+		ctx,
 		"response to outgoing request",
 		"status_code", response.StatusCode,
 		"url", request.URL.String(),
-		"body", string(responseBodyData),
+		"body", redactor.Body(responseBodyData),
 	)
 
 	response.Body = io.NopCloser(bytes.NewReader(responseBodyData))
@@ -0,0 +1,64 @@
+package lifecycle
+
+import (
+	"context"
+	"io"
+	"log"
+)
+
+type StopFunc func(ctx context.Context) error
+
+type component struct {
+	name string
+	stop StopFunc
+}
+
+// Manager останавливает компоненты сервиса в порядке их регистрации. Общее время остановки
+// ограничено дедлайном контекста: компонент, не успевший остановиться до дедлайна, больше не ожидается.
+// Компоненты после него останавливаются все без исключения с уже истекшим контекстом, чтобы освободить
+// оставшиеся ресурсы; компоненты, учитывающие контекст, в этом случае завершаются без ожидания.
+type Manager struct {
+	components []component
+}
+
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+func (m *Manager) Add(name string, stop StopFunc) {
+	m.components = append(m.components, component{
+		name: name,
+		stop: stop,
+	})
+}
+
+func (m *Manager) AddCloser(name string, closer io.Closer) {
+	m.Add(name, func(context.Context) error {
+		return closer.Close()
+	})
+}
+
+func (m *Manager) Stop(ctx context.Context) {
+	for _, c := range m.components {
+		if ctx.Err() != nil {
+			if err := c.stop(ctx); err != nil {
+				log.Printf("failed to stop %v: %v", c.name, err)
+			}
+			continue
+		}
+
+		done := make(chan error, 1)
+		go func(c component) {
+			done <- c.stop(ctx)
+		}(c)
+
+		select {
+		case err := <-done:
+			if err != nil {
+				log.Printf("failed to stop %v: %v", c.name, err)
+			}
+		case <-ctx.Done():
+			log.Printf("failed to stop %v: %v", c.name, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	Path = "/metrics"
+
+	readHeaderTimeout = 5 * time.Second
+)
+
+// Server отдает метрики в формате Prometheus на отдельном внутреннем адресе, недоступном клиентам API.
+type Server struct {
+	server *http.Server
+}
+
+func NewServer(address string) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(Path, promhttp.Handler())
+
+	return &Server{
+		server: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+	}
+}
+
+func (s *Server) Serve() error {
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+const namespace = "ecomway"
+
+const externalStatusError = "error"
+
+var (
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by route and response status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of handled gRPC requests by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	externalRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of payment system API requests by endpoint and response status.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"system", "endpoint", "status"})
+
+	externalRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "external",
+		Name:      "request_errors_total",
+		Help:      "Failed payment system API requests (transport errors and error responses) by endpoint.",
+	}, []string{"system", "endpoint"})
+
+	schedulerTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "task_duration_seconds",
+		Help:      "Duration of scheduler task runs.",
+		Buckets:   []float64{.1, .5, 1, 5, 15, 30, 60, 300, 900},
+	}, []string{"task", "trigger"})
+
+	schedulerTaskBatchSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "task_batch_size",
+		Help:      "Number of operations processed by a scheduler task run.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"task"})
+
+	schedulerTaskOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "task_operations_total",
+		Help:      "Operations processed by scheduler tasks by result.",
+	}, []string{"task", "result"})
+
+	operationsCreated = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "operation",
+		Name:      "created_total",
+		Help:      "Created operations by type and payment method.",
+	}, []string{"type", "external_method"})
+
+	operationsFinalized = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "operation",
+		Name:      "finalized_total",
+		Help:      "Operations moved to a final status by type, payment method and status.",
+	}, []string{"type", "external_method", "status"})
+)
+
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequestDuration.WithLabelValues(method, route, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+func ObserveGRPCRequest(method string, code codes.Code, duration time.Duration) {
+	grpcRequestDuration.WithLabelValues(method, code.String()).Observe(duration.Seconds())
+}
+
+// ObserveExternalRequest учитывает запрос к API платежной системы. Если запрос не был выполнен, err не равен nil.
+func ObserveExternalRequest(system, endpoint string, status int, err error, duration time.Duration) {
+	statusLabel := strconv.Itoa(status)
+	if err != nil {
+		statusLabel = externalStatusError
+	}
+
+	externalRequestDuration.WithLabelValues(system, endpoint, statusLabel).Observe(duration.Seconds())
+
+	if err != nil || status >= 400 {
+		externalRequestErrors.WithLabelValues(system, endpoint).Inc()
+	}
+}
+
+func ObserveSchedulerTaskRun(task string, trigger model.SchedulerTaskTrigger, result model.SchedulerTaskRunResult, duration time.Duration) {
+	schedulerTaskDuration.WithLabelValues(task, string(trigger)).Observe(duration.Seconds())
+	schedulerTaskBatchSize.WithLabelValues(task).Observe(float64(result.Processed))
+	schedulerTaskOperations.WithLabelValues(task, "succeeded").Add(float64(result.Succeeded))
+	schedulerTaskOperations.WithLabelValues(task, "errored").Add(float64(result.Errored))
+}
+
+func IncOperationCreated(op *model.Operation) {
+	operationsCreated.WithLabelValues(string(op.Type), op.ExternalMethod).Inc()
+}
+
+// IncOperationFinalized учитывает операцию, если она находится в итоговом статусе.
+func IncOperationFinalized(op *model.Operation) {
+	if op.Status != model.OperationStatusSuccess && op.Status != model.OperationStatusFailed {
+		return
+	}
+	operationsFinalized.WithLabelValues(string(op.Type), op.ExternalMethod, string(op.Status)).Inc()
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
 )
 
 func NewAccessLog() fiber.Handler {
@@ -15,13 +17,22 @@ func NewAccessLog() fiber.Handler {
 
 		stop := time.Now()
 
-		slog.Info("request has been processed",
+		redactor := redact.Default()
+
+		// тела маршрутов, исключенных из журнала, не пишем даже в очищенном виде
+		var requestBody, responseBody string
+		if !redactor.SkipBody(c.Route().Path) {
+			requestBody = redactor.Body(c.Request().Body())
+			responseBody = redactor.Body(c.Response().Body())
+		}
+
+		slog.InfoContext(c.UserContext(), "request has been processed",
 			"request_method", c.Method(),
 			"request_route", c.Path(),
-			"request_body", string(c.Request().Body()),
+			"request_body", requestBody,
 			"response_status", c.Response().StatusCode(),
 			"response_latency", stop.Sub(start).Milliseconds(),
-			"response_body", string(c.Response().Body()),
+			"response_body", responseBody,
 		)
 
 		return err
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+)
+
+func NewMetrics() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+
+			var fiberErr *fiber.Error
+			if errors.As(err, &fiberErr) {
+				status = fiberErr.Code
+			}
+		}
+
+		// в качестве маршрута используем шаблон пути, чтобы идентификаторы не порождали новые серии
+		metrics.ObserveHTTPRequest(c.Method(), c.Route().Path, status, time.Since(start))
+
+		return err
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+type AnalyticsGranularity string
+
+const (
+	AnalyticsGranularityHour AnalyticsGranularity = "hour"
+	AnalyticsGranularityDay  AnalyticsGranularity = "day"
+	AnalyticsGranularityWeek AnalyticsGranularity = "week"
+)
+
+type AnalyticsGroupField string
+
+const (
+	AnalyticsGroupFieldExternalSystem AnalyticsGroupField = "external_system"
+	AnalyticsGroupFieldExternalMethod AnalyticsGroupField = "external_method"
+	AnalyticsGroupFieldCurrency       AnalyticsGroupField = "currency"
+	AnalyticsGroupFieldType           AnalyticsGroupField = "type"
+)
+
+type OperationAnalyticsCriteria struct {
+	Granularity     AnalyticsGranularity
+	TimeZone        string
+	GroupBy         []AnalyticsGroupField
+	Types           *[]OperationType
+	ExternalSystems *[]string
+	MerchantID      *string
+	CreatedAtFrom   time.Time
+	CreatedAtTo     time.Time
+}
+
+type OperationAnalyticsItem struct {
+	// PeriodStart - начало временного интервала в часовом поясе, указанном в критериях
+	PeriodStart time.Time
+	// ExternalSystem, ExternalMethod, Currency и Type заполнены, только если по ним велась группировка
+	ExternalSystem string
+	ExternalMethod string
+	Currency       string
+	Type           OperationType
+
+	CreatedCount   int64
+	SucceededCount int64
+	FailedCount    int64
+	// SucceededAmount - объем успешных операций в минорных единицах валюты
+	SucceededAmount int64
+	// MedianProcessingTime - медианное время от создания операции до её завершения на стороне ПС
+	MedianProcessingTime time.Duration
+}
+
+// ConversionRate возвращает долю успешных операций среди всех созданных за интервал.
+func (i OperationAnalyticsItem) ConversionRate() float64 {
+	if i.CreatedCount == 0 {
+		return 0
+	}
+	return float64(i.SucceededCount) / float64(i.CreatedCount)
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// AuditAction - административное действие, фиксируемое в журнале аудита.
+type AuditAction string
+
+const (
+	AuditActionToolRecover           AuditAction = "TOOL_RECOVER"
+	AuditActionToolRemove            AuditAction = "TOOL_REMOVE"
+	AuditActionOperationChangeStatus AuditAction = "OPERATION_CHANGE_STATUS"
+	AuditActionBlockEntryCreate      AuditAction = "BLOCK_ENTRY_CREATE"
+	AuditActionBlockEntryUpdate      AuditAction = "BLOCK_ENTRY_UPDATE"
+	AuditActionBlockEntryDelete      AuditAction = "BLOCK_ENTRY_DELETE"
+)
+
+// AuditTargetType - тип объекта, над которым выполнено действие.
+type AuditTargetType string
+
+const (
+	AuditTargetTypeTool       AuditTargetType = "TOOL"
+	AuditTargetTypeOperation  AuditTargetType = "OPERATION"
+	AuditTargetTypeBlockEntry AuditTargetType = "BLOCK_ENTRY"
+)
+
+// AuditData - сведения об исполнителе действия, передаваемые вместе с административным запросом.
+type AuditData struct {
+	// ActorID - идентификатор специалиста, выполнившего действие
+	ActorID string
+	// Reason - причина действия, указанная специалистом
+	Reason string
+}
+
+// AuditEntry - запись журнала аудита: кто, когда и с какой причиной изменил объект, и как он выглядел до и после изменения.
+type AuditEntry struct {
+	ID         int64
+	ActorID    string
+	Action     AuditAction
+	TargetType AuditTargetType
+	TargetID   string
+	// ClientID - клиент, которому принадлежит объект
+	ClientID   string
+	MerchantID string
+	Before     map[string]string
+	After      map[string]string
+	Reason     string
+	CreatedAt  time.Time
+}
+
+type AuditEntryCriteria struct {
+	ActorID       *string
+	Action        *AuditAction
+	TargetType    *AuditTargetType
+	TargetID      *string
+	ClientID      *string
+	MerchantID    *string
+	CreatedAtFrom time.Time
+	CreatedAtTo   time.Time
+	MaxCount      int64
+}
+
+type auditEntryKey struct{}
+
+// ContextWithAuditEntry возвращает контекст с заготовкой записи журнала аудита. Хранилище операций
+// дополняет такую запись состоянием после изменения статуса и сохраняет её в той же транзакции,
+// что и само изменение. Если запись сохранена, её ID становится ненулевым.
+func ContextWithAuditEntry(ctx context.Context, entry *AuditEntry) context.Context {
+	return context.WithValue(ctx, auditEntryKey{}, entry)
+}
+
+// AuditEntryFromContext возвращает заготовку записи журнала аудита из контекста или nil.
+func AuditEntryFromContext(ctx context.Context) *AuditEntry {
+	entry, _ := ctx.Value(auditEntryKey{}).(*AuditEntry)
+	return entry
+}
+
+// OperationAuditState возвращает состояние операции, фиксируемое в журнале аудита.
+func OperationAuditState(operation *Operation) map[string]string {
+	return map[string]string{
+		"status":          string(operation.Status),
+		"external_status": string(operation.ExternalStatus),
+	}
+}
@@ -0,0 +1,169 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+// BlockEntryType - признак, по которому запись списка блокировок запрещает операции и сохранение платежных средств.
+type BlockEntryType string
+
+const (
+	BlockEntryTypeUser BlockEntryType = "USER"
+	// BlockEntryTypeCard - отпечаток банковской карты (идентификатор сохраненного платежного средства)
+	BlockEntryTypeCard BlockEntryType = "CARD"
+	// BlockEntryTypeBINRange - диапазон BIN (первых шести цифр номера) банковских карт
+	BlockEntryTypeBINRange BlockEntryType = "BIN_RANGE"
+	BlockEntryTypeWallet   BlockEntryType = "WALLET"
+	BlockEntryTypeEmail    BlockEntryType = "EMAIL"
+	// BlockEntryTypeIP - IP-адрес или подсеть в нотации CIDR
+	BlockEntryTypeIP BlockEntryType = "IP"
+)
+
+const binLength = 6
+
+// BlockEntry - запись списка блокировок магазина.
+type BlockEntry struct {
+	ID         int64
+	MerchantID string
+	Type       BlockEntryType
+	Value      string
+	// ValueTo - верхняя граница диапазона для BIN_RANGE, для остальных типов пустая
+	ValueTo string
+	Reason  string
+	// CreatedBy - идентификатор специалиста, добавившего запись
+	CreatedBy string
+	// ExpiresAt - момент, после которого запись перестает действовать; нулевое значение - бессрочно
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Active сообщает, действует ли запись в момент now.
+func (e BlockEntry) Active(now time.Time) bool {
+	return e.ExpiresAt.IsZero() || e.ExpiresAt.After(now)
+}
+
+// Normalize проверяет значение записи и приводит его к виду, в котором оно сравнивается при проверке:
+// адрес электронной почты - к нижнему регистру, IP-адрес и подсеть - к каноническому виду.
+func (e *BlockEntry) Normalize() error {
+	e.Value = strings.TrimSpace(e.Value)
+	e.ValueTo = strings.TrimSpace(e.ValueTo)
+
+	if e.Value == "" {
+		return errors.New("block entry value is empty")
+	}
+
+	if e.Type != BlockEntryTypeBINRange && e.ValueTo != "" {
+		return fmt.Errorf("value_to is allowed only for %v block entries", BlockEntryTypeBINRange)
+	}
+
+	switch e.Type {
+	case BlockEntryTypeUser, BlockEntryTypeCard, BlockEntryTypeWallet:
+	case BlockEntryTypeEmail:
+		e.Value = strings.ToLower(e.Value)
+	case BlockEntryTypeBINRange:
+		if e.ValueTo == "" {
+			e.ValueTo = e.Value
+		}
+		if !isBIN(e.Value) || !isBIN(e.ValueTo) {
+			return fmt.Errorf("BIN range bounds must consist of %v digits", binLength)
+		}
+		if e.Value > e.ValueTo {
+			return fmt.Errorf("BIN range lower bound %v is greater than upper bound %v", e.Value, e.ValueTo)
+		}
+	case BlockEntryTypeIP:
+		if strings.Contains(e.Value, "/") {
+			prefix, err := netip.ParsePrefix(e.Value)
+			if err != nil {
+				return fmt.Errorf("parse IP subnet: %w", err)
+			}
+			e.Value = prefix.Masked().String()
+		} else {
+			addr, err := netip.ParseAddr(e.Value)
+			if err != nil {
+				return fmt.Errorf("parse IP address: %w", err)
+			}
+			e.Value = addr.Unmap().String()
+		}
+	default:
+		return fmt.Errorf("unresolved block entry type: %v", e.Type)
+	}
+
+	return nil
+}
+
+func isBIN(value string) bool {
+	if len(value) != binLength {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+type BlockEntryCriteria struct {
+	ID         *int64
+	MerchantID *string
+	Type       *BlockEntryType
+	Value      *string
+	// ActiveOnly - вернуть только действующие записи
+	ActiveOnly bool
+	MaxCount   int64
+}
+
+// BlockCheckData - признаки клиента, операции и платежного средства, которые проверяются по списку блокировок.
+// Пустые признаки не проверяются.
+type BlockCheckData struct {
+	MerchantID      string
+	UserID          string
+	Email           string
+	IP              string
+	CardFingerprint string
+	CardBIN         string
+	WalletNumber    string
+}
+
+// NewBlockCheckData собирает признаки для проверки из данных операции. Адрес электронной почты берется
+// из дополнительной информации об операции, реквизиты - из платежного средства, если оно есть. IP-адрес
+// передается отдельно: gateway определяет его по соединению с учетом доверенных балансировщиков, а значение
+// из дополнительной информации задает сам клиент, поэтому оно не учитывается.
+func NewBlockCheckData(merchantID, userID, clientIP string, additional map[string]any, tool *Tool) BlockCheckData {
+	data := BlockCheckData{
+		MerchantID: merchantID,
+		UserID:     userID,
+	}
+
+	if email, _ := additional["email"].(string); email != "" {
+		data.Email = strings.ToLower(strings.TrimSpace(email))
+	}
+
+	if addr, err := netip.ParseAddr(clientIP); err == nil {
+		data.IP = addr.Unmap().String()
+	}
+
+	if tool != nil {
+		data.AddTool(tool)
+	}
+
+	return data
+}
+
+// AddTool дополняет признаки реквизитами платежного средства.
+func (d *BlockCheckData) AddTool(tool *Tool) {
+	switch tool.Type {
+	case ToolTypeBankCard:
+		d.CardFingerprint = tool.ID
+		if first6, _ := tool.Details["first6"].(string); isBIN(first6) {
+			d.CardBIN = first6
+		}
+	case ToolTypeWallet:
+		d.WalletNumber, _ = tool.Details["number"].(string)
+	}
+}
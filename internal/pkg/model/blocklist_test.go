@@ -0,0 +1,184 @@
+package model
+
+import "testing"
+
+func TestBlockEntryNormalize(t *testing.T) {
+	tests := []struct {
+		name        string
+		entry       BlockEntry
+		wantValue   string
+		wantValueTo string
+		wantErr     bool
+	}{
+		{
+			name:      "user",
+			entry:     BlockEntry{Type: BlockEntryTypeUser, Value: " 42 "},
+			wantValue: "42",
+		},
+		{
+			name:      "email is lowercased",
+			entry:     BlockEntry{Type: BlockEntryTypeEmail, Value: " Fraud@Example.COM "},
+			wantValue: "fraud@example.com",
+		},
+		{
+			name:    "empty value",
+			entry:   BlockEntry{Type: BlockEntryTypeUser, Value: "   "},
+			wantErr: true,
+		},
+		{
+			name:    "value_to for non-range type",
+			entry:   BlockEntry{Type: BlockEntryTypeCard, Value: "abc", ValueTo: "def"},
+			wantErr: true,
+		},
+		{
+			name:        "single BIN",
+			entry:       BlockEntry{Type: BlockEntryTypeBINRange, Value: "427600"},
+			wantValue:   "427600",
+			wantValueTo: "427600",
+		},
+		{
+			name:        "BIN range",
+			entry:       BlockEntry{Type: BlockEntryTypeBINRange, Value: "427600", ValueTo: " 427699 "},
+			wantValue:   "427600",
+			wantValueTo: "427699",
+		},
+		{
+			name:    "BIN range with reversed bounds",
+			entry:   BlockEntry{Type: BlockEntryTypeBINRange, Value: "427699", ValueTo: "427600"},
+			wantErr: true,
+		},
+		{
+			name:    "BIN with letters",
+			entry:   BlockEntry{Type: BlockEntryTypeBINRange, Value: "42760a"},
+			wantErr: true,
+		},
+		{
+			name:    "BIN of wrong length",
+			entry:   BlockEntry{Type: BlockEntryTypeBINRange, Value: "4276001"},
+			wantErr: true,
+		},
+		{
+			name:      "IPv4 address",
+			entry:     BlockEntry{Type: BlockEntryTypeIP, Value: "192.0.2.1"},
+			wantValue: "192.0.2.1",
+		},
+		{
+			name:      "IPv4-mapped IPv6 address",
+			entry:     BlockEntry{Type: BlockEntryTypeIP, Value: "::ffff:192.0.2.1"},
+			wantValue: "192.0.2.1",
+		},
+		{
+			name:      "subnet is masked",
+			entry:     BlockEntry{Type: BlockEntryTypeIP, Value: "192.0.2.17/24"},
+			wantValue: "192.0.2.0/24",
+		},
+		{
+			name:      "IPv6 subnet",
+			entry:     BlockEntry{Type: BlockEntryTypeIP, Value: "2001:DB8::1/32"},
+			wantValue: "2001:db8::/32",
+		},
+		{
+			name:    "invalid IP address",
+			entry:   BlockEntry{Type: BlockEntryTypeIP, Value: "300.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid subnet",
+			entry:   BlockEntry{Type: BlockEntryTypeIP, Value: "192.0.2.0/33"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			entry:   BlockEntry{Type: "PHONE", Value: "+70000000000"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := tt.entry
+
+			err := entry.Normalize()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if entry.Value != tt.wantValue || entry.ValueTo != tt.wantValueTo {
+				t.Errorf("Normalize() value = %q, value_to = %q, want %q, %q",
+					entry.Value, entry.ValueTo, tt.wantValue, tt.wantValueTo)
+			}
+		})
+	}
+}
+
+func TestNewBlockCheckData(t *testing.T) {
+	tests := []struct {
+		name       string
+		clientIP   string
+		additional map[string]any
+		tool       *Tool
+		want       BlockCheckData
+	}{
+		{
+			name: "no optional data",
+			want: BlockCheckData{MerchantID: "shop", UserID: "1"},
+		},
+		{
+			name:       "email is normalized",
+			additional: map[string]any{"email": " Client@Example.COM "},
+			want:       BlockCheckData{MerchantID: "shop", UserID: "1", Email: "client@example.com"},
+		},
+		{
+			name:       "email of wrong type is ignored",
+			additional: map[string]any{"email": 42},
+			want:       BlockCheckData{MerchantID: "shop", UserID: "1"},
+		},
+		{
+			name:     "client IP",
+			clientIP: "192.0.2.1",
+			want:     BlockCheckData{MerchantID: "shop", UserID: "1", IP: "192.0.2.1"},
+		},
+		{
+			name:     "IPv4-mapped client IP",
+			clientIP: "::ffff:192.0.2.1",
+			want:     BlockCheckData{MerchantID: "shop", UserID: "1", IP: "192.0.2.1"},
+		},
+		{
+			name:     "invalid client IP",
+			clientIP: "unknown",
+			want:     BlockCheckData{MerchantID: "shop", UserID: "1"},
+		},
+		{
+			name:       "client IP from additional data is ignored",
+			additional: map[string]any{"ip": "198.51.100.1"},
+			want:       BlockCheckData{MerchantID: "shop", UserID: "1"},
+		},
+		{
+			name: "bank card",
+			tool: &Tool{ID: "fingerprint", Type: ToolTypeBankCard, Details: map[string]any{"first6": "427600"}},
+			want: BlockCheckData{MerchantID: "shop", UserID: "1", CardFingerprint: "fingerprint", CardBIN: "427600"},
+		},
+		{
+			name: "bank card with malformed BIN",
+			tool: &Tool{ID: "fingerprint", Type: ToolTypeBankCard, Details: map[string]any{"first6": "4276**"}},
+			want: BlockCheckData{MerchantID: "shop", UserID: "1", CardFingerprint: "fingerprint"},
+		},
+		{
+			name: "wallet",
+			tool: &Tool{ID: "wallet", Type: ToolTypeWallet, Details: map[string]any{"number": "410011"}},
+			want: BlockCheckData{MerchantID: "shop", UserID: "1", WalletNumber: "410011"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewBlockCheckData("shop", "1", tt.clientIP, tt.additional, tt.tool)
+			if got != tt.want {
+				t.Errorf("NewBlockCheckData() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
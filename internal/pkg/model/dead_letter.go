@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// OperationAttempt хранит сведения о неудачных попытках фоновой обработки операции.
+// Операция, исчерпавшая лимит попыток, попадает в очередь недоставленных (dead letter)
+// и больше не обрабатывается планировщиком до ручного вмешательства.
+type OperationAttempt struct {
+	OperationID    int64
+	TaskName       string
+	Attempts       int
+	LastError      string
+	LastAttemptAt  time.Time
+	DeadLetteredAt time.Time
+
+	OperationType   OperationType
+	OperationStatus OperationStatus
+	ExternalSystem  string
+	ExternalMethod  string
+}
+
+func (a *OperationAttempt) DeadLettered() bool {
+	return !a.DeadLetteredAt.IsZero()
+}
+
+type OperationAttemptCriteria struct {
+	OperationID  *int64
+	MerchantID   *string
+	DeadLettered *bool
+	MaxCount     int64
+}
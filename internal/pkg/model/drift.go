@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// OperationStatusDrift фиксирует расхождение между статусом операции в системе и её статусом на стороне ПС.
+type OperationStatusDrift struct {
+	ID             int64
+	OperationID    int64
+	OperationType  OperationType
+	ExternalSystem string
+	ExternalMethod string
+	Status         OperationStatus
+	ExternalStatus OperationExternalStatus
+	ProviderStatus OperationExternalStatus
+	Fixed          bool
+	FixError       string
+	DetectedAt     time.Time
+}
+
+type OperationStatusDriftCriteria struct {
+	OperationID    *int64
+	MerchantID     *string
+	Fixed          *bool
+	DetectedAtFrom time.Time
+	DetectedAtTo   time.Time
+	MaxCount       int64
+}
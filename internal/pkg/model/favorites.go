@@ -10,5 +10,6 @@ type FavoritesData struct {
 	Currency       string
 	ExternalSystem string
 	ExternalMethod string
+	MerchantID     string
 	UserID         string
 }
@@ -0,0 +1,13 @@
+package model
+
+// DefaultMerchantID - магазин, к которому относятся операции, инструменты и избранное, созданные до появления
+// нескольких магазинов, а также запросы клиентов, не привязанных к конкретному магазину
+const DefaultMerchantID = "default"
+
+// MerchantIDOrDefault возвращает идентификатор магазина, подставляя магазин по умолчанию вместо пустого значения.
+func MerchantIDOrDefault(merchantID string) string {
+	if merchantID == "" {
+		return DefaultMerchantID
+	}
+	return merchantID
+}
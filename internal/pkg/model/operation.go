@@ -42,6 +42,7 @@ const (
 
 type Operation struct {
 	ID             int64
+	MerchantID     string
 	UserID         string
 	Type           OperationType
 	Currency       string
@@ -60,10 +61,16 @@ type Operation struct {
 	ConfirmationCode     string
 	ProcessedAt          time.Time
 	ConfirmationAttempts int
+	// ClientIP - IP-адрес клиента, создавшего операцию
+	ClientIP string
+	// HasFailedAttempts - у операции есть неудачные попытки фоновой обработки; заполняется только при захвате
+	// операций планировщиком
+	HasFailedAttempts bool
 }
 
 type ReportOperation struct {
 	ID             int64
+	MerchantID     string
 	UserID         string
 	Type           OperationType
 	Currency       string
@@ -84,13 +91,16 @@ type ScriptAcquiredFor func(ctx context.Context, op *Operation) error
 
 type OperationCriteria struct {
 	ID         *int64
+	MerchantID *string
 	UserID     *string
 	ExternalID *string
 
+	IDs             *[]int64
 	Types           *[]OperationType
 	Statuses        *[]OperationStatus
 	StatusesByType  map[OperationType][]OperationStatus
 	ExternalSystems *[]string
+	ExternalIDs     *[]string
 	CreatedAtFrom   time.Time
 	CreatedAtTo     time.Time
 	MaxCount        int64
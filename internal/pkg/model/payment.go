@@ -17,9 +17,12 @@ type CreatePaymentData struct {
 	Currency       string
 	LangCode       string
 	ToolID         string
+	MerchantID     string
 	UserID         string
-	Amount         int64
-	OperationID    int64
+	// ClientIP - IP-адрес клиента, определенный gateway по соединению; клиент не может его подменить
+	ClientIP    string
+	Amount      int64
+	OperationID int64
 }
 
 type CreatePaymentResult struct {
@@ -10,9 +10,12 @@ type CreatePayoutData struct {
 	Currency       string
 	LangCode       string
 	ToolID         string
+	MerchantID     string
 	UserID         string
-	Amount         int64
-	OperationID    int64
+	// ClientIP - IP-адрес клиента, определенный gateway по соединению; клиент не может его подменить
+	ClientIP    string
+	Amount      int64
+	OperationID int64
 }
 
 type CreatePayoutResult struct {
@@ -22,11 +25,14 @@ type CreatePayoutResult struct {
 	FailReason     string
 	Status         OperationStatus
 	OperationID    int64
+	// ConfirmationExpiresAt - время, до которого клиент может подтвердить выплату
+	ConfirmationExpiresAt time.Time
 }
 
 type ConfirmPayoutData struct {
 	ConfirmationCode string
 	LangCode         string
+	MerchantID       string
 	UserID           string
 	OperationID      int64
 }
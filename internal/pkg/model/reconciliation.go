@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+type ReconciliationDiscrepancyType string
+
+const (
+	// ReconciliationDiscrepancyTypeMissing - успешная операция отсутствует в реестре ПС
+	ReconciliationDiscrepancyTypeMissing ReconciliationDiscrepancyType = "MISSING"
+	// ReconciliationDiscrepancyTypeExtra - строка реестра ПС не соответствует ни одной операции
+	ReconciliationDiscrepancyTypeExtra ReconciliationDiscrepancyType = "EXTRA"
+	// ReconciliationDiscrepancyTypeAmountMismatch - сумма или валюта операции не совпадает с реестром ПС
+	ReconciliationDiscrepancyTypeAmountMismatch ReconciliationDiscrepancyType = "AMOUNT_MISMATCH"
+	// ReconciliationDiscrepancyTypeStatusMismatch - статус операции не совпадает с реестром ПС
+	ReconciliationDiscrepancyTypeStatusMismatch ReconciliationDiscrepancyType = "STATUS_MISMATCH"
+)
+
+// RegistryItem представляет строку реестра, предоставленного платежной системой.
+type RegistryItem struct {
+	ExternalID string
+	Currency   string
+	Amount     int64
+	Status     OperationExternalStatus
+}
+
+type ReconcileData struct {
+	// MerchantID - магазин, операции которого сверяются с реестром
+	MerchantID     string
+	ExternalSystem string
+	PeriodFrom     time.Time
+	PeriodTo       time.Time
+	Items          []RegistryItem
+}
+
+type ReconciliationDiscrepancy struct {
+	Type       ReconciliationDiscrepancyType
+	ExternalID string
+	// OperationID, Currency, Amount и Status заполнены, если операция найдена в системе
+	OperationID int64
+	Currency    string
+	Amount      int64
+	Status      OperationStatus
+	// RegistryCurrency, RegistryAmount и RegistryStatus заполнены, если операция найдена в реестре ПС
+	RegistryCurrency string
+	RegistryAmount   int64
+	RegistryStatus   OperationExternalStatus
+}
+
+type ReconciliationRun struct {
+	ID               int64
+	MerchantID       string
+	ExternalSystem   string
+	PeriodFrom       time.Time
+	PeriodTo         time.Time
+	RegistryCount    int64
+	MatchedCount     int64
+	DiscrepancyCount int64
+	CreatedAt        time.Time
+	Discrepancies    []ReconciliationDiscrepancy
+}
@@ -0,0 +1,53 @@
+package model
+
+import "time"
+
+type SchedulerTaskTrigger string
+
+const (
+	// SchedulerTaskTriggerSchedule - запуск фоновой задачи по расписанию
+	SchedulerTaskTriggerSchedule SchedulerTaskTrigger = "SCHEDULE"
+	// SchedulerTaskTriggerManual - внеочередной запуск фоновой задачи по запросу
+	SchedulerTaskTriggerManual SchedulerTaskTrigger = "MANUAL"
+)
+
+// SchedulerTaskSettings представляет параметры фоновой задачи, измененные во время работы сервиса.
+// Нулевые Interval и OperationBatchSize означают, что используются значения из конфигурации.
+type SchedulerTaskSettings struct {
+	IsPaused           bool
+	Interval           time.Duration
+	OperationBatchSize int64
+}
+
+type SchedulerTask struct {
+	Name               string
+	IsPaused           bool
+	Interval           time.Duration
+	OperationBatchSize int64
+	// DefaultInterval и DefaultOperationBatchSize - значения из конфигурации
+	DefaultInterval           time.Duration
+	DefaultOperationBatchSize int64
+}
+
+type SchedulerTaskRunResult struct {
+	// Processed - количество операций, взятых в обработку
+	Processed int64
+	// Succeeded - количество операций, обработанных без ошибок
+	Succeeded int64
+	// Errored - количество операций, при обработке которых произошла ошибка
+	Errored int64
+}
+
+type SchedulerTaskRun struct {
+	SchedulerTaskRunResult
+	ID         int64
+	TaskName   string
+	InstanceID string
+	Trigger    SchedulerTaskTrigger
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+func (r SchedulerTaskRun) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
+}
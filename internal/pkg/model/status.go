@@ -6,6 +6,7 @@ const (
 	OperationFailReasonTimeout                = "Timeout"
 	OperationFailReasonManual                 = "Manual"
 	OperationFailReasonWrongCodeLimitExceeded = "Code confirm attempts has been exceeded"
+	OperationFailReasonCanceled               = "Canceled by user"
 )
 
 type GetOperationStatusData struct {
@@ -16,6 +17,7 @@ type GetOperationStatusData struct {
 	Currency       string
 	OperationType  OperationType
 	OperationID    int64
+	MerchantID     string
 	UserID         string
 	Amount         int64
 }
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
 
 type ToolType string
 
@@ -16,10 +20,13 @@ const (
 	ToolStatusRemovedByClient        ToolStatus = "REMOVED_BY_CLIENT"
 	ToolStatusPendingRecovery        ToolStatus = "PENDING_RECOVERY"
 	ToolStatusRemovedByAdministrator ToolStatus = "REMOVED_BY_ADMINISTRATOR"
+	// ToolStatusExpired - у банковской карты истек срок действия
+	ToolStatusExpired ToolStatus = "EXPIRED"
 )
 
 type Tool struct {
 	ID             string
+	MerchantID     string
 	UserID         string
 	ExternalMethod string
 	Displayed      string
@@ -28,12 +35,31 @@ type Tool struct {
 	Type           ToolType
 	Details        map[string]any
 	Fake           bool
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// LegacyID - идентификатор банковской карты до перехода на отпечатки; совпадает с ID, пока отпечаток
+	// карты с зашифрованными реквизитами не вычислен
+	LegacyID string
+	// ExpiresAt - момент окончания срока действия платежного средства; нулевое значение - бессрочно
+	ExpiresAt time.Time
+	// ExpiryNotifiedAt - время отправки клиенту уведомления о скором окончании срока действия
+	ExpiryNotifiedAt time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IdentifiedBy сообщает, соответствует ли платежному средству идентификатор id, в том числе прежний.
+func (t Tool) IdentifiedBy(id string) bool {
+	return t.ID == id || (t.LegacyID != "" && t.LegacyID == id)
+}
+
+// CardFingerprint возвращает идентификатор сохраненной карты, производный от токена способа оплаты
+// в платежной системе. Та же формула используется в миграции 20241026_tool_fingerprint.
+func CardFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
 }
 
 func (t Tool) CanBeRecovered() bool {
-	return t.Status != ToolStatusRemovedByAdministrator
+	return t.Status != ToolStatusRemovedByAdministrator && t.Status != ToolStatusExpired
 }
 
 func (t Tool) Removed() bool {
@@ -41,3 +67,13 @@ func (t Tool) Removed() bool {
 		t.Status == ToolStatusRemovedByAdministrator ||
 		t.Status == ToolStatusPendingRecovery
 }
+
+func (t Tool) Expired() bool {
+	return t.Status == ToolStatusExpired
+}
+
+// CardExpiresAt возвращает момент окончания срока действия банковской карты: карта действует
+// до конца указанного на ней месяца включительно.
+func CardExpiresAt(expiryYear, expiryMonth int) time.Time {
+	return time.Date(expiryYear, time.Month(expiryMonth), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+}
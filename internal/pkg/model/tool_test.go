@@ -0,0 +1,72 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+)
+
+// fingerprintMigration - миграция, вычисляющая отпечатки уже сохраненных карт формулой
+// substr(encode(sha256(convert_to(token, 'UTF8')), 'hex'), 1, 32).
+const fingerprintMigration = "../../services/engine/migrator/migrations/20241026_tool_fingerprint.up.sql"
+
+// sqlCardFingerprint повторяет формулу миграции: первые 32 символа шестнадцатеричного SHA-256 от токена в UTF-8.
+func sqlCardFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+func TestCardFingerprint(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{
+			name:  "empty token",
+			token: "",
+			want:  "e3b0c44298fc1c149afbf4c8996fb924",
+		},
+		{
+			name:  "ascii token",
+			token: "abc",
+			want:  "ba7816bf8f01cfea414140de5dae2223",
+		},
+		{
+			name:  "payment method token",
+			token: "2dc32aa0-000f-5000-8000-16d7bc6cd09f",
+			want:  sqlCardFingerprint("2dc32aa0-000f-5000-8000-16d7bc6cd09f"),
+		},
+		{
+			name:  "non-ascii token",
+			token: "токен-карты",
+			want:  sqlCardFingerprint("токен-карты"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CardFingerprint(tt.token)
+			if got != tt.want {
+				t.Errorf("CardFingerprint(%q) = %v, want %v", tt.token, got, tt.want)
+			}
+			if sqlGot := sqlCardFingerprint(tt.token); got != sqlGot {
+				t.Errorf("CardFingerprint(%q) = %v, SQL formula gives %v", tt.token, got, sqlGot)
+			}
+		})
+	}
+}
+
+func TestCardFingerprintMigrationFormula(t *testing.T) {
+	migration, err := os.ReadFile(fingerprintMigration)
+	if err != nil {
+		t.Fatalf("reading migration: %v", err)
+	}
+
+	const formula = "substr(encode(sha256(convert_to(details ->> 'token', 'UTF8')), 'hex'), 1, 32)"
+	if !strings.Contains(string(migration), formula) {
+		t.Errorf("migration %v does not compute fingerprint as %v", fingerprintMigration, formula)
+	}
+}
@@ -0,0 +1,41 @@
+package ratelimit
+
+// Config - настройки ограничения частоты запросов по группам маршрутов.
+type Config struct {
+	IsEnabled bool `yaml:"is_enabled"`
+	// Groups - ограничения групп маршрутов; группа без настроек не ограничивается
+	Groups map[string]GroupConfig `yaml:"groups"`
+}
+
+// GroupConfig - ограничения группы маршрутов по каждому из ключей: API ключу клиента, пользователю и IP адресу.
+type GroupConfig struct {
+	PerAPIKey LimitConfig `yaml:"per_api_key"`
+	PerUser   LimitConfig `yaml:"per_user"`
+	PerIP     LimitConfig `yaml:"per_ip"`
+}
+
+type LimitConfig struct {
+	// RequestsPerMinute - средняя допустимая частота запросов; 0 - без ограничения
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+	// Burst - максимальное количество запросов подряд (по умолчанию - RequestsPerMinute)
+	Burst int `yaml:"burst"`
+}
+
+func (c LimitConfig) Limit() Limit {
+	burst := c.Burst
+	if burst <= 0 {
+		burst = c.RequestsPerMinute
+	}
+	return Limit{
+		Rate:  float64(c.RequestsPerMinute) / 60,
+		Burst: burst,
+	}
+}
+
+// Group возвращает ограничения группы маршрутов; для выключенного ограничения или неизвестной группы - пустые.
+func (c Config) Group(name string) GroupConfig {
+	if !c.IsEnabled {
+		return GroupConfig{}
+	}
+	return c.Groups[name]
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// idleBucketTTL - время, после которого неиспользуемая корзина удаляется из памяти.
+const idleBucketTTL = 10 * time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// MemoryStore хранит корзины токенов в памяти процесса.
+type MemoryStore struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	cleanedAt time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, requests ...Request) (Result, error) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// неиспользуемые корзины удаляем не чаще раза в idleBucketTTL, чтобы не обходить их при каждом запросе
+	if now.Sub(s.cleanedAt) > idleBucketTTL {
+		for k, b := range s.buckets {
+			if now.Sub(b.updatedAt) > idleBucketTTL {
+				delete(s.buckets, k)
+			}
+		}
+		s.cleanedAt = now
+	}
+
+	buckets := make([]*bucket, 0, len(requests))
+	var result Result
+	result.Allowed = true
+
+	for _, request := range requests {
+		if !request.Limit.Enabled() {
+			continue
+		}
+
+		b, ok := s.buckets[request.Key]
+		if !ok {
+			b = &bucket{
+				tokens:    float64(request.Limit.Burst),
+				updatedAt: now,
+			}
+			s.buckets[request.Key] = b
+		}
+
+		elapsed := now.Sub(b.updatedAt).Seconds()
+		b.tokens = math.Min(float64(request.Limit.Burst), b.tokens+elapsed*request.Limit.Rate)
+		b.updatedAt = now
+
+		if b.tokens < 1 {
+			result.Allowed = false
+			retryAfter := time.Duration((1 - b.tokens) / request.Limit.Rate * float64(time.Second))
+			result.RetryAfter = max(result.RetryAfter, retryAfter)
+			continue
+		}
+
+		buckets = append(buckets, b)
+	}
+
+	if !result.Allowed {
+		return result, nil
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return result, nil
+}
@@ -0,0 +1,41 @@
+// Package ratelimit предназначен для ограничения частоты запросов алгоритмом token bucket.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit - параметры корзины токенов: скорость пополнения и емкость.
+type Limit struct {
+	// Rate - количество токенов, добавляемых в корзину за секунду
+	Rate float64
+	// Burst - емкость корзины, т.е. максимальное количество запросов подряд
+	Burst int
+}
+
+// Enabled сообщает, задано ли ограничение; нулевое значение означает отсутствие ограничения.
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// Request - корзина, из которой нужно взять токен: ключ корзины и её параметры.
+type Request struct {
+	Key   string
+	Limit Limit
+}
+
+// Result - результат попытки взять токены из корзин.
+type Result struct {
+	Allowed bool
+	// RetryAfter - время, через которое токены появятся во всех корзинах (для отклоненного запроса)
+	RetryAfter time.Duration
+}
+
+// Store хранит состояние корзин. Реализация в памяти подходит для одного экземпляра сервиса;
+// для нескольких экземпляров нужна реализация поверх общего хранилища.
+type Store interface {
+	// Take берет по токену из каждой корзины, только если токены есть во всех корзинах сразу:
+	// отклоненный запрос не расходует токены корзин, проверка которых прошла успешно.
+	Take(ctx context.Context, requests ...Request) (Result, error)
+}
@@ -0,0 +1,14 @@
+package redact
+
+// Config - дополнительные правила скрытия чувствительных данных в журналах.
+type Config struct {
+	// Fields - имена полей (без учета регистра), значения которых скрываются на любом уровне вложенности
+	Fields []string `yaml:"fields"`
+	// Paths - пути в JSON документе через точку, например "payment_method.card.last4";
+	// сегмент "*" соответствует любому ключу, массивы на пути не указываются
+	Paths []string `yaml:"paths"`
+	// MaskPatterns - маскировать номера банковских карт и адреса электронной почты в строковых значениях
+	MaskPatterns bool `yaml:"mask_patterns"`
+	// SkipBodyRoutes - маршруты HTTP API, тела запросов и ответов которых не журналируются
+	SkipBodyRoutes []string `yaml:"skip_body_routes"`
+}
@@ -0,0 +1,62 @@
+package redact
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// panPattern - последовательность из 13-19 цифр, возможно разделенных пробелами или дефисами
+	panPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	// emailPattern - адрес электронной почты
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+func maskPatterns(value string) string {
+	value = panPattern.ReplaceAllStringFunc(value, maskPAN)
+	value = emailPattern.ReplaceAllStringFunc(value, maskEmail)
+	return value
+}
+
+// maskPAN оставляет первые шесть и последние четыре цифры номера, прошедшего проверку по алгоритму Луна;
+// остальные последовательности цифр (идентификаторы, суммы) не изменяются.
+func maskPAN(match string) string {
+	digits := make([]byte, 0, len(match))
+	for i := 0; i < len(match); i++ {
+		if match[i] >= '0' && match[i] <= '9' {
+			digits = append(digits, match[i])
+		}
+	}
+
+	if !luhnValid(digits) {
+		return match
+	}
+
+	return string(digits[:6]) + strings.Repeat("*", len(digits)-10) + string(digits[len(digits)-4:])
+}
+
+func luhnValid(digits []byte) bool {
+	var sum int
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// maskEmail оставляет первый символ имени пользователя и домен.
+func maskEmail(match string) string {
+	at := strings.LastIndexByte(match, '@')
+	if at <= 0 {
+		return match
+	}
+	return match[:1] + strings.Repeat("*", at-1) + match[at:]
+}
@@ -0,0 +1,184 @@
+// Package redact предназначен для скрытия чувствительных данных в журналах: кодов подтверждения,
+// реквизитов платежных средств, идентификаторов сессий и персональных данных клиентов.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+)
+
+// RedactedValue заменяет значение скрытого поля.
+const RedactedValue = "[REDACTED]"
+
+// defaultFields - поля, значения которых скрываются всегда
+var defaultFields = []string{
+	"confirmation_code",
+	"additional_data",
+	"details",
+	"email",
+	"token",
+	"session_id",
+	"card_number",
+	"number",
+	"account_number",
+	"payment_method_id",
+	"cvc",
+	"csc",
+	"password",
+	"secret",
+}
+
+// defaultPaths - пути в JSON документе, значения по которым скрываются всегда
+var defaultPaths = []string{
+	"payment_method.card.expiry_month",
+	"payment_method.card.expiry_year",
+}
+
+var defaultRedactor atomic.Pointer[Redactor]
+
+func init() {
+	defaultRedactor.Store(New(Config{MaskPatterns: true}))
+}
+
+// Default возвращает общий для процесса Redactor, которым пользуются журналы HTTP и gRPC.
+func Default() *Redactor {
+	return defaultRedactor.Load()
+}
+
+// SetDefault заменяет общий для процесса Redactor.
+func SetDefault(r *Redactor) {
+	defaultRedactor.Store(r)
+}
+
+// Redactor скрывает значения полей по имени и по пути в JSON документе, а также маскирует
+// номера банковских карт и адреса электронной почты в остальных строковых значениях.
+type Redactor struct {
+	fields       map[string]struct{}
+	paths        [][]string
+	maskPatterns bool
+	skipRoutes   map[string]struct{}
+}
+
+// New создает Redactor с правилами по умолчанию, дополненными правилами из конфигурации.
+func New(cfg Config) *Redactor {
+	r := &Redactor{
+		fields:       make(map[string]struct{}, len(defaultFields)+len(cfg.Fields)),
+		maskPatterns: cfg.MaskPatterns,
+		skipRoutes:   make(map[string]struct{}, len(cfg.SkipBodyRoutes)),
+	}
+
+	for _, field := range append(defaultFields, cfg.Fields...) {
+		r.fields[strings.ToLower(field)] = struct{}{}
+	}
+
+	for _, path := range append(defaultPaths, cfg.Paths...) {
+		path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+		if path == "" {
+			continue
+		}
+		r.paths = append(r.paths, strings.Split(path, "."))
+	}
+
+	for _, route := range cfg.SkipBodyRoutes {
+		r.skipRoutes[route] = struct{}{}
+	}
+
+	return r
+}
+
+// SkipBody сообщает, что тела запросов и ответов маршрута route не должны попадать в журнал вовсе.
+func (r *Redactor) SkipBody(route string) bool {
+	_, ok := r.skipRoutes[route]
+	return ok
+}
+
+// Body возвращает тело запроса или ответа для журнала. JSON документ разбирается и очищается по правилам;
+// тело в другом формате журналируется с маскированием номеров карт и адресов почты.
+func (r *Redactor) Body(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	// числа сохраняем как есть, чтобы суммы в журнале не теряли вид "100.00"
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return r.String(string(body))
+	}
+
+	value = r.Value(value)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return RedactedValue
+	}
+	return string(data)
+}
+
+// Value очищает разобранный JSON документ (map[string]any, []any и скалярные значения) и возвращает результат.
+func (r *Redactor) Value(value any) any {
+	return r.walk(value, nil)
+}
+
+// String маскирует номера банковских карт и адреса электронной почты в строке.
+func (r *Redactor) String(value string) string {
+	if !r.maskPatterns {
+		return value
+	}
+	return maskPatterns(value)
+}
+
+func (r *Redactor) walk(value any, path []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, nested := range v {
+			nestedPath := append(path[:len(path):len(path)], key)
+			if r.sensitive(key, nestedPath) {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = r.walk(nested, nestedPath)
+		}
+		return v
+	case []any:
+		// элементы массива проверяются по пути самого массива
+		for i, nested := range v {
+			v[i] = r.walk(nested, path)
+		}
+		return v
+	case string:
+		return r.String(v)
+	default:
+		return v
+	}
+}
+
+func (r *Redactor) sensitive(key string, path []string) bool {
+	if _, ok := r.fields[strings.ToLower(key)]; ok {
+		return true
+	}
+
+	for _, rule := range r.paths {
+		if matchPath(rule, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath сравнивает путь с правилом; сегмент "*" соответствует любому ключу.
+func matchPath(rule, path []string) bool {
+	if len(rule) != len(path) {
+		return false
+	}
+	for i := range rule {
+		if rule[i] != "*" && rule[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+package session
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Способы проверки сессий.
+const (
+	TypeJWT           = "jwt"
+	TypeIntrospection = "introspection"
+)
+
+// Config - настройки проверки сессий пользователей.
+type Config struct {
+	// Type - способ проверки: "jwt" или "introspection"
+	Type          string              `yaml:"type"`
+	JWT           JWTConfig           `yaml:"jwt"`
+	Introspection IntrospectionConfig `yaml:"introspection"`
+}
+
+type JWTConfig struct {
+	// SecretEnv - имя переменной среды окружения с общим секретом подписи токенов
+	SecretEnv string `yaml:"secret_env"`
+	// JWKSFile - путь к файлу JWK Set с открытыми ключами; используется вместо общего секрета
+	JWKSFile string `yaml:"jwks_file"`
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// Leeway - допустимое расхождение часов в секундах
+	Leeway int `yaml:"leeway"`
+}
+
+type IntrospectionConfig struct {
+	URL      string `yaml:"url"`
+	ClientID string `yaml:"client_id"`
+	// ClientSecretEnv - имя переменной среды окружения с секретом шлюза у поставщика сессий
+	ClientSecretEnv string `yaml:"client_secret_env"`
+	// Timeout - таймаут запроса интроспекции в секундах
+	Timeout int `yaml:"timeout"`
+	// CacheTTL - время хранения активной сессии в кэше в секундах
+	CacheTTL int `yaml:"cache_ttl"`
+}
+
+// NewVerifier создает проверку сессий выбранного в конфигурации типа, подгружая секреты
+// из переменных среды окружения.
+func NewVerifier(cfg Config) (Verifier, error) {
+	switch cfg.Type {
+	case TypeJWT:
+		opts := JWTOptions{
+			JWKSFile: cfg.JWT.JWKSFile,
+			Issuer:   cfg.JWT.Issuer,
+			Audience: cfg.JWT.Audience,
+			Leeway:   time.Duration(cfg.JWT.Leeway) * time.Second,
+		}
+		if cfg.JWT.SecretEnv != "" {
+			secret, exists := os.LookupEnv(cfg.JWT.SecretEnv)
+			if !exists || secret == "" {
+				return nil, fmt.Errorf("secret environment variable %q is not set", cfg.JWT.SecretEnv)
+			}
+			opts.Secret = []byte(secret)
+		}
+		return NewJWTVerifier(opts)
+	case TypeIntrospection:
+		if cfg.Introspection.URL == "" {
+			return nil, fmt.Errorf("introspection url is not set")
+		}
+		opts := IntrospectionOptions{
+			URL:      cfg.Introspection.URL,
+			ClientID: cfg.Introspection.ClientID,
+			Timeout:  time.Duration(cfg.Introspection.Timeout) * time.Second,
+			CacheTTL: time.Duration(cfg.Introspection.CacheTTL) * time.Second,
+		}
+		if cfg.Introspection.ClientSecretEnv != "" {
+			secret, exists := os.LookupEnv(cfg.Introspection.ClientSecretEnv)
+			if !exists || secret == "" {
+				return nil, fmt.Errorf("client secret environment variable %q is not set", cfg.Introspection.ClientSecretEnv)
+			}
+			opts.ClientSecret = secret
+		}
+		return NewIntrospectionVerifier(opts), nil
+	default:
+		return nil, fmt.Errorf("unresolved session verifier type: %q", cfg.Type)
+	}
+}
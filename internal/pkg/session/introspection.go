@@ -0,0 +1,180 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultIntrospectionTimeout = 5 * time.Second
+
+// IntrospectionVerifier проверяет сессию запросом к эндпоинту интроспекции поставщика сессий (RFC 7662).
+// Активные сессии кэшируются на cacheTTL, но не дольше срока их действия, чтобы не обращаться
+// к поставщику на каждый запрос клиента.
+type IntrospectionVerifier struct {
+	url          string
+	clientID     string
+	clientSecret string
+	cacheTTL     time.Duration
+	httpClient   *http.Client
+	now          func() time.Time
+
+	mu        sync.Mutex
+	cache     map[string]cachedSession
+	cleanedAt time.Time
+}
+
+type IntrospectionOptions struct {
+	URL string
+	// ClientID и ClientSecret - учетные данные шлюза у поставщика сессий (HTTP Basic)
+	ClientID     string
+	ClientSecret string
+	Timeout      time.Duration
+	// CacheTTL - время хранения активной сессии в кэше; нулевое значение отключает кэш
+	CacheTTL time.Duration
+}
+
+type cachedSession struct {
+	session     Session
+	cachedUntil time.Time
+}
+
+type introspectionResponse struct {
+	Active    bool   `json:"active"`
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+	// Roles - расширение ответа интроспекции с ролями пользователя
+	Roles []string `json:"roles"`
+}
+
+func NewIntrospectionVerifier(opts IntrospectionOptions) *IntrospectionVerifier {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultIntrospectionTimeout
+	}
+
+	// клиент pkg/http не используется намеренно: он пишет тело запроса в лог, а в нем передается идентификатор сессии
+	return &IntrospectionVerifier{
+		url:          opts.URL,
+		clientID:     opts.ClientID,
+		clientSecret: opts.ClientSecret,
+		cacheTTL:     opts.CacheTTL,
+		httpClient:   &http.Client{Timeout: opts.Timeout},
+		now:          time.Now,
+		cache:        make(map[string]cachedSession),
+	}
+}
+
+func (v *IntrospectionVerifier) Verify(ctx context.Context, sessionID string) (Session, error) {
+	if sessionID == "" {
+		return Session{}, ErrInvalidSession
+	}
+
+	if session, ok := v.cached(sessionID); ok {
+		return session, nil
+	}
+
+	response, err := v.introspect(ctx, sessionID)
+	if err != nil {
+		return Session{}, fmt.Errorf("introspecting session: %w", err)
+	}
+
+	if !response.Active || response.Subject == "" {
+		return Session{}, ErrInvalidSession
+	}
+
+	session := Session{
+		Subject: response.Subject,
+		Roles:   response.Roles,
+	}
+	if response.ExpiresAt > 0 {
+		session.ExpiresAt = time.Unix(response.ExpiresAt, 0).UTC()
+		if !v.now().Before(session.ExpiresAt) {
+			return Session{}, ErrInvalidSession
+		}
+	}
+
+	v.remember(sessionID, session)
+
+	return session, nil
+}
+
+func (v *IntrospectionVerifier) introspect(ctx context.Context, sessionID string) (introspectionResponse, error) {
+	form := url.Values{"token": {sessionID}}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return introspectionResponse{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if v.clientID != "" {
+		request.SetBasicAuth(v.clientID, v.clientSecret)
+	}
+
+	response, err := v.httpClient.Do(request)
+	if err != nil {
+		return introspectionResponse{}, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return introspectionResponse{}, fmt.Errorf("unexpected status code: %v", response.StatusCode)
+	}
+
+	var result introspectionResponse
+	if err = json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return introspectionResponse{}, fmt.Errorf("decoding response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (v *IntrospectionVerifier) cached(sessionID string) (Session, bool) {
+	if v.cacheTTL <= 0 {
+		return Session{}, false
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	entry, ok := v.cache[sessionID]
+	if !ok || !v.now().Before(entry.cachedUntil) {
+		return Session{}, false
+	}
+	return entry.session, true
+}
+
+func (v *IntrospectionVerifier) remember(sessionID string, session Session) {
+	if v.cacheTTL <= 0 {
+		return
+	}
+
+	now := v.now()
+	cachedUntil := now.Add(v.cacheTTL)
+	if !session.ExpiresAt.IsZero() && session.ExpiresAt.Before(cachedUntil) {
+		cachedUntil = session.ExpiresAt
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// истекшие записи удаляем не чаще раза в cacheTTL, чтобы не обходить кэш при каждом запросе
+	if now.Sub(v.cleanedAt) > v.cacheTTL {
+		for id, entry := range v.cache {
+			if !now.Before(entry.cachedUntil) {
+				delete(v.cache, id)
+			}
+		}
+		v.cleanedAt = now
+	}
+
+	v.cache[sessionID] = cachedSession{
+		session:     session,
+		cachedUntil: cachedUntil,
+	}
+}
@@ -0,0 +1,116 @@
+package session
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const defaultJWTLeeway = 30 * time.Second
+
+// JWTVerifier проверяет сессию, переданную в виде JWT: подпись, срок действия, издателя и получателя токена.
+// Подпись проверяется общим секретом (HS256, HS384, HS512) или открытыми ключами из JWKS (RS*, PS*, ES*).
+type JWTVerifier struct {
+	keyFunc jwt.Keyfunc
+	parser  *jwt.Parser
+}
+
+// sessionClaims - утверждения токена сессии; роли передаются в нестандартном утверждении roles.
+type sessionClaims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+type JWTOptions struct {
+	// Secret - общий секрет для проверки подписи HMAC
+	Secret []byte
+	// JWKSFile - путь к файлу JWK Set с открытыми ключами поставщика сессий
+	JWKSFile string
+	// Issuer и Audience - ожидаемые значения iss и aud; пустое значение не проверяется
+	Issuer   string
+	Audience string
+	// Leeway - допустимое расхождение часов с поставщиком сессий
+	Leeway time.Duration
+}
+
+// NewJWTVerifier создает проверку JWT. Должен быть задан ровно один из источников ключей: Secret или JWKSFile.
+func NewJWTVerifier(opts JWTOptions) (*JWTVerifier, error) {
+	if (len(opts.Secret) == 0) == (opts.JWKSFile == "") {
+		return nil, errors.New("exactly one of secret or JWKS file must be set")
+	}
+
+	if opts.Leeway <= 0 {
+		opts.Leeway = defaultJWTLeeway
+	}
+
+	parserOpts := []jwt.ParserOption{
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(opts.Leeway),
+	}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	var keyFunc jwt.Keyfunc
+	if len(opts.Secret) > 0 {
+		secret := opts.Secret
+		keyFunc = func(*jwt.Token) (any, error) {
+			return secret, nil
+		}
+		parserOpts = append(parserOpts, jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}))
+	} else {
+		keys, err := loadJWKS(opts.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading JWKS: %w", err)
+		}
+		keyFunc = jwksKeyFunc(keys)
+		parserOpts = append(parserOpts, jwt.WithValidMethods([]string{
+			"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512",
+		}))
+	}
+
+	return &JWTVerifier{
+		keyFunc: keyFunc,
+		parser:  jwt.NewParser(parserOpts...),
+	}, nil
+}
+
+func (v *JWTVerifier) Verify(_ context.Context, sessionID string) (Session, error) {
+	var claims sessionClaims
+	if _, err := v.parser.ParseWithClaims(sessionID, &claims, v.keyFunc); err != nil {
+		return Session{}, fmt.Errorf("%w: %v", ErrInvalidSession, err)
+	}
+
+	if claims.Subject == "" {
+		return Session{}, fmt.Errorf("%w: token has no subject", ErrInvalidSession)
+	}
+
+	return Session{
+		Subject:   claims.Subject,
+		ExpiresAt: claims.ExpiresAt.Time,
+		Roles:     claims.Roles,
+	}, nil
+}
+
+// jwksKeyFunc выбирает ключ по заголовку kid; если kid не указан, а ключ в наборе один, используется он.
+func jwksKeyFunc(keys map[string]crypto.PublicKey) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := keys[kid]; ok {
+			return key, nil
+		}
+		if kid == "" && len(keys) == 1 {
+			for _, key := range keys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key id: %q", kid)
+	}
+}
@@ -0,0 +1,36 @@
+// Package session предназначен для проверки сессий пользователей, от имени которых выполняются запросы к HTTP API.
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrInvalidSession возвращается, если сессия не найдена, истекла или подпись токена сессии неверна.
+var ErrInvalidSession = errors.New("session is invalid or expired")
+
+// Session - проверенная сессия пользователя.
+type Session struct {
+	// Subject - идентификатор пользователя, которому принадлежит сессия
+	Subject string
+	// ExpiresAt - время окончания действия сессии (нулевое - время не указано поставщиком сессий)
+	ExpiresAt time.Time
+	// Roles - роли пользователя, выданные поставщиком сессий
+	Roles []string
+}
+
+// HasRole сообщает, выдана ли пользователю роль role.
+func (s Session) HasRole(role string) bool {
+	for _, r := range s.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Verifier проверяет сессию по её идентификатору, переданному клиентом.
+type Verifier interface {
+	Verify(ctx context.Context, sessionID string) (Session, error)
+}
@@ -0,0 +1,112 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ExporterNone - спаны создаются (идентификаторы трассировки попадают в журнал), но никуда не отправляются
+	ExporterNone = "none"
+	// ExporterOTLP - спаны отправляются в OTLP-коллектор по gRPC
+	ExporterOTLP = "otlp"
+)
+
+const (
+	serviceNamespace    = "ecomway"
+	instrumentationName = "github.com/tmrrwnxtsn/ecomway"
+)
+
+// Config - настройки трассировки
+type Config struct {
+	// Exporter - способ экспорта спанов: ExporterNone (по умолчанию) или ExporterOTLP
+	Exporter string `yaml:"exporter"`
+	// Endpoint - адрес OTLP-коллектора, например "otel-collector:4317"
+	Endpoint string `yaml:"endpoint"`
+	// Insecure - подключаться к коллектору без TLS
+	Insecure bool `yaml:"insecure"`
+	// SampleRatio - доля сохраняемых трассировок в диапазоне (0; 1]; 0 - сохранять все
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// Init настраивает глобальный TracerProvider и W3C-пропагацию контекста трассировки для сервиса serviceName.
+// Возвращаемая функция отправляет накопленные спаны и останавливает экспорт.
+func Init(ctx context.Context, serviceName string, cfg Config) (func(ctx context.Context) error, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceNamespace(serviceNamespace),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("creating resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(newSampler(cfg.SampleRatio)),
+	}
+
+	switch cfg.Exporter {
+	case "", ExporterNone:
+	case ExporterOTLP:
+		exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(opts...)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
+
+func newSampler(ratio float64) sdktrace.Sampler {
+	if ratio <= 0 || ratio >= 1 {
+		return sdktrace.ParentBased(sdktrace.AlwaysSample())
+	}
+	return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))
+}
+
+// StartSpan создает дочерний спан для ctx.
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// TraceID возвращает идентификатор трассировки из ctx или пустую строку, если трассировки нет.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// StartStorageSpan создает спан обращения к базе данных PostgreSQL.
+func StartStorageSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return StartSpan(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+}
@@ -6,6 +6,11 @@ const defaultLang = "ru"
 
 const (
 	KeyInvalidAPIKey            = "INVALID_API_KEY"
+	KeyInsufficientScope        = "INSUFFICIENT_SCOPE"
+	KeyInvalidSession           = "INVALID_SESSION"
+	KeySessionUserMismatch      = "SESSION_USER_MISMATCH"
+	KeyInsufficientPermissions  = "INSUFFICIENT_PERMISSIONS"
+	KeyTooManyRequests          = "TOO_MANY_REQUESTS"
 	KeyPaymentSuccessful        = "PAYMENT_SUCCESSFUL"
 	KeyPaymentRejected          = "PAYMENT_REJECTED"
 	KeyObjectNotFound           = "OBJECT_NOT_FOUND"
@@ -18,6 +23,8 @@ const (
 	KeyToolRecovered            = "TOOL_RECOVERED"
 	KeyUnresolvedStatusConflict = "UNRESOLVED_STATUS_CONFLICT"
 	KeyForbiddenOnRemovedTool   = "FORBIDDEN_ON_REMOVED_TOOL"
+	KeyForbiddenOnExpiredTool   = "FORBIDDEN_ON_EXPIRED_TOOL"
+	KeyOperationBlocked         = "OPERATION_BLOCKED"
 	KeyWrongConfirmationCode    = "WRONG_CONFIRMATION_CODE"
 	KeyWrongCodeLimitExceeded   = "WRONG_CODE_LIMIT_EXCEEDED"
 	KeyPayoutConfirmed          = "PAYOUT_CONFIRMED"
@@ -31,6 +38,11 @@ func NewTranslator(lang ...string) *Translator {
 	translations := map[string]map[string]string{
 		"en": {
 			KeyInvalidAPIKey:            "Authorization header must contain valid API key.",
+			KeyInsufficientScope:        "API key is not allowed to perform this request.",
+			KeyInvalidSession:           "Session is invalid or expired. Please sign in again.",
+			KeySessionUserMismatch:      "Session belongs to another user.",
+			KeyInsufficientPermissions:  "Your role is not allowed to perform this action.",
+			KeyTooManyRequests:          "Too many requests. Please try again later.",
 			KeyPaymentSuccessful:        "Payment successful!",
 			KeyPaymentRejected:          "Payment rejected.",
 			KeyObjectNotFound:           "Object not found.",
@@ -43,12 +55,19 @@ func NewTranslator(lang ...string) *Translator {
 			KeyToolRecovered:            "Payment tool is ready for recovery.",
 			KeyUnresolvedStatusConflict: "Not able to perform the action for the object status.",
 			KeyForbiddenOnRemovedTool:   "Not able to perform action on restricted payment tool.",
+			KeyForbiddenOnExpiredTool:   "Payment tool has expired. Please add a new one.",
+			KeyOperationBlocked:         "The operation is not available. Please contact support.",
 			KeyWrongConfirmationCode:    "Wrong confirmation code.",
 			KeyWrongCodeLimitExceeded:   "The maximum number of possible confirmation attempts has been exceeded. The payout was rejected.",
 			KeyPayoutConfirmed:          "Payout successfully confirmed.",
 		},
 		"ru": {
 			KeyInvalidAPIKey:            "Заголовок авторизации должен содержать корректный API ключ.",
+			KeyInsufficientScope:        "API ключу не разрешено выполнение этого запроса.",
+			KeyInvalidSession:           "Сессия недействительна или истекла. Выполните вход повторно.",
+			KeySessionUserMismatch:      "Сессия принадлежит другому пользователю.",
+			KeyInsufficientPermissions:  "Вашей роли не разрешено выполнение этого действия.",
+			KeyTooManyRequests:          "Слишком много запросов. Повторите попытку позже.",
 			KeyPaymentSuccessful:        "Оплата успешна!",
 			KeyPaymentRejected:          "Оплата неуспешна.",
 			KeyObjectNotFound:           "Искомый объект не найден.",
@@ -61,6 +80,8 @@ func NewTranslator(lang ...string) *Translator {
 			KeyToolRecovered:            "Платежное средство готово для восстановления.",
 			KeyUnresolvedStatusConflict: "Целевое действие невозможно для данного статуса объекта.",
 			KeyForbiddenOnRemovedTool:   "Невозможно осуществить операцию с использованием удаленного платежного средства.",
+			KeyForbiddenOnExpiredTool:   "Срок действия платежного средства истек. Пожалуйста, добавьте новое.",
+			KeyOperationBlocked:         "Операция недоступна. Пожалуйста, обратитесь в службу поддержки.",
 			KeyWrongConfirmationCode:    "Неверный код подтверждения.",
 			KeyWrongCodeLimitExceeded:   "Превышено максимальное количество возможных попыток для подтверждения. Выплата отклонена.",
 			KeyPayoutConfirmed:          "Вывод средств успешно подтвержден.",
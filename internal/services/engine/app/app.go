@@ -2,47 +2,72 @@ package app
 
 import (
 	"context"
-	"io"
+	"fmt"
 	"log"
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
 	pbIntegration "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/encryption"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	pkghealth "github.com/tmrrwnxtsn/ecomway/internal/pkg/health"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/lifecycle"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/client/integration"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/client/smtp"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/config"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/migrator"
+	attemptrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/attempt"
+	auditrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/audit"
+	blocklistrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/blocklist"
+	driftrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/drift"
 	oprepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/operation"
+	reconrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/reconciliation"
+	taskrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/task"
 	toolrepo "github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/tool"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/user"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/scheduler"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/server"
+	auditservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/audit"
+	blocklistservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/blocklist"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/deadletter"
+	driftservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/drift"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/favorites"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/limit"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/method"
 	opservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/operation"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/payment"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/payout"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/payout/confirmation"
+	reconservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/reconciliation"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/status"
+	taskservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/task"
 	toolservice "github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/tool"
 )
 
+// healthCheckInterval - периодичность проверки зависимостей для gRPC health-сервиса
+const healthCheckInterval = 10 * time.Second
+
 type App struct {
-	srv     *server.Server
-	storage *pgxpool.Pool
-	closers []io.Closer
+	srv           *server.Server
+	metricsServer *metrics.Server
+	lifecycle     *lifecycle.Manager
 }
 
 func New(configPath string) *App {
 	ctx := context.Background()
 
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})))
 	slog.SetDefault(logger)
 
 	cfg, err := config.Load(configPath)
@@ -50,6 +75,13 @@ func New(configPath string) *App {
 		log.Fatalf("loading config: %v", err)
 	}
 
+	redact.SetDefault(redact.New(cfg.Engine.Redaction))
+
+	shutdownTracing, err := tracing.Init(ctx, "engine", cfg.Engine.Tracing)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+
 	env := cfg.Engine.Environment
 
 	postgresMigrator, err := migrator.NewPostgresMigrator(cfg.Engine.Storage.DatabaseURL)
@@ -73,7 +105,7 @@ func New(configPath string) *App {
 		log.Fatalf("listening tcp on %v: %v", cfg.Engine.GRPCAddress, err)
 	}
 
-	integrationConn, err := grpc.Dial(cfg.Services.Integration.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	integrationConn, err := pkggrpc.Dial(cfg.Services.Integration.GRPCAddress, cfg.Services.Integration.TLS)
 	if err != nil {
 		log.Fatalf("connecting integration service: %v", err)
 	}
@@ -87,64 +119,177 @@ func New(configPath string) *App {
 	operationRepository := oprepo.NewRepository(postgresConn)
 	toolRepository := toolrepo.NewRepository(postgresConn)
 	userRepository := user.NewRepository(postgresConn)
+	reconciliationRepository := reconrepo.NewRepository(postgresConn)
+	driftRepository := driftrepo.NewRepository(postgresConn)
+	taskRepository := taskrepo.NewRepository(postgresConn)
+	attemptRepository := attemptrepo.NewRepository(postgresConn)
+	auditRepository := auditrepo.NewRepository(postgresConn)
+	blockListRepository := blocklistrepo.NewRepository(postgresConn)
+
+	var toolEncrypter *encryption.Encrypter
+	if cfg.Engine.ToolEncryption.IsEnabled {
+		keyProvider, err := encryption.NewKeyProvider(cfg.Engine.ToolEncryption.Keys)
+		if err != nil {
+			log.Fatalf("creating tool encryption key provider: %v", err)
+		}
+		toolEncrypter = encryption.NewEncrypter(keyProvider)
+	}
+	toolCipher := toolservice.NewDetailsCipher(toolEncrypter, cfg.Engine.ToolEncryption.SensitiveDetails)
 
 	methodService := method.NewService(integrationClient)
 	limitService := limit.NewService()
-	paymentService := payment.NewService(operationRepository, integrationClient, toolRepository)
-	toolService := toolservice.NewService(toolRepository)
+	blockListService := blocklistservice.NewService(blockListRepository)
+	paymentService := payment.NewService(operationRepository, integrationClient, toolRepository, toolCipher, blockListService)
+	toolService := toolservice.NewService(toolRepository, toolCipher, operationRepository, smtpClient)
+	// отпечатки карт с зашифрованными реквизитами вычисляются до начала обслуживания запросов
+	if err = toolService.MigrateFingerprints(ctx); err != nil {
+		log.Fatalf("migrating tool fingerprints: %v", err)
+	}
 	payoutService := payout.NewService(
 		operationRepository,
 		integrationClient,
 		toolRepository,
+		toolCipher,
+		blockListService,
 		smtpClient,
+		confirmation.NewTTL(cfg.Engine.PayoutConfirmation.DefaultTTLMin, cfg.Engine.PayoutConfirmation.TTLMin),
 		cfg.Engine.WrongConfirmationCodeLimit,
 		env == "dev",
 	)
 	operationService := opservice.NewService(operationRepository)
 	favoritesService := favorites.NewService(userRepository)
+	reconciliationService := reconservice.NewService(reconciliationRepository, operationRepository)
+	statusService := status.NewService(operationService, paymentService, payoutService, toolService)
+	driftService := driftservice.NewService(driftRepository)
+	auditService := auditservice.NewService(auditRepository)
+	taskService := taskservice.NewService(taskRepository)
+	deadLetterService := deadletter.NewService(
+		attemptRepository,
+		statusService,
+		deadletter.NewWebhookAlerter(cfg.Engine.Scheduler.DeadLetter.AlertWebhookURL),
+	)
 
-	if cfg.Engine.Scheduler.IsEnabled {
-		var tasks []scheduler.BackgroundTask
+	var tasks []scheduler.BackgroundTask
+
+	instanceID := cfg.Engine.Scheduler.InstanceID
+	if instanceID == "" {
+		hostname, _ := os.Hostname()
+		instanceID = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
 
+	if cfg.Engine.Scheduler.IsEnabled {
 		if cfg.Engine.Scheduler.Tasks.FinalizeOperations.IsEnabled {
 			tasks = append(tasks, scheduler.NewFinalizeOperationsTask(
 				cfg.Engine.Scheduler.Tasks.FinalizeOperations,
+				instanceID,
 				operationService,
 				integrationClient,
 				paymentService,
 				payoutService,
+				toolService,
+				deadLetterService,
 			))
 		}
 		if cfg.Engine.Scheduler.Tasks.RequestPayouts.IsEnabled {
 			tasks = append(tasks, scheduler.NewRequestPayoutsTask(
 				cfg.Engine.Scheduler.Tasks.RequestPayouts,
+				instanceID,
 				operationService,
 				payoutService,
+				deadLetterService,
+			))
+		}
+		if cfg.Engine.Scheduler.Tasks.DetectStatusDrift.IsEnabled {
+			tasks = append(tasks, scheduler.NewDetectStatusDriftTask(
+				cfg.Engine.Scheduler.Tasks.DetectStatusDrift,
+				instanceID,
+				operationService,
+				integrationClient,
+				driftService,
+				statusService,
+			))
+		}
+		if cfg.Engine.Scheduler.Tasks.TrackToolExpiry.IsEnabled {
+			tasks = append(tasks, scheduler.NewTrackToolExpiryTask(
+				cfg.Engine.Scheduler.Tasks.TrackToolExpiry,
+				toolService,
+			))
+		}
+		if cfg.Engine.Scheduler.Tasks.SealToolDetails.IsEnabled {
+			tasks = append(tasks, scheduler.NewSealToolDetailsTask(
+				cfg.Engine.Scheduler.Tasks.SealToolDetails,
+				toolService,
 			))
 		}
-
-		scheduler.NewScheduler(tasks...).Start(ctx)
 	}
 
-	grpcServer := grpc.NewServer()
+	taskScheduler := scheduler.NewScheduler(instanceID, taskService, tasks...)
+	taskScheduler.Start(ctx)
+
+	healthChecker := pkghealth.NewChecker(0)
+	healthChecker.Add("postgres", postgresConn.Ping)
+	healthChecker.Add("integration", pkghealth.GRPCCheck(integrationConn))
+	healthChecker.Add("smtp", smtpClient.Ping)
+
+	healthServer := health.NewServer()
+	healthCtx, stopHealthCheck := context.WithCancel(ctx)
+	go healthChecker.Watch(healthCtx, healthServer, healthCheckInterval)
+
+	grpcServer, err := pkggrpc.NewServer(pkggrpc.ServerOptions{
+		TLS:    cfg.Engine.TLS,
+		Policy: server.CallerPolicy,
+	})
+	if err != nil {
+		log.Fatalf("creating grpc server: %v", err)
+	}
 	srv := server.NewServer(server.Options{
-		Server:            grpcServer,
-		Listener:          grpcListener,
-		MethodService:     methodService,
-		LimitService:      limitService,
-		PaymentService:    paymentService,
-		ToolService:       toolService,
-		PayoutService:     payoutService,
-		OperationService:  operationService,
-		FavoritesService:  favoritesService,
-		IntegrationClient: integrationClient,
+		Server:                grpcServer,
+		Listener:              grpcListener,
+		MethodService:         methodService,
+		LimitService:          limitService,
+		PaymentService:        paymentService,
+		ToolService:           toolService,
+		PayoutService:         payoutService,
+		OperationService:      operationService,
+		StatusService:         statusService,
+		DriftService:          driftService,
+		FavoritesService:      favoritesService,
+		ReconciliationService: reconciliationService,
+		SchedulerService:      taskScheduler,
+		DeadLetterService:     deadLetterService,
+		AuditService:          auditService,
+		BlockListService:      blockListService,
+		IntegrationClient:     integrationClient,
 	})
 	pbEngine.RegisterEngineServiceServer(grpcServer, srv)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if err = server.CallerPolicy.Validate(grpcServer); err != nil {
+		log.Fatalf("validating grpc access policy: %v", err)
+	}
+
+	metricsServer := metrics.NewServer(cfg.Engine.MetricsAddress)
+
+	lifecycleManager := lifecycle.NewManager()
+	lifecycleManager.Add("health check", func(context.Context) error {
+		stopHealthCheck()
+		healthServer.Shutdown()
+		return nil
+	})
+	lifecycleManager.Add("scheduler", taskScheduler.Stop)
+	lifecycleManager.AddCloser("grpc server", srv)
+	lifecycleManager.Add("metrics server", metricsServer.Shutdown)
+	lifecycleManager.AddCloser("integration connection", integrationConn)
+	lifecycleManager.AddCloser("smtp client", smtpClient)
+	lifecycleManager.Add("storage", func(context.Context) error {
+		postgresConn.Close()
+		return nil
+	})
+	lifecycleManager.Add("tracing", shutdownTracing)
 
 	return &App{
-		srv:     srv,
-		storage: postgresConn,
-		closers: []io.Closer{srv, integrationConn, smtpClient},
+		srv:           srv,
+		metricsServer: metricsServer,
+		lifecycle:     lifecycleManager,
 	}
 }
 
@@ -155,17 +300,15 @@ func (a *App) Start() {
 		return a.srv.Serve()
 	})
 
+	group.Go(func() error {
+		return a.metricsServer.Serve()
+	})
+
 	if err := group.Wait(); err != nil {
 		log.Fatalf("app: %v", err)
 	}
 }
 
-func (a *App) Stop(_ context.Context) {
-	for _, c := range a.closers {
-		if err := c.Close(); err != nil {
-			log.Printf("failed to close resource: %v", err)
-		}
-	}
-
-	a.storage.Close()
+func (a *App) Stop(ctx context.Context) {
+	a.lifecycle.Stop(ctx)
 }
@@ -8,20 +8,22 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
-func (c *Client) AllAvailableMethods(ctx context.Context, opType model.OperationType, currency string) ([]model.Method, error) {
+func (c *Client) AllAvailableMethods(ctx context.Context, opType model.OperationType, merchantID, currency string) ([]model.Method, error) {
 	request := &pb.AvailableMethodsRequest{
 		OperationType: convert.OperationTypeToProto(opType),
 		Currency:      currency,
+		MerchantId:    merchantID,
 	}
 
 	return c.availableMethods(ctx, request)
 }
 
-func (c *Client) AvailableMethodsByExternalSystem(ctx context.Context, opType model.OperationType, currency, externalSystem string) ([]model.Method, error) {
+func (c *Client) AvailableMethodsByExternalSystem(ctx context.Context, opType model.OperationType, merchantID, currency, externalSystem string) ([]model.Method, error) {
 	request := &pb.AvailableMethodsRequest{
 		OperationType:  convert.OperationTypeToProto(opType),
 		Currency:       currency,
 		ExternalSystem: &externalSystem,
+		MerchantId:     merchantID,
 	}
 
 	return c.availableMethods(ctx, request)
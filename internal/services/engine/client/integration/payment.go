@@ -22,6 +22,7 @@ func (c *Client) CreatePayment(ctx context.Context, data model.CreatePaymentData
 	request := &pb.CreatePaymentRequest{
 		OperationId:    data.OperationID,
 		UserId:         data.UserID,
+		MerchantId:     data.MerchantID,
 		LangCode:       data.LangCode,
 		ExternalSystem: data.ExternalSystem,
 		ExternalMethod: data.ExternalMethod,
@@ -22,6 +22,7 @@ func (c *Client) CreatePayout(ctx context.Context, data model.CreatePayoutData)
 	request := &pb.CreatePayoutRequest{
 		OperationId:    data.OperationID,
 		UserId:         data.UserID,
+		MerchantId:     data.MerchantID,
 		LangCode:       data.LangCode,
 		ExternalSystem: data.ExternalSystem,
 		ExternalMethod: data.ExternalMethod,
@@ -16,6 +16,7 @@ func (c *Client) GetOperationStatus(ctx context.Context, data model.GetOperation
 		OperationId:    data.OperationID,
 		OperationType:  convert.OperationTypeToProto(data.OperationType),
 		UserId:         data.UserID,
+		MerchantId:     data.MerchantID,
 		ExternalSystem: data.ExternalSystem,
 		ExternalMethod: data.ExternalMethod,
 		Amount:         data.Amount,
@@ -1,13 +1,17 @@
 package smtp
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"sync"
 
 	mail "github.com/xhit/go-simple-mail/v2"
 )
 
 type Client struct {
+	// mu защищает соединение с SMTP-сервером, которое не поддерживает конкурентное использование
+	mu         sync.Mutex
 	smtpServer *mail.SMTPServer
 	smtpClient *mail.SMTPClient
 	username   string
@@ -53,6 +57,9 @@ func (c *Client) SendEmail(to, subject, body string) error {
 		return fmt.Errorf("sending email through SMTP: %v", emailMsg.Error)
 	}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if err := emailMsg.Send(c.smtpClient); err != nil {
 		return fmt.Errorf("sending email through SMTP: %v", err)
 	}
@@ -60,6 +67,14 @@ func (c *Client) SendEmail(to, subject, body string) error {
 	return nil
 }
 
+// Ping проверяет, что соединение с SMTP-сервером активно.
+func (c *Client) Ping(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.smtpClient.Noop()
+}
+
 func (c *Client) Close() error {
 	return c.smtpClient.Close()
 }
@@ -5,11 +5,17 @@ import (
 	"os"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/encryption"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 const (
 	databaseURLEnvKey  = "DATABASE_URL"
 	smtpPasswordEnvKey = "SMTP_PASSWORD"
+	instanceIDEnvKey   = "SCHEDULER_INSTANCE_ID"
 )
 
 type Config struct {
@@ -18,11 +24,36 @@ type Config struct {
 }
 
 type EngineConfig struct {
-	GRPCAddress                string          `yaml:"grpc_address"`
-	Environment                string          `yaml:"environment"`
-	Storage                    StorageConfig   `yaml:"storage"`
-	Scheduler                  SchedulerConfig `yaml:"scheduler"`
-	WrongConfirmationCodeLimit int             `yaml:"wrong_confirmation_code_limit"`
+	GRPCAddress string `yaml:"grpc_address"`
+	// TLS - настройки mTLS gRPC-сервера; при включении клиенты авторизуются по сертификатам
+	TLS pkggrpc.TLSConfig `yaml:"tls"`
+	// MetricsAddress - адрес HTTP-сервера, отдающего метрики в формате Prometheus
+	MetricsAddress string `yaml:"metrics_address"`
+	Environment    string `yaml:"environment"`
+	// Redaction - правила скрытия чувствительных данных в журналах
+	Redaction redact.Config `yaml:"redaction"`
+	// Tracing - настройки экспорта трассировок OpenTelemetry
+	Tracing                    tracing.Config           `yaml:"tracing"`
+	Storage                    StorageConfig            `yaml:"storage"`
+	Scheduler                  SchedulerConfig          `yaml:"scheduler"`
+	WrongConfirmationCodeLimit int                      `yaml:"wrong_confirmation_code_limit"`
+	PayoutConfirmation         PayoutConfirmationConfig `yaml:"payout_confirmation"`
+	// ToolEncryption - шифрование чувствительных реквизитов сохраненных платежных средств
+	ToolEncryption ToolEncryptionConfig `yaml:"tool_encryption"`
+}
+
+type ToolEncryptionConfig struct {
+	IsEnabled bool              `yaml:"is_enabled"`
+	Keys      encryption.Config `yaml:"keys"`
+	// SensitiveDetails - шифруемые реквизиты платежного средства; по умолчанию только "token"
+	SensitiveDetails []string `yaml:"sensitive_details"`
+}
+
+type PayoutConfirmationConfig struct {
+	// DefaultTTLMin - время в минутах, в течение которого клиент может подтвердить выплату
+	DefaultTTLMin int `yaml:"default_ttl_min"`
+	// TTLMin - время подтверждения выплаты в минутах в разрезе платежных систем и методов
+	TTLMin map[string]map[string]int `yaml:"ttl_min"`
 }
 
 type StorageConfig struct {
@@ -30,13 +61,20 @@ type StorageConfig struct {
 }
 
 type SchedulerConfig struct {
-	IsEnabled bool                 `yaml:"is_enabled"`
-	Tasks     SchedulerTasksConfig `yaml:"tasks"`
+	IsEnabled bool `yaml:"is_enabled"`
+	// InstanceID - идентификатор экземпляра сервиса, от имени которого захватываются операции
+	// (по умолчанию - имя хоста и идентификатор процесса)
+	InstanceID string               `yaml:"instance_id"`
+	Tasks      SchedulerTasksConfig `yaml:"tasks"`
+	DeadLetter DeadLetterConfig     `yaml:"dead_letter"`
 }
 
 type SchedulerTasksConfig struct {
 	FinalizeOperations SchedulerTaskConfig `yaml:"finalize_operations"`
 	RequestPayouts     SchedulerTaskConfig `yaml:"request_payouts"`
+	DetectStatusDrift  SchedulerTaskConfig `yaml:"detect_status_drift"`
+	TrackToolExpiry    SchedulerTaskConfig `yaml:"track_tool_expiry"`
+	SealToolDetails    SchedulerTaskConfig `yaml:"seal_tool_details"`
 }
 
 type SchedulerTaskConfig struct {
@@ -46,6 +84,20 @@ type SchedulerTaskConfig struct {
 	MaxWorkers               int            `yaml:"max_workers"`
 	ActualizeStatusIntervals map[int]int    `yaml:"actualize_status_intervals"`
 	ExternalSystemLifetime   map[string]int `yaml:"external_system_lifetime"`
+	LookbackDays             int            `yaml:"lookback_days"`
+	AutoFix                  bool           `yaml:"auto_fix"`
+	// ClaimLease - время в секундах, на которое экземпляр сервиса захватывает пачку операций
+	ClaimLease int `yaml:"claim_lease"`
+	// RetryBudget - количество неудачных попыток обработки операции подряд, после которого
+	// операция переводится в очередь недоставленных
+	RetryBudget int `yaml:"retry_budget"`
+	// NotifyBeforeDays - за сколько дней до окончания срока действия платежного средства уведомлять клиента
+	NotifyBeforeDays int `yaml:"notify_before_days"`
+}
+
+type DeadLetterConfig struct {
+	// AlertWebhookURL - адрес, на который отправляется оповещение о переводе операции в очередь недоставленных
+	AlertWebhookURL string `yaml:"alert_webhook_url"`
 }
 
 type ServicesConfig struct {
@@ -55,6 +107,8 @@ type ServicesConfig struct {
 
 type ServiceConfig struct {
 	GRPCAddress string `yaml:"grpc_address"`
+	// TLS - настройки mTLS для соединения с сервисом
+	TLS pkggrpc.TLSConfig `yaml:"tls"`
 }
 
 type SMTPConfig struct {
@@ -92,4 +146,7 @@ func (c *Config) loadFromEnv() {
 	if smtpPass, exists := os.LookupEnv(smtpPasswordEnvKey); exists {
 		c.Services.SMTP.Password = smtpPass
 	}
+	if instanceID, exists := os.LookupEnv(instanceIDEnvKey); exists {
+		c.Engine.Scheduler.InstanceID = instanceID
+	}
 }
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_reconciliation_discrepancy_run_id;
+
+DROP TABLE IF EXISTS reconciliation_discrepancy;
+DROP TABLE IF EXISTS reconciliation_run;
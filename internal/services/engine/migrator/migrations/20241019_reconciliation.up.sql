@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS reconciliation_run
+(
+    id                SERIAL PRIMARY KEY,
+    external_system   VARCHAR(255)             NOT NULL,
+    period_from       TIMESTAMP WITH TIME ZONE NOT NULL,
+    period_to         TIMESTAMP WITH TIME ZONE NOT NULL,
+    registry_count    INTEGER                  NOT NULL,
+    matched_count     INTEGER                  NOT NULL,
+    discrepancy_count INTEGER                  NOT NULL,
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS reconciliation_discrepancy
+(
+    run_id            BIGINT       NOT NULL REFERENCES reconciliation_run ON DELETE CASCADE,
+    type              VARCHAR(255) NOT NULL,
+    external_id       VARCHAR(255) NOT NULL,
+    operation_id      BIGINT,
+    currency          VARCHAR(255),
+    amount            NUMERIC(20, 8),
+    status            VARCHAR(255),
+    registry_currency VARCHAR(255),
+    registry_amount   NUMERIC(20, 8),
+    registry_status   VARCHAR(255)
+);
+
+CREATE INDEX ix_reconciliation_discrepancy_run_id ON reconciliation_discrepancy (run_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_operation_status_drift_detected_at;
+
+DROP TABLE IF EXISTS operation_status_drift;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS operation_status_drift
+(
+    id              SERIAL PRIMARY KEY,
+    operation_id    BIGINT                   NOT NULL REFERENCES operation ON DELETE CASCADE,
+    operation_type  VARCHAR(255)             NOT NULL,
+    external_system VARCHAR(255)             NOT NULL,
+    external_method VARCHAR(255)             NOT NULL,
+    status          VARCHAR(255)             NOT NULL,
+    external_status VARCHAR(255),
+    provider_status VARCHAR(255)             NOT NULL,
+    fixed           BOOLEAN                  NOT NULL DEFAULT FALSE,
+    fix_error       TEXT,
+    detected_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ix_operation_status_drift_detected_at ON operation_status_drift (detected_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS ix_operation_claimed_until;
+
+ALTER TABLE operation
+    DROP COLUMN IF EXISTS claimed_by,
+    DROP COLUMN IF EXISTS claimed_until;
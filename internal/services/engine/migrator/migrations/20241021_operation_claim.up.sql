@@ -0,0 +1,5 @@
+ALTER TABLE operation
+    ADD COLUMN IF NOT EXISTS claimed_by    VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS claimed_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX ix_operation_claimed_until ON operation (claimed_until);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS ix_scheduler_task_run_task_name_started_at;
+
+DROP TABLE IF EXISTS scheduler_task_run;
+
+DROP TABLE IF EXISTS scheduler_task;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS scheduler_task
+(
+    name                 VARCHAR(255) PRIMARY KEY,
+    is_paused            BOOLEAN                  NOT NULL DEFAULT FALSE,
+    interval_sec         INTEGER,
+    operation_batch_size BIGINT,
+    updated_at           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS scheduler_task_run
+(
+    id              SERIAL PRIMARY KEY,
+    task_name       VARCHAR(255)             NOT NULL,
+    instance_id     VARCHAR(255)             NOT NULL,
+    trigger_type    VARCHAR(255)             NOT NULL,
+    processed_count BIGINT                   NOT NULL,
+    succeeded_count BIGINT                   NOT NULL,
+    errored_count   BIGINT                   NOT NULL,
+    started_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at     TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX ix_scheduler_task_run_task_name_started_at ON scheduler_task_run (task_name, started_at);
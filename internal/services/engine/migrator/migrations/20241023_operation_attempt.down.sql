@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_operation_attempt_dead_lettered_at;
+
+DROP TABLE IF EXISTS operation_attempt;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS operation_attempt
+(
+    operation_id     BIGINT PRIMARY KEY REFERENCES operation ON DELETE CASCADE,
+    task_name        VARCHAR(255)             NOT NULL,
+    attempts         INTEGER                  NOT NULL,
+    last_error       TEXT                     NOT NULL,
+    last_attempt_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    dead_lettered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX ix_operation_attempt_dead_lettered_at ON operation_attempt (dead_lettered_at);
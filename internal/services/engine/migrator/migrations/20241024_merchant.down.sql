@@ -0,0 +1,22 @@
+DELETE FROM "user" WHERE merchant_id <> 'default';
+
+ALTER TABLE "user"
+    DROP CONSTRAINT user_pkey,
+    ADD PRIMARY KEY (id);
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS merchant_id;
+
+DELETE FROM tool WHERE merchant_id <> 'default';
+
+ALTER TABLE tool
+    DROP CONSTRAINT tool_pkey,
+    ADD PRIMARY KEY (id, user_id, external_method);
+
+ALTER TABLE tool
+    DROP COLUMN IF EXISTS merchant_id;
+
+DROP INDEX IF EXISTS ix_operation_merchant_id_user_id;
+
+ALTER TABLE operation
+    DROP COLUMN IF EXISTS merchant_id;
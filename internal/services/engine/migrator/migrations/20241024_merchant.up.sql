@@ -0,0 +1,18 @@
+ALTER TABLE operation
+    ADD COLUMN IF NOT EXISTS merchant_id VARCHAR(255) NOT NULL DEFAULT 'default';
+
+CREATE INDEX ix_operation_merchant_id_user_id ON operation (merchant_id, user_id);
+
+ALTER TABLE tool
+    ADD COLUMN IF NOT EXISTS merchant_id VARCHAR(255) NOT NULL DEFAULT 'default';
+
+ALTER TABLE tool
+    DROP CONSTRAINT tool_pkey,
+    ADD PRIMARY KEY (merchant_id, id, user_id, external_method);
+
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS merchant_id VARCHAR(255) NOT NULL DEFAULT 'default';
+
+ALTER TABLE "user"
+    DROP CONSTRAINT user_pkey,
+    ADD PRIMARY KEY (merchant_id, id);
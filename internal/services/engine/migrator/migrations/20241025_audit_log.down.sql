@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_audit_log_target;
+DROP INDEX IF EXISTS ix_audit_log_created_at;
+
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id          SERIAL PRIMARY KEY,
+    actor_id    VARCHAR(255)             NOT NULL,
+    action      VARCHAR(255)             NOT NULL,
+    target_type VARCHAR(255)             NOT NULL,
+    target_id   VARCHAR(255)             NOT NULL,
+    client_id   VARCHAR(255)             NOT NULL,
+    merchant_id VARCHAR(255)             NOT NULL,
+    before      JSONB                    NOT NULL DEFAULT '{}',
+    after       JSONB                    NOT NULL DEFAULT '{}',
+    reason      TEXT                     NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ix_audit_log_created_at ON audit_log (created_at);
+CREATE INDEX ix_audit_log_target ON audit_log (target_type, target_id);
@@ -0,0 +1,19 @@
+UPDATE operation_metadata m
+SET tool_id = t.legacy_id
+FROM operation o,
+     tool t
+WHERE m.operation_id = o.id
+  AND t.legacy_id IS NOT NULL
+  AND m.tool_id = t.id
+  AND o.merchant_id = t.merchant_id
+  AND o.user_id = t.user_id
+  AND o.external_method = t.external_method;
+
+UPDATE tool
+SET id = legacy_id
+WHERE legacy_id IS NOT NULL;
+
+DROP INDEX IF EXISTS ix_tool_legacy_id;
+
+ALTER TABLE tool
+    DROP COLUMN IF EXISTS legacy_id;
@@ -0,0 +1,30 @@
+ALTER TABLE tool
+    ADD COLUMN IF NOT EXISTS legacy_id VARCHAR(255);
+
+UPDATE operation_metadata m
+SET tool_id = substr(encode(sha256(convert_to(t.details ->> 'token', 'UTF8')), 'hex'), 1, 32)
+FROM operation o,
+     tool t
+WHERE m.operation_id = o.id
+  AND t.type = 'BANK_CARD'
+  AND t.details ? 'token'
+  AND m.tool_id = t.id
+  AND o.merchant_id = t.merchant_id
+  AND o.user_id = t.user_id
+  AND o.external_method = t.external_method;
+
+UPDATE tool
+SET legacy_id = id,
+    id        = substr(encode(sha256(convert_to(details ->> 'token', 'UTF8')), 'hex'), 1, 32)
+WHERE type = 'BANK_CARD'
+  AND details ? 'token';
+
+-- токен из зашифрованных реквизитов в SQL недоступен: такие карты помечаются совпадающими id и legacy_id,
+-- а отпечаток для них вычисляет сервис при запуске после расшифровки реквизитов
+UPDATE tool
+SET legacy_id = id
+WHERE type = 'BANK_CARD'
+  AND details ? 'encrypted'
+  AND legacy_id IS NULL;
+
+CREATE INDEX ix_tool_legacy_id ON tool (merchant_id, user_id, external_method, legacy_id);
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS ix_tool_status_expires_at;
+
+UPDATE tool
+SET status = 'ACTIVE'
+WHERE status = 'EXPIRED';
+
+ALTER TABLE tool
+    DROP COLUMN IF EXISTS expiry_notified_at,
+    DROP COLUMN IF EXISTS expires_at;
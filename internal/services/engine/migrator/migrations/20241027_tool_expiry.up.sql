@@ -0,0 +1,12 @@
+ALTER TABLE tool
+    ADD COLUMN IF NOT EXISTS expires_at         TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS expiry_notified_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE tool
+SET expires_at = make_timestamptz((details ->> 'expiry_year')::INTEGER, (details ->> 'expiry_month')::INTEGER, 1, 0, 0, 0, 'UTC')
+    + INTERVAL '1 month'
+WHERE type = 'BANK_CARD'
+  AND details ->> 'expiry_year' ~ '^[0-9]{4}$'
+  AND details ->> 'expiry_month' ~ '^(0?[1-9]|1[0-2])$';
+
+CREATE INDEX ix_tool_status_expires_at ON tool (status, expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS ix_block_list_merchant_id_type_value;
+
+DROP TABLE IF EXISTS block_list;
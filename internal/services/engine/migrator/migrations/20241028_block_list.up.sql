@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS block_list
+(
+    id          SERIAL PRIMARY KEY,
+    merchant_id VARCHAR(255)             NOT NULL DEFAULT 'default',
+    type        VARCHAR(255)             NOT NULL,
+    value       VARCHAR(255)             NOT NULL,
+    value_to    VARCHAR(255)             NOT NULL DEFAULT '',
+    reason      TEXT                     NOT NULL DEFAULT '',
+    created_by  VARCHAR(255)             NOT NULL,
+    expires_at  TIMESTAMP WITH TIME ZONE,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX ix_block_list_merchant_id_type_value ON block_list (merchant_id, type, value);
@@ -0,0 +1,2 @@
+ALTER TABLE operation_metadata
+    DROP COLUMN IF EXISTS client_ip;
@@ -0,0 +1,2 @@
+ALTER TABLE operation_metadata
+    ADD COLUMN IF NOT EXISTS client_ip VARCHAR(255);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ix_reconciliation_run_merchant_id;
+
+ALTER TABLE reconciliation_run
+    DROP COLUMN IF EXISTS merchant_id;
@@ -0,0 +1,4 @@
+ALTER TABLE reconciliation_run
+    ADD COLUMN IF NOT EXISTS merchant_id VARCHAR(255) NOT NULL DEFAULT 'default';
+
+CREATE INDEX ix_reconciliation_run_merchant_id ON reconciliation_run (merchant_id);
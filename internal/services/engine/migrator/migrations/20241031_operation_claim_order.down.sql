@@ -0,0 +1 @@
+DROP INDEX IF EXISTS ix_operation_status_updated_at_id;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS ix_operation_status_updated_at_id ON operation (status, updated_at, id);
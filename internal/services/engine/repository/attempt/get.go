@@ -0,0 +1,89 @@
+package attempt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) All(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "attempt.Repository.All")
+	defer span.End()
+
+	dbAttempts, err := r.dbGetAll(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*model.OperationAttempt, 0, len(dbAttempts))
+	for _, dbA := range dbAttempts {
+		attempts = append(attempts, attemptFromDB(dbA))
+	}
+	return attempts, nil
+}
+
+func (r *Repository) dbGetAll(ctx context.Context, criteria model.OperationAttemptCriteria) ([]dbAttempt, error) {
+	if criteria.MaxCount == 0 {
+		criteria.MaxCount = defaultAttemptMaxCount
+	}
+
+	whereStmt, args := r.whereStmt(criteria)
+
+	var dbAttempts []dbAttempt
+	err := pgxscan.Select(ctx, r.conn, &dbAttempts, fmt.Sprintf(`
+SELECT %[3]v.operation_id,
+       %[3]v.task_name,
+       %[3]v.attempts,
+       %[3]v.last_error,
+       %[3]v.last_attempt_at,
+       %[3]v.dead_lettered_at,
+       %[4]v.type            AS operation_type,
+       %[4]v.status          AS operation_status,
+       %[4]v.external_system AS external_system,
+       %[4]v.external_method AS external_method
+FROM %[1]v %[3]v
+         JOIN %[2]v %[4]v ON %[3]v.operation_id = %[4]v.id
+%[5]v ORDER BY %[3]v.last_attempt_at DESC LIMIT %[6]v
+`, attemptTable, operationTable, attemptTableAbbr, operationTableAbbr, whereStmt, criteria.MaxCount),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbAttempts, nil
+}
+
+func (r *Repository) whereStmt(c model.OperationAttemptCriteria) (string, []any) {
+	var (
+		whereValues []string
+		args        []any
+	)
+
+	if c.OperationID != nil {
+		args = append(args, *c.OperationID)
+		whereValues = append(whereValues, fmt.Sprintf("%v.operation_id=$%d", attemptTableAbbr, len(args)))
+	}
+
+	if c.MerchantID != nil {
+		args = append(args, *c.MerchantID)
+		whereValues = append(whereValues, fmt.Sprintf("%v.merchant_id=$%d", operationTableAbbr, len(args)))
+	}
+
+	if c.DeadLettered != nil {
+		if *c.DeadLettered {
+			whereValues = append(whereValues, fmt.Sprintf("%v.dead_lettered_at IS NOT NULL", attemptTableAbbr))
+		} else {
+			whereValues = append(whereValues, fmt.Sprintf("%v.dead_lettered_at IS NULL", attemptTableAbbr))
+		}
+	}
+
+	if len(whereValues) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(whereValues, " AND "), args
+}
@@ -0,0 +1,53 @@
+package attempt
+
+import (
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type dbAttempt struct {
+	OperationID    int64      `db:"operation_id"`
+	TaskName       string     `db:"task_name"`
+	Attempts       int        `db:"attempts"`
+	LastError      string     `db:"last_error"`
+	LastAttemptAt  time.Time  `db:"last_attempt_at"`
+	DeadLetteredAt *time.Time `db:"dead_lettered_at"`
+
+	OperationType   *string `db:"operation_type"`
+	OperationStatus *string `db:"operation_status"`
+	ExternalSystem  *string `db:"external_system"`
+	ExternalMethod  *string `db:"external_method"`
+}
+
+func attemptFromDB(dbA dbAttempt) *model.OperationAttempt {
+	a := &model.OperationAttempt{
+		OperationID:   dbA.OperationID,
+		TaskName:      dbA.TaskName,
+		Attempts:      dbA.Attempts,
+		LastError:     dbA.LastError,
+		LastAttemptAt: dbA.LastAttemptAt,
+	}
+
+	if dbA.DeadLetteredAt != nil {
+		a.DeadLetteredAt = *dbA.DeadLetteredAt
+	}
+
+	if dbA.OperationType != nil {
+		a.OperationType = model.OperationType(*dbA.OperationType)
+	}
+
+	if dbA.OperationStatus != nil {
+		a.OperationStatus = model.OperationStatus(*dbA.OperationStatus)
+	}
+
+	if dbA.ExternalSystem != nil {
+		a.ExternalSystem = *dbA.ExternalSystem
+	}
+
+	if dbA.ExternalMethod != nil {
+		a.ExternalMethod = *dbA.ExternalMethod
+	}
+
+	return a
+}
@@ -0,0 +1,23 @@
+package attempt
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+const (
+	attemptTable     = "operation_attempt"
+	attemptTableAbbr = "oa"
+
+	operationTable     = "operation"
+	operationTableAbbr = "op"
+
+	defaultAttemptMaxCount = 1000
+)
+
+type Repository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepository(conn *pgxpool.Pool) *Repository {
+	return &Repository{
+		conn: conn,
+	}
+}
@@ -0,0 +1,80 @@
+package attempt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Fail увеличивает счетчик неудачных попыток обработки операции. Если счетчик достиг budget,
+// операция помечается как недоставленная.
+func (r *Repository) Fail(ctx context.Context, operationID int64, taskName, lastError string, budget int) (*model.OperationAttempt, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "attempt.Repository.Fail")
+	defer span.End()
+
+	var dbA dbAttempt
+	if err := pgxscan.Get(ctx, r.conn, &dbA, fmt.Sprintf(`
+INSERT INTO %[1]v AS %[2]v (operation_id,
+                            task_name,
+                            attempts,
+                            last_error,
+                            last_attempt_at,
+                            dead_lettered_at)
+VALUES ($1, $2, 1, $3, NOW(), CASE WHEN 1 >= $4::INTEGER THEN NOW() END)
+ON CONFLICT (operation_id) DO UPDATE
+    SET task_name        = EXCLUDED.task_name,
+        attempts         = %[2]v.attempts + 1,
+        last_error       = EXCLUDED.last_error,
+        last_attempt_at  = EXCLUDED.last_attempt_at,
+        dead_lettered_at = CASE WHEN %[2]v.attempts + 1 >= $4::INTEGER THEN NOW() END
+RETURNING operation_id, task_name, attempts, last_error, last_attempt_at, dead_lettered_at
+`, attemptTable, attemptTableAbbr),
+		operationID,
+		taskName,
+		lastError,
+		budget,
+	); err != nil {
+		return nil, err
+	}
+
+	return attemptFromDB(dbA), nil
+}
+
+// Reset удаляет сведения о неудачных попытках обработки операции.
+func (r *Repository) Reset(ctx context.Context, operationID int64) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "attempt.Repository.Reset")
+	defer span.End()
+
+	_, err := r.conn.Exec(ctx, fmt.Sprintf(`
+DELETE
+FROM %v
+WHERE operation_id = $1
+`, attemptTable),
+		operationID,
+	)
+	return err
+}
+
+// ResetDeadLettered удаляет сведения о попытках обработки недоставленной операции и возвращает
+// признак того, что операция действительно находилась в очереди недоставленных.
+func (r *Repository) ResetDeadLettered(ctx context.Context, operationID int64) (bool, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "attempt.Repository.ResetDeadLettered")
+	defer span.End()
+
+	tag, err := r.conn.Exec(ctx, fmt.Sprintf(`
+DELETE
+FROM %v
+WHERE operation_id = $1
+  AND dead_lettered_at IS NOT NULL
+`, attemptTable),
+		operationID,
+	)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
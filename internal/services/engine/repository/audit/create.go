@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func (r *Repository) Create(ctx context.Context, e *model.AuditEntry) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "audit.Repository.Create")
+	defer span.End()
+
+	return dbCreate(ctx, r.conn, e)
+}
+
+// CreateInTx сохраняет запись журнала аудита в уже открытой транзакции, чтобы запись
+// фиксировалась или откатывалась вместе с изменением, которое она описывает.
+func CreateInTx(ctx context.Context, tx pgx.Tx, e *model.AuditEntry) error {
+	return dbCreate(ctx, tx, e)
+}
+
+func dbCreate(ctx context.Context, db queryRower, e *model.AuditEntry) error {
+	dbE := auditEntryToDB(e)
+	if dbE.ID != 0 {
+		return fmt.Errorf("creating audit entry with existing ID: %v", dbE.ID)
+	}
+
+	if err := db.QueryRow(ctx, fmt.Sprintf(`
+INSERT INTO %v (actor_id,
+                action,
+                target_type,
+                target_id,
+                client_id,
+                merchant_id,
+                before,
+                after,
+                reason)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, created_at`,
+		auditTable),
+		dbE.ActorID,
+		dbE.Action,
+		dbE.TargetType,
+		dbE.TargetID,
+		dbE.ClientID,
+		dbE.MerchantID,
+		dbE.Before,
+		dbE.After,
+		dbE.Reason,
+	).Scan(
+		&e.ID,
+		&e.CreatedAt,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) All(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "audit.Repository.All")
+	defer span.End()
+
+	dbEntries, err := r.dbGetAll(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.AuditEntry, 0, len(dbEntries))
+	for _, dbE := range dbEntries {
+		entries = append(entries, auditEntryFromDB(dbE))
+	}
+	return entries, nil
+}
+
+func (r *Repository) dbGetAll(ctx context.Context, criteria model.AuditEntryCriteria) ([]dbAuditEntry, error) {
+	if criteria.MaxCount == 0 {
+		criteria.MaxCount = defaultAuditMaxCount
+	}
+
+	whereStmt, args := r.whereStmt(criteria)
+
+	var dbEntries []dbAuditEntry
+	err := pgxscan.Select(ctx, r.conn, &dbEntries, fmt.Sprintf(`
+SELECT id,
+       actor_id,
+       action,
+       target_type,
+       target_id,
+       client_id,
+       merchant_id,
+       before,
+       after,
+       reason,
+       created_at
+FROM %v
+%v ORDER BY id DESC LIMIT %v
+`, auditTable, whereStmt, criteria.MaxCount),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbEntries, nil
+}
+
+func (r *Repository) whereStmt(c model.AuditEntryCriteria) (string, []any) {
+	var (
+		whereValues []string
+		args        []any
+	)
+
+	if c.ActorID != nil {
+		args = append(args, *c.ActorID)
+		whereValues = append(whereValues, fmt.Sprintf("actor_id=$%d", len(args)))
+	}
+
+	if c.Action != nil {
+		args = append(args, string(*c.Action))
+		whereValues = append(whereValues, fmt.Sprintf("action=$%d", len(args)))
+	}
+
+	if c.TargetType != nil {
+		args = append(args, string(*c.TargetType))
+		whereValues = append(whereValues, fmt.Sprintf("target_type=$%d", len(args)))
+	}
+
+	if c.TargetID != nil {
+		args = append(args, *c.TargetID)
+		whereValues = append(whereValues, fmt.Sprintf("target_id=$%d", len(args)))
+	}
+
+	if c.ClientID != nil {
+		args = append(args, *c.ClientID)
+		whereValues = append(whereValues, fmt.Sprintf("client_id=$%d", len(args)))
+	}
+
+	if c.MerchantID != nil {
+		args = append(args, *c.MerchantID)
+		whereValues = append(whereValues, fmt.Sprintf("merchant_id=$%d", len(args)))
+	}
+
+	if !c.CreatedAtFrom.IsZero() {
+		args = append(args, c.CreatedAtFrom)
+		whereValues = append(whereValues, fmt.Sprintf("created_at>=$%d", len(args)))
+	}
+
+	if !c.CreatedAtTo.IsZero() {
+		args = append(args, c.CreatedAtTo)
+		whereValues = append(whereValues, fmt.Sprintf("created_at<=$%d", len(args)))
+	}
+
+	if len(whereValues) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(whereValues, " AND "), args
+}
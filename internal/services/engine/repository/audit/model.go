@@ -0,0 +1,63 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type dbAuditEntry struct {
+	ID         int64             `db:"id"`
+	ActorID    string            `db:"actor_id"`
+	Action     string            `db:"action"`
+	TargetType string            `db:"target_type"`
+	TargetID   string            `db:"target_id"`
+	ClientID   string            `db:"client_id"`
+	MerchantID string            `db:"merchant_id"`
+	Before     map[string]string `db:"before"`
+	After      map[string]string `db:"after"`
+	Reason     string            `db:"reason"`
+	CreatedAt  time.Time         `db:"created_at"`
+}
+
+func auditEntryToDB(e *model.AuditEntry) dbAuditEntry {
+	dbE := dbAuditEntry{
+		ID:         e.ID,
+		ActorID:    e.ActorID,
+		Action:     string(e.Action),
+		TargetType: string(e.TargetType),
+		TargetID:   e.TargetID,
+		ClientID:   e.ClientID,
+		MerchantID: e.MerchantID,
+		Before:     e.Before,
+		After:      e.After,
+		Reason:     e.Reason,
+		CreatedAt:  e.CreatedAt,
+	}
+
+	if dbE.Before == nil {
+		dbE.Before = map[string]string{}
+	}
+
+	if dbE.After == nil {
+		dbE.After = map[string]string{}
+	}
+
+	return dbE
+}
+
+func auditEntryFromDB(dbE dbAuditEntry) *model.AuditEntry {
+	return &model.AuditEntry{
+		ID:         dbE.ID,
+		ActorID:    dbE.ActorID,
+		Action:     model.AuditAction(dbE.Action),
+		TargetType: model.AuditTargetType(dbE.TargetType),
+		TargetID:   dbE.TargetID,
+		ClientID:   dbE.ClientID,
+		MerchantID: dbE.MerchantID,
+		Before:     dbE.Before,
+		After:      dbE.After,
+		Reason:     dbE.Reason,
+		CreatedAt:  dbE.CreatedAt,
+	}
+}
@@ -0,0 +1,19 @@
+package audit
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+const (
+	auditTable = "audit_log"
+
+	defaultAuditMaxCount = 10000
+)
+
+type Repository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepository(conn *pgxpool.Pool) *Repository {
+	return &Repository{
+		conn: conn,
+	}
+}
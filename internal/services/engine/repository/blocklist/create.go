@@ -0,0 +1,47 @@
+package blocklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) Create(ctx context.Context, e *model.BlockEntry) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "blocklist.Repository.Create")
+	defer span.End()
+
+	dbE := blockEntryToDB(e)
+	if dbE.ID != 0 {
+		return fmt.Errorf("creating block entry with existing ID: %v", dbE.ID)
+	}
+
+	if err := r.conn.QueryRow(ctx, fmt.Sprintf(`
+INSERT INTO %v (merchant_id,
+                type,
+                value,
+                value_to,
+                reason,
+                created_by,
+                expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at, updated_at`,
+		blockListTable),
+		dbE.MerchantID,
+		dbE.Type,
+		dbE.Value,
+		dbE.ValueTo,
+		dbE.Reason,
+		dbE.CreatedBy,
+		dbE.ExpiresAt,
+	).Scan(
+		&e.ID,
+		&e.CreatedAt,
+		&e.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
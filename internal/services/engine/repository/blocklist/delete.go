@@ -0,0 +1,16 @@
+package blocklist
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "blocklist.Repository.Delete")
+	defer span.End()
+
+	_, err := r.conn.Exec(ctx, fmt.Sprintf(`DELETE FROM %v WHERE id = $1`, blockListTable), id)
+	return err
+}
@@ -0,0 +1,112 @@
+package blocklist
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+const selectColumns = `
+SELECT id,
+       merchant_id,
+       type,
+       value,
+       value_to,
+       reason,
+       created_by,
+       expires_at,
+       created_at,
+       updated_at`
+
+func (r *Repository) All(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "blocklist.Repository.All")
+	defer span.End()
+
+	if criteria.MaxCount == 0 {
+		criteria.MaxCount = defaultBlockListMaxCount
+	}
+
+	whereStmt, args := r.whereStmt(criteria)
+
+	var dbEntries []dbBlockEntry
+	err := pgxscan.Select(ctx, r.conn, &dbEntries, fmt.Sprintf(`%v
+FROM %v
+%v ORDER BY id DESC LIMIT %v
+`, selectColumns, blockListTable, whereStmt, criteria.MaxCount),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.BlockEntry, 0, len(dbEntries))
+	for _, dbE := range dbEntries {
+		entries = append(entries, blockEntryFromDB(dbE))
+	}
+	return entries, nil
+}
+
+func (r *Repository) GetOne(ctx context.Context, criteria model.BlockEntryCriteria) (*model.BlockEntry, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "blocklist.Repository.GetOne")
+	defer span.End()
+
+	whereStmt, args := r.whereStmt(criteria)
+
+	var dbE dbBlockEntry
+	err := pgxscan.Get(ctx, r.conn, &dbE, fmt.Sprintf(`%v
+FROM %v
+%v ORDER BY id DESC LIMIT 1
+`, selectColumns, blockListTable, whereStmt),
+		args...)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return blockEntryFromDB(dbE), nil
+}
+
+func (r *Repository) whereStmt(c model.BlockEntryCriteria) (string, []any) {
+	var (
+		whereValues []string
+		args        []any
+	)
+
+	if c.ID != nil {
+		args = append(args, *c.ID)
+		whereValues = append(whereValues, fmt.Sprintf("id=$%d", len(args)))
+	}
+
+	if c.MerchantID != nil {
+		args = append(args, *c.MerchantID)
+		whereValues = append(whereValues, fmt.Sprintf("merchant_id=$%d", len(args)))
+	}
+
+	if c.Type != nil {
+		args = append(args, string(*c.Type))
+		whereValues = append(whereValues, fmt.Sprintf("type=$%d", len(args)))
+	}
+
+	if c.Value != nil {
+		args = append(args, *c.Value)
+		whereValues = append(whereValues, fmt.Sprintf("value=$%d", len(args)))
+	}
+
+	if c.ActiveOnly {
+		args = append(args, time.Now().UTC())
+		whereValues = append(whereValues, fmt.Sprintf("(expires_at IS NULL OR expires_at>$%d)", len(args)))
+	}
+
+	if len(whereValues) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(whereValues, " AND "), args
+}
@@ -0,0 +1,73 @@
+package blocklist
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Match возвращает первую действующую в момент now запись магазина, под которую подпадает хотя бы один
+// из признаков data, или sql.ErrNoRows, если таких записей нет.
+func (r *Repository) Match(ctx context.Context, data model.BlockCheckData, now time.Time) (*model.BlockEntry, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "blocklist.Repository.Match")
+	defer span.End()
+
+	args := []any{data.MerchantID, now.UTC()}
+
+	var conditions []string
+	addExact := func(entryType model.BlockEntryType, value string) {
+		if value == "" {
+			return
+		}
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf("(type='%v' AND value=$%d)", entryType, len(args)))
+	}
+
+	addExact(model.BlockEntryTypeUser, data.UserID)
+	addExact(model.BlockEntryTypeEmail, data.Email)
+	addExact(model.BlockEntryTypeCard, data.CardFingerprint)
+	addExact(model.BlockEntryTypeWallet, data.WalletNumber)
+
+	if data.CardBIN != "" {
+		args = append(args, data.CardBIN)
+		conditions = append(conditions, fmt.Sprintf(
+			"(type='%v' AND $%d BETWEEN value AND value_to)", model.BlockEntryTypeBINRange, len(args),
+		))
+	}
+
+	// приведение к inet допустимо только для записей по IP, поэтому выполняется внутри CASE
+	if data.IP != "" {
+		args = append(args, data.IP)
+		conditions = append(conditions, fmt.Sprintf(
+			"(CASE WHEN type='%v' THEN value::inet >>= $%d::inet ELSE FALSE END)", model.BlockEntryTypeIP, len(args),
+		))
+	}
+
+	if len(conditions) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	var dbE dbBlockEntry
+	err := pgxscan.Get(ctx, r.conn, &dbE, fmt.Sprintf(`%v
+FROM %v
+WHERE merchant_id=$1 AND (expires_at IS NULL OR expires_at>$2) AND (%v)
+ORDER BY id
+LIMIT 1
+`, selectColumns, blockListTable, strings.Join(conditions, " OR ")),
+		args...)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return blockEntryFromDB(dbE), nil
+}
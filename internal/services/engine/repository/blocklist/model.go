@@ -0,0 +1,61 @@
+package blocklist
+
+import (
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type dbBlockEntry struct {
+	ID         int64      `db:"id"`
+	MerchantID string     `db:"merchant_id"`
+	Type       string     `db:"type"`
+	Value      string     `db:"value"`
+	ValueTo    string     `db:"value_to"`
+	Reason     string     `db:"reason"`
+	CreatedBy  string     `db:"created_by"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+func blockEntryToDB(e *model.BlockEntry) dbBlockEntry {
+	dbE := dbBlockEntry{
+		ID:         e.ID,
+		MerchantID: e.MerchantID,
+		Type:       string(e.Type),
+		Value:      e.Value,
+		ValueTo:    e.ValueTo,
+		Reason:     e.Reason,
+		CreatedBy:  e.CreatedBy,
+		CreatedAt:  e.CreatedAt,
+		UpdatedAt:  e.UpdatedAt,
+	}
+
+	if !e.ExpiresAt.IsZero() {
+		expiresAt := e.ExpiresAt.UTC()
+		dbE.ExpiresAt = &expiresAt
+	}
+
+	return dbE
+}
+
+func blockEntryFromDB(dbE dbBlockEntry) *model.BlockEntry {
+	e := &model.BlockEntry{
+		ID:         dbE.ID,
+		MerchantID: dbE.MerchantID,
+		Type:       model.BlockEntryType(dbE.Type),
+		Value:      dbE.Value,
+		ValueTo:    dbE.ValueTo,
+		Reason:     dbE.Reason,
+		CreatedBy:  dbE.CreatedBy,
+		CreatedAt:  dbE.CreatedAt,
+		UpdatedAt:  dbE.UpdatedAt,
+	}
+
+	if dbE.ExpiresAt != nil {
+		e.ExpiresAt = dbE.ExpiresAt.UTC()
+	}
+
+	return e
+}
@@ -0,0 +1,19 @@
+package blocklist
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+const (
+	blockListTable = "block_list"
+
+	defaultBlockListMaxCount = 10000
+)
+
+type Repository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepository(conn *pgxpool.Pool) *Repository {
+	return &Repository{
+		conn: conn,
+	}
+}
@@ -0,0 +1,35 @@
+package blocklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Update сохраняет причину и срок действия записи; признак блокировки после создания не меняется.
+func (r *Repository) Update(ctx context.Context, e *model.BlockEntry) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "blocklist.Repository.Update")
+	defer span.End()
+
+	if e == nil {
+		return errors.New("updating nil block entry")
+	}
+
+	dbE := blockEntryToDB(e)
+
+	return r.conn.QueryRow(ctx, fmt.Sprintf(`
+UPDATE %v
+SET reason = $2, expires_at = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING updated_at`,
+		blockListTable),
+		dbE.ID,
+		dbE.Reason,
+		dbE.ExpiresAt,
+	).Scan(
+		&e.UpdatedAt,
+	)
+}
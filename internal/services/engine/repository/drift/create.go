@@ -0,0 +1,50 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) Create(ctx context.Context, d *model.OperationStatusDrift) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "drift.Repository.Create")
+	defer span.End()
+
+	dbD := driftToDB(d)
+	if dbD.ID != 0 {
+		return fmt.Errorf("creating status drift with existing ID: %v", dbD.ID)
+	}
+
+	if err := r.conn.QueryRow(ctx, fmt.Sprintf(`
+INSERT INTO %v (operation_id,
+                operation_type,
+                external_system,
+                external_method,
+                status,
+                external_status,
+                provider_status,
+                fixed,
+                fix_error)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, detected_at`,
+		driftTable),
+		dbD.OperationID,
+		dbD.OperationType,
+		dbD.ExternalSystem,
+		dbD.ExternalMethod,
+		dbD.Status,
+		dbD.ExternalStatus,
+		dbD.ProviderStatus,
+		dbD.Fixed,
+		dbD.FixError,
+	).Scan(
+		&d.ID,
+		&d.DetectedAt,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
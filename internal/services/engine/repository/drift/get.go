@@ -0,0 +1,96 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) All(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "drift.Repository.All")
+	defer span.End()
+
+	dbDrifts, err := r.dbGetAll(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]*model.OperationStatusDrift, 0, len(dbDrifts))
+	for _, dbD := range dbDrifts {
+		drifts = append(drifts, driftFromDB(dbD))
+	}
+	return drifts, nil
+}
+
+func (r *Repository) dbGetAll(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]dbDrift, error) {
+	if criteria.MaxCount == 0 {
+		criteria.MaxCount = defaultDriftMaxCount
+	}
+
+	whereStmt, args := r.whereStmt(criteria)
+
+	var dbDrifts []dbDrift
+	err := pgxscan.Select(ctx, r.conn, &dbDrifts, fmt.Sprintf(`
+SELECT id,
+       operation_id,
+       operation_type,
+       external_system,
+       external_method,
+       status,
+       external_status,
+       provider_status,
+       fixed,
+       fix_error,
+       detected_at
+FROM %v
+%v ORDER BY id DESC LIMIT %v
+`, driftTable, whereStmt, criteria.MaxCount),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbDrifts, nil
+}
+
+func (r *Repository) whereStmt(c model.OperationStatusDriftCriteria) (string, []any) {
+	var (
+		whereValues []string
+		args        []any
+	)
+
+	if c.OperationID != nil {
+		args = append(args, *c.OperationID)
+		whereValues = append(whereValues, fmt.Sprintf("operation_id=$%d", len(args)))
+	}
+
+	if c.MerchantID != nil {
+		args = append(args, *c.MerchantID)
+		whereValues = append(whereValues, fmt.Sprintf("operation_id IN (SELECT id FROM %v WHERE merchant_id=$%d)", operationTable, len(args)))
+	}
+
+	if c.Fixed != nil {
+		args = append(args, *c.Fixed)
+		whereValues = append(whereValues, fmt.Sprintf("fixed=$%d", len(args)))
+	}
+
+	if !c.DetectedAtFrom.IsZero() {
+		args = append(args, c.DetectedAtFrom)
+		whereValues = append(whereValues, fmt.Sprintf("detected_at>=$%d", len(args)))
+	}
+
+	if !c.DetectedAtTo.IsZero() {
+		args = append(args, c.DetectedAtTo)
+		whereValues = append(whereValues, fmt.Sprintf("detected_at<=$%d", len(args)))
+	}
+
+	if len(whereValues) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(whereValues, " AND "), args
+}
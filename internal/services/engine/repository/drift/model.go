@@ -0,0 +1,69 @@
+package drift
+
+import (
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type dbDrift struct {
+	ID             int64     `db:"id"`
+	OperationID    int64     `db:"operation_id"`
+	OperationType  string    `db:"operation_type"`
+	ExternalSystem string    `db:"external_system"`
+	ExternalMethod string    `db:"external_method"`
+	Status         string    `db:"status"`
+	ExternalStatus *string   `db:"external_status"`
+	ProviderStatus string    `db:"provider_status"`
+	Fixed          bool      `db:"fixed"`
+	FixError       *string   `db:"fix_error"`
+	DetectedAt     time.Time `db:"detected_at"`
+}
+
+func driftToDB(d *model.OperationStatusDrift) dbDrift {
+	dbD := dbDrift{
+		ID:             d.ID,
+		OperationID:    d.OperationID,
+		OperationType:  string(d.OperationType),
+		ExternalSystem: d.ExternalSystem,
+		ExternalMethod: d.ExternalMethod,
+		Status:         string(d.Status),
+		ProviderStatus: string(d.ProviderStatus),
+		Fixed:          d.Fixed,
+		DetectedAt:     d.DetectedAt,
+	}
+
+	if d.ExternalStatus != "" {
+		dbD.ExternalStatus = (*string)(&d.ExternalStatus)
+	}
+
+	if d.FixError != "" {
+		dbD.FixError = &d.FixError
+	}
+
+	return dbD
+}
+
+func driftFromDB(dbD dbDrift) *model.OperationStatusDrift {
+	d := &model.OperationStatusDrift{
+		ID:             dbD.ID,
+		OperationID:    dbD.OperationID,
+		OperationType:  model.OperationType(dbD.OperationType),
+		ExternalSystem: dbD.ExternalSystem,
+		ExternalMethod: dbD.ExternalMethod,
+		Status:         model.OperationStatus(dbD.Status),
+		ProviderStatus: model.OperationExternalStatus(dbD.ProviderStatus),
+		Fixed:          dbD.Fixed,
+		DetectedAt:     dbD.DetectedAt,
+	}
+
+	if dbD.ExternalStatus != nil {
+		d.ExternalStatus = model.OperationExternalStatus(*dbD.ExternalStatus)
+	}
+
+	if dbD.FixError != nil {
+		d.FixError = *dbD.FixError
+	}
+
+	return d
+}
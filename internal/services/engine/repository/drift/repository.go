@@ -0,0 +1,20 @@
+package drift
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+const (
+	driftTable     = "operation_status_drift"
+	operationTable = "operation"
+
+	defaultDriftMaxCount = 10000
+)
+
+type Repository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepository(conn *pgxpool.Pool) *Repository {
+	return &Repository{
+		conn: conn,
+	}
+}
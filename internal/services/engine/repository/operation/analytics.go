@@ -0,0 +1,157 @@
+package operation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+const defaultAnalyticsTimeZone = "UTC"
+
+var analyticsGroupColumns = map[model.AnalyticsGroupField]string{
+	model.AnalyticsGroupFieldExternalSystem: "external_system",
+	model.AnalyticsGroupFieldExternalMethod: "external_method",
+	model.AnalyticsGroupFieldCurrency:       "currency",
+	model.AnalyticsGroupFieldType:           "type",
+}
+
+type dbOperationAnalytics struct {
+	PeriodStart             time.Time `db:"period_start"`
+	ExternalSystem          *string   `db:"external_system"`
+	ExternalMethod          *string   `db:"external_method"`
+	Currency                *string   `db:"currency"`
+	Type                    *string   `db:"type"`
+	CreatedCount            int64     `db:"created_count"`
+	SucceededCount          int64     `db:"succeeded_count"`
+	FailedCount             int64     `db:"failed_count"`
+	SucceededAmount         float64   `db:"succeeded_amount"`
+	MedianProcessingSeconds *float64  `db:"median_processing_seconds"`
+}
+
+func (r *Repository) Analytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.Analytics")
+	defer span.End()
+
+	dbItems, err := r.dbGetAnalytics(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]model.OperationAnalyticsItem, 0, len(dbItems))
+	for _, dbItem := range dbItems {
+		items = append(items, operationAnalyticsFromDB(dbItem))
+	}
+	return items, nil
+}
+
+func (r *Repository) dbGetAnalytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]dbOperationAnalytics, error) {
+	switch criteria.Granularity {
+	case model.AnalyticsGranularityHour, model.AnalyticsGranularityDay, model.AnalyticsGranularityWeek:
+	default:
+		return nil, fmt.Errorf("unresolved analytics granularity: %v", criteria.Granularity)
+	}
+
+	if criteria.TimeZone == "" {
+		criteria.TimeZone = defaultAnalyticsTimeZone
+	}
+
+	if criteria.MerchantID == nil {
+		return nil, errors.New("analytics merchant id not stated")
+	}
+
+	groupColumns := make([]string, 0, len(criteria.GroupBy)+1)
+	groupedByCurrency := false
+	for _, field := range criteria.GroupBy {
+		column, ok := analyticsGroupColumns[field]
+		if !ok {
+			return nil, fmt.Errorf("unresolved analytics group field: %v", field)
+		}
+		groupColumns = append(groupColumns, fmt.Sprintf("%s.%s", operationTableAbbr, column))
+		groupedByCurrency = groupedByCurrency || field == model.AnalyticsGroupFieldCurrency
+	}
+
+	// суммы в разных валютах складывать нельзя, поэтому объем всегда считается в разрезе валюты
+	if !groupedByCurrency {
+		groupColumns = append(groupColumns, fmt.Sprintf("%s.%s", operationTableAbbr, analyticsGroupColumns[model.AnalyticsGroupFieldCurrency]))
+	}
+
+	whereStmt, args, err := r.whereStmt(model.OperationCriteria{
+		Types:           criteria.Types,
+		ExternalSystems: criteria.ExternalSystems,
+		MerchantID:      criteria.MerchantID,
+		CreatedAtFrom:   criteria.CreatedAtFrom,
+		CreatedAtTo:     criteria.CreatedAtTo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	granularityArgID, timeZoneArgID := len(args)+1, len(args)+2
+	successArgID, failedArgID := len(args)+3, len(args)+4
+	args = append(args, criteria.Granularity, criteria.TimeZone, model.OperationStatusSuccess, model.OperationStatusFailed)
+
+	periodColumn := fmt.Sprintf("date_trunc($%d, %s.created_at, $%d)", granularityArgID, operationTableAbbr, timeZoneArgID)
+
+	selectColumns := strings.Join(append([]string{periodColumn + " period_start"}, groupColumns...), ",\n       ")
+	groupByStmt := strings.Join(append([]string{periodColumn}, groupColumns...), ", ")
+
+	var dbItems []dbOperationAnalytics
+	err = pgxscan.Select(ctx, r.conn, &dbItems, fmt.Sprintf(`
+SELECT %[5]v,
+       COUNT(*) created_count,
+       COUNT(*) FILTER (WHERE %[3]v.status = $%[8]v) succeeded_count,
+       COUNT(*) FILTER (WHERE %[3]v.status = $%[9]v) failed_count,
+       COALESCE(SUM(%[3]v.amount) FILTER (WHERE %[3]v.status = $%[8]v), 0) succeeded_amount,
+       percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM %[4]v.processed_at - %[3]v.created_at)) median_processing_seconds
+FROM %[1]v %[3]v
+         JOIN %[2]v %[4]v on %[3]v.id = %[4]v.operation_id
+%[6]v GROUP BY %[7]v ORDER BY period_start
+`, operationTable, operationMetadataTable, operationTableAbbr, operationMetadataTableAbbr,
+		selectColumns, whereStmt, groupByStmt, successArgID, failedArgID),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbItems, nil
+}
+
+func operationAnalyticsFromDB(dbItem dbOperationAnalytics) model.OperationAnalyticsItem {
+	item := model.OperationAnalyticsItem{
+		PeriodStart:     dbItem.PeriodStart,
+		CreatedCount:    dbItem.CreatedCount,
+		SucceededCount:  dbItem.SucceededCount,
+		FailedCount:     dbItem.FailedCount,
+		SucceededAmount: convert.BaseToCents(dbItem.SucceededAmount),
+	}
+
+	if dbItem.ExternalSystem != nil {
+		item.ExternalSystem = *dbItem.ExternalSystem
+	}
+
+	if dbItem.ExternalMethod != nil {
+		item.ExternalMethod = *dbItem.ExternalMethod
+	}
+
+	if dbItem.Currency != nil {
+		item.Currency = *dbItem.Currency
+	}
+
+	if dbItem.Type != nil {
+		item.Type = model.OperationType(*dbItem.Type)
+	}
+
+	if dbItem.MedianProcessingSeconds != nil {
+		item.MedianProcessingTime = time.Duration(*dbItem.MedianProcessingSeconds * float64(time.Second))
+	}
+
+	return item
+}
@@ -0,0 +1,119 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Claim захватывает пачку операций, удовлетворяющих критериям, на время lease.
+// Операции, заблокированные другой транзакцией, захваченные другим экземпляром сервиса или
+// исчерпавшие лимит попыток обработки, пропускаются. Первыми захватываются операции, дольше всего
+// не обновлявшиеся. У захваченных операций заполняется признак неудачных попыток.
+func (r *Repository) Claim(ctx context.Context, criteria model.OperationCriteria, claimedBy string, lease time.Duration) ([]*model.Operation, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.Claim")
+	defer span.End()
+
+	claimed, err := r.dbClaim(ctx, criteria, claimedBy, lease)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(claimed) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(claimed))
+	hasFailedAttempts := make(map[int64]bool, len(claimed))
+	for _, c := range claimed {
+		ids = append(ids, c.ID)
+		hasFailedAttempts[c.ID] = c.HasFailedAttempts
+	}
+
+	operations, err := r.All(ctx, model.OperationCriteria{IDs: &ids, MaxCount: int64(len(ids))})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range operations {
+		op.HasFailedAttempts = hasFailedAttempts[op.ID]
+	}
+	return operations, nil
+}
+
+// Release освобождает операции, захваченные экземпляром сервиса claimedBy.
+func (r *Repository) Release(ctx context.Context, ids []int64, claimedBy string) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.Release")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.conn.Exec(ctx, fmt.Sprintf(`
+UPDATE %v
+SET claimed_by    = NULL,
+    claimed_until = NULL
+WHERE id = ANY ($1)
+  AND claimed_by = $2
+`, operationTable),
+		ids,
+		claimedBy,
+	)
+	return err
+}
+
+type dbClaimedOperation struct {
+	ID                int64 `db:"id"`
+	HasFailedAttempts bool  `db:"has_failed_attempts"`
+}
+
+func (r *Repository) dbClaim(ctx context.Context, criteria model.OperationCriteria, claimedBy string, lease time.Duration) ([]dbClaimedOperation, error) {
+	if criteria.MaxCount == 0 {
+		criteria.MaxCount = defaultOperationMaxCount
+	}
+
+	whereStmt, args, err := r.whereStmt(criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	claimedByArgID, leaseArgID := len(args)+1, len(args)+2
+	args = append(args, claimedBy, lease.Seconds())
+
+	var claimed []dbClaimedOperation
+	err = pgxscan.Select(ctx, r.conn, &claimed, fmt.Sprintf(`
+WITH claimed AS (SELECT %[3]v.id
+                 FROM %[1]v %[3]v
+                          JOIN %[2]v %[4]v on %[3]v.id = %[4]v.operation_id
+                 %[5]v
+                   AND (%[3]v.claimed_until IS NULL OR %[3]v.claimed_until < NOW())
+                   AND NOT EXISTS (SELECT 1
+                                   FROM %[9]v
+                                   WHERE %[9]v.operation_id = %[3]v.id
+                                     AND %[9]v.dead_lettered_at IS NOT NULL)
+                 ORDER BY %[3]v.updated_at, %[3]v.id
+                 LIMIT %[6]v FOR UPDATE OF %[3]v SKIP LOCKED)
+UPDATE %[1]v
+SET claimed_by    = $%[7]v,
+    claimed_until = NOW() + make_interval(secs => $%[8]v)
+FROM claimed
+WHERE %[1]v.id = claimed.id
+RETURNING %[1]v.id,
+          EXISTS (SELECT 1
+                  FROM %[9]v
+                  WHERE %[9]v.operation_id = %[1]v.id) AS has_failed_attempts
+`, operationTable, operationMetadataTable, operationTableAbbr, operationMetadataTableAbbr,
+		whereStmt, criteria.MaxCount, claimedByArgID, leaseArgID, operationAttemptTable),
+		args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
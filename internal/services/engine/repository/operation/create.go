@@ -4,10 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 func (r *Repository) Create(ctx context.Context, op *model.Operation) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.Create")
+	defer span.End()
+
 	dbOp := operationToDB(op)
 	if dbOp.ID != 0 {
 		return fmt.Errorf("creating operation with existing ID: %v", dbOp.ID)
@@ -20,6 +25,8 @@ func (r *Repository) Create(ctx context.Context, op *model.Operation) error {
 
 	// проставляем идентификатор созданной операции, чтобы использовать его в дальнейшем
 	op.ID = operationID
+
+	metrics.IncOperationCreated(op)
 	return nil
 }
 
@@ -31,7 +38,8 @@ func (r *Repository) dbCreate(ctx context.Context, dbOp dbOperation) (int64, err
 	defer r.dbRollback(ctx, dbTX)
 
 	if err = dbTX.QueryRow(ctx, fmt.Sprintf(`
-INSERT INTO %v (user_id,
+INSERT INTO %v (merchant_id,
+                user_id,
                 type,
                 currency,
                 amount,
@@ -39,9 +47,10 @@ INSERT INTO %v (user_id,
                 external_id,
                 external_system,
                 external_method)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING id`,
 		operationTable),
+		dbOp.MerchantID,
 		dbOp.UserID,
 		dbOp.Type,
 		dbOp.Currency,
@@ -63,8 +72,9 @@ INSERT INTO %v (operation_id,
                 fail_reason,
                 confirmation_code,
                 processed_at,
-                confirmation_attempts)
-VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+                confirmation_attempts,
+                client_ip)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
 		operationMetadataTable),
 		dbOp.ID,
 		dbOp.ToolID,
@@ -72,7 +82,8 @@ VALUES ($1, $2, $3, $4, $5, $6, $7)`,
 		dbOp.FailReason,
 		dbOp.ConfirmationCode,
 		dbOp.ProcessedAt,
-		dbOp.ConfirmationAttempts)
+		dbOp.ConfirmationAttempts,
+		dbOp.ClientIP)
 	if err != nil {
 		return 0, err
 	}
@@ -24,12 +24,29 @@ func (r *Repository) whereStmt(c model.OperationCriteria) (string, []any, error)
 		currArgID++
 	}
 
+	if c.MerchantID != nil {
+		whereValues = append(whereValues, fmt.Sprintf("%s.merchant_id=$%d", operationTableAbbr, currArgID))
+		args = append(args, *c.MerchantID)
+		currArgID++
+	}
+
 	if c.UserID != nil {
 		whereValues = append(whereValues, fmt.Sprintf("%s.user_id=$%d", operationTableAbbr, currArgID))
 		args = append(args, *c.UserID)
 		currArgID++
 	}
 
+	if c.IDs != nil {
+		argsInRoundBrackets := make([]string, 0, len(*c.IDs))
+		for _, id := range *c.IDs {
+			argsInRoundBrackets = append(argsInRoundBrackets, fmt.Sprintf("$%d", currArgID))
+			args = append(args, id)
+			currArgID++
+		}
+		inRoundBrackets := strings.Join(argsInRoundBrackets, ", ")
+		whereValues = append(whereValues, fmt.Sprintf("%s.id IN (%s)", operationTableAbbr, inRoundBrackets))
+	}
+
 	if c.Types != nil {
 		argsInRoundBrackets := make([]string, 0, len(*c.Types))
 		for _, t := range *c.Types {
@@ -86,6 +103,17 @@ func (r *Repository) whereStmt(c model.OperationCriteria) (string, []any, error)
 		whereValues = append(whereValues, fmt.Sprintf("%s.external_system IN (%s)", operationTableAbbr, inRoundBrackets))
 	}
 
+	if c.ExternalIDs != nil {
+		argsInRoundBrackets := make([]string, 0, len(*c.ExternalIDs))
+		for _, id := range *c.ExternalIDs {
+			argsInRoundBrackets = append(argsInRoundBrackets, fmt.Sprintf("$%d", currArgID))
+			args = append(args, id)
+			currArgID++
+		}
+		inRoundBrackets := strings.Join(argsInRoundBrackets, ", ")
+		whereValues = append(whereValues, fmt.Sprintf("%s.external_id IN (%s)", operationTableAbbr, inRoundBrackets))
+	}
+
 	if c.ExternalID != nil {
 		whereValues = append(whereValues, fmt.Sprintf("%s.external_id=$%d", operationTableAbbr, currArgID))
 		args = append(args, *c.ExternalID)
@@ -114,6 +142,10 @@ func (r *Repository) nonNilCriteriaArgs(c model.OperationCriteria) (int, int) {
 		nonNilCriteria++
 		nonNilCriteriaArgs++
 	}
+	if c.MerchantID != nil {
+		nonNilCriteria++
+		nonNilCriteriaArgs++
+	}
 	if c.UserID != nil {
 		nonNilCriteria++
 		nonNilCriteriaArgs++
@@ -122,6 +154,10 @@ func (r *Repository) nonNilCriteriaArgs(c model.OperationCriteria) (int, int) {
 		nonNilCriteria++
 		nonNilCriteriaArgs++
 	}
+	if c.IDs != nil {
+		nonNilCriteria++
+		nonNilCriteriaArgs += len(*c.IDs)
+	}
 	if c.Types != nil {
 		nonNilCriteria++
 		nonNilCriteriaArgs += len(*c.Types)
@@ -134,6 +170,10 @@ func (r *Repository) nonNilCriteriaArgs(c model.OperationCriteria) (int, int) {
 		nonNilCriteria++
 		nonNilCriteriaArgs += len(*c.ExternalSystems)
 	}
+	if c.ExternalIDs != nil {
+		nonNilCriteria++
+		nonNilCriteriaArgs += len(*c.ExternalIDs)
+	}
 	if len(c.StatusesByType) > 0 {
 		nonNilCriteria++
 		for _, statuses := range c.StatusesByType {
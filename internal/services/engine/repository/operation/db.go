@@ -11,6 +11,6 @@ import (
 func (r *Repository) dbRollback(ctx context.Context, dbTX pgx.Tx) {
 	err := dbTX.Rollback(ctx)
 	if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
-		slog.Error("failed to rollback db transaction", "error", err)
+		slog.ErrorContext(ctx, "failed to rollback db transaction", "error", err)
 	}
 }
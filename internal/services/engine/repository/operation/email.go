@@ -0,0 +1,36 @@
+package operation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// LastEmail возвращает адрес электронной почты, указанный клиентом в последней операции, или пустую строку,
+// если клиент ни разу его не указывал.
+func (r *Repository) LastEmail(ctx context.Context, merchantID, userID string) (string, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.LastEmail")
+	defer span.End()
+
+	var emails []string
+	err := pgxscan.Select(ctx, r.conn, &emails, fmt.Sprintf(`
+SELECT additional ->> 'email'
+FROM %v
+WHERE merchant_id = $1
+  AND user_id = $2
+  AND COALESCE(additional ->> 'email', '') <> ''
+ORDER BY created_at DESC
+LIMIT 1
+`, operationTable), merchantID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if len(emails) == 0 {
+		return "", nil
+	}
+	return emails[0], nil
+}
@@ -10,12 +10,18 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/jackc/pgx/v4"
 
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/repository/audit"
 )
 
 const defaultOperationMaxCount = 10000
 
 func (r *Repository) AcquireOneLocked(ctx context.Context, criteria model.OperationCriteria, script model.ScriptAcquiredFor) (err error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.AcquireOneLocked")
+	defer span.End()
+
 	dbTX, err := r.conn.Begin(ctx)
 	if err != nil {
 		return err
@@ -36,7 +42,7 @@ func (r *Repository) AcquireOneLocked(ctx context.Context, criteria model.Operat
 	// на время выполнения действий над операцией преобразуем её в общую структуру (модель)
 	op := operationFromDB(dbOp)
 
-	oldStatus := op.Status
+	oldStatus, oldExternalStatus := op.Status, op.ExternalStatus
 	defer func() {
 		dbOp = operationToDB(op)
 
@@ -46,13 +52,27 @@ func (r *Repository) AcquireOneLocked(ctx context.Context, criteria model.Operat
 			return
 		}
 
+		// запись аудита ручного изменения статуса сохраняется в той же транзакции:
+		// при её ошибке транзакция не зафиксируется и статус останется прежним
+		if entry := model.AuditEntryFromContext(ctx); entry != nil && entry.ID == 0 &&
+			(oldStatus != op.Status || oldExternalStatus != op.ExternalStatus) {
+			entry.After = model.OperationAuditState(op)
+			if auditErr := audit.CreateInTx(ctx, dbTX, entry); auditErr != nil {
+				err = multierror.Append(err, fmt.Errorf("record audit entry: %w", auditErr))
+				return
+			}
+		}
+
 		if oldStatus != op.Status {
-			slog.Info(
+			slog.InfoContext(
+				ctx,
 				"operation status changed",
 				"operation_id", op.ID,
 				"old_status", oldStatus,
 				"new_status", op.Status,
 			)
+
+			metrics.IncOperationFinalized(op)
 		}
 	}()
 
@@ -60,6 +80,9 @@ func (r *Repository) AcquireOneLocked(ctx context.Context, criteria model.Operat
 }
 
 func (r *Repository) GetOneWithoutLock(ctx context.Context, criteria model.OperationCriteria) (*model.Operation, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.GetOneWithoutLock")
+	defer span.End()
+
 	dbOp, err := r.dbGetOne(ctx, r.conn, criteria, false)
 	if err != nil {
 		return nil, err
@@ -70,6 +93,9 @@ func (r *Repository) GetOneWithoutLock(ctx context.Context, criteria model.Opera
 }
 
 func (r *Repository) All(ctx context.Context, criteria model.OperationCriteria) ([]*model.Operation, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.All")
+	defer span.End()
+
 	dbOps, err := r.dbGetAll(ctx, criteria)
 	if err != nil {
 		return nil, err
@@ -83,6 +109,9 @@ func (r *Repository) All(ctx context.Context, criteria model.OperationCriteria)
 }
 
 func (r *Repository) AllForReport(ctx context.Context, criteria model.OperationCriteria) ([]model.ReportOperation, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "operation.Repository.AllForReport")
+	defer span.End()
+
 	dbOps, err := r.dbGetAllForReport(ctx, criteria)
 	if err != nil {
 		return nil, err
@@ -110,6 +139,7 @@ func (r *Repository) dbGetOne(ctx context.Context, db pgxscan.Querier, criteria
 
 	err = pgxscan.Get(ctx, db, &dbOp, fmt.Sprintf(`
 SELECT %[3]v.id,
+       %[3]v.merchant_id,
        %[3]v.user_id,
        %[3]v.type,
        %[3]v.currency,
@@ -126,7 +156,8 @@ SELECT %[3]v.id,
        %[4]v.fail_reason,
        %[4]v.confirmation_code,
        %[4]v.processed_at,
-       %[4]v.confirmation_attempts
+       %[4]v.confirmation_attempts,
+       %[4]v.client_ip
 FROM %[1]v %[3]v
          JOIN %[2]v %[4]v on %[3]v.id = %[4]v.operation_id
 %v %v
@@ -155,6 +186,7 @@ func (r *Repository) dbGetAll(ctx context.Context, criteria model.OperationCrite
 	var dbOps []dbOperation
 	err = pgxscan.Select(ctx, r.conn, &dbOps, fmt.Sprintf(`
 SELECT %[3]v.id,
+       %[3]v.merchant_id,
        %[3]v.user_id,
        %[3]v.type,
        %[3]v.currency,
@@ -171,7 +203,8 @@ SELECT %[3]v.id,
        %[4]v.fail_reason,
        %[4]v.confirmation_code,
        %[4]v.processed_at,
-       %[4]v.confirmation_attempts
+       %[4]v.confirmation_attempts,
+       %[4]v.client_ip
 FROM %[1]v %[3]v
          JOIN %[2]v %[4]v on %[3]v.id = %[4]v.operation_id
 %v ORDER BY random() LIMIT %v
@@ -194,6 +227,7 @@ func (r *Repository) dbGetAllForReport(ctx context.Context, criteria model.Opera
 	var dbOps []dbReportOperation
 	err := pgxscan.Select(ctx, r.conn, &dbOps, fmt.Sprintf(`
 SELECT %[3]v.id,
+       %[3]v.merchant_id,
        %[3]v.user_id,
        %[3]v.type,
        %[3]v.currency,
@@ -210,7 +244,7 @@ SELECT %[3]v.id,
        %[4]v.processed_at
 FROM %[1]v %[3]v
          JOIN %[2]v %[4]v on %[3]v.id = %[4]v.operation_id
-         LEFT JOIN %[5]v %[6]v on %[4]v.tool_id = %[6]v.id AND %[3]v.user_id = %[6]v.user_id AND %[3]v.external_method = %[6]v.external_method
+         LEFT JOIN %[5]v %[6]v on %[4]v.tool_id = %[6]v.id AND %[3]v.merchant_id = %[6]v.merchant_id AND %[3]v.user_id = %[6]v.user_id AND %[3]v.external_method = %[6]v.external_method
 %v LIMIT %v
 `, operationTable, operationMetadataTable, operationTableAbbr, operationMetadataTableAbbr, toolTable, toolTableAbbr,
 		whereStmt, criteria.MaxCount),
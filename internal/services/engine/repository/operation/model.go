@@ -9,6 +9,7 @@ import (
 
 type dbOperation struct {
 	ID             int64     `db:"id"`
+	MerchantID     string    `db:"merchant_id"`
 	UserID         string    `db:"user_id"`
 	Type           string    `db:"type"`
 	Currency       string    `db:"currency"`
@@ -27,11 +28,13 @@ type dbOperation struct {
 	ConfirmationCode     *string        `db:"confirmation_code"`
 	ProcessedAt          *time.Time     `db:"processed_at"`
 	ConfirmationAttempts *int           `db:"confirmation_attempts"`
+	ClientIP             *string        `db:"client_ip"`
 }
 
 func operationToDB(op *model.Operation) dbOperation {
 	dbOp := dbOperation{
 		ID:             op.ID,
+		MerchantID:     op.MerchantID,
 		UserID:         op.UserID,
 		Type:           string(op.Type),
 		Currency:       op.Currency,
@@ -75,12 +78,17 @@ func operationToDB(op *model.Operation) dbOperation {
 		dbOp.ConfirmationAttempts = &op.ConfirmationAttempts
 	}
 
+	if op.ClientIP != "" {
+		dbOp.ClientIP = &op.ClientIP
+	}
+
 	return dbOp
 }
 
 func operationFromDB(dbOp dbOperation) *model.Operation {
 	op := &model.Operation{
 		ID:             dbOp.ID,
+		MerchantID:     dbOp.MerchantID,
 		UserID:         dbOp.UserID,
 		Type:           model.OperationType(dbOp.Type),
 		Currency:       dbOp.Currency,
@@ -124,11 +132,16 @@ func operationFromDB(dbOp dbOperation) *model.Operation {
 		op.ConfirmationAttempts = *dbOp.ConfirmationAttempts
 	}
 
+	if dbOp.ClientIP != nil {
+		op.ClientIP = *dbOp.ClientIP
+	}
+
 	return op
 }
 
 type dbReportOperation struct {
 	ID             int64      `db:"id"`
+	MerchantID     string     `db:"merchant_id"`
 	UserID         string     `db:"user_id"`
 	Type           string     `db:"type"`
 	Currency       string     `db:"currency"`
@@ -148,6 +161,7 @@ type dbReportOperation struct {
 func reportOperationFromDB(dbOp dbReportOperation) model.ReportOperation {
 	op := model.ReportOperation{
 		ID:             dbOp.ID,
+		MerchantID:     dbOp.MerchantID,
 		UserID:         dbOp.UserID,
 		Type:           model.OperationType(dbOp.Type),
 		Currency:       dbOp.Currency,
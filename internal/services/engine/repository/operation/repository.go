@@ -11,6 +11,8 @@ const (
 
 	toolTable     = "tool"
 	toolTableAbbr = "tl"
+
+	operationAttemptTable = "operation_attempt"
 )
 
 type Repository struct {
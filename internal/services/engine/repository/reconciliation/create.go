@@ -0,0 +1,116 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) Create(ctx context.Context, run *model.ReconciliationRun) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "reconciliation.Repository.Create")
+	defer span.End()
+
+	if run.ID != 0 {
+		return fmt.Errorf("creating reconciliation run with existing ID: %v", run.ID)
+	}
+
+	dbDiscrepancies := make([]dbDiscrepancy, 0, len(run.Discrepancies))
+	for _, d := range run.Discrepancies {
+		dbDiscrepancies = append(dbDiscrepancies, discrepancyToDB(d))
+	}
+
+	dbR, err := r.dbCreate(ctx, runToDB(run), dbDiscrepancies)
+	if err != nil {
+		return err
+	}
+
+	run.ID = dbR.ID
+	run.CreatedAt = dbR.CreatedAt
+	return nil
+}
+
+func (r *Repository) dbCreate(ctx context.Context, dbR dbRun, dbDiscrepancies []dbDiscrepancy) (dbRun, error) {
+	dbTX, err := r.conn.Begin(ctx)
+	if err != nil {
+		return dbR, err
+	}
+	defer r.dbRollback(ctx, dbTX)
+
+	if err = dbTX.QueryRow(ctx, fmt.Sprintf(`
+INSERT INTO %v (merchant_id,
+                external_system,
+                period_from,
+                period_to,
+                registry_count,
+                matched_count,
+                discrepancy_count)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at`,
+		runTable),
+		dbR.MerchantID,
+		dbR.ExternalSystem,
+		dbR.PeriodFrom,
+		dbR.PeriodTo,
+		dbR.RegistryCount,
+		dbR.MatchedCount,
+		dbR.DiscrepancyCount,
+	).Scan(
+		&dbR.ID,
+		&dbR.CreatedAt,
+	); err != nil {
+		return dbR, err
+	}
+
+	if len(dbDiscrepancies) > 0 {
+		rows := make([][]any, 0, len(dbDiscrepancies))
+		for _, dbD := range dbDiscrepancies {
+			rows = append(rows, []any{
+				dbR.ID,
+				dbD.Type,
+				dbD.ExternalID,
+				dbD.OperationID,
+				dbD.Currency,
+				dbD.Amount,
+				dbD.Status,
+				dbD.RegistryCurrency,
+				dbD.RegistryAmount,
+				dbD.RegistryStatus,
+			})
+		}
+
+		_, err = dbTX.CopyFrom(ctx, pgx.Identifier{discrepancyTable}, []string{
+			"run_id",
+			"type",
+			"external_id",
+			"operation_id",
+			"currency",
+			"amount",
+			"status",
+			"registry_currency",
+			"registry_amount",
+			"registry_status",
+		}, pgx.CopyFromRows(rows))
+		if err != nil {
+			return dbR, err
+		}
+	}
+
+	if err = dbTX.Commit(ctx); err != nil {
+		return dbR, err
+	}
+
+	return dbR, nil
+}
+
+func (r *Repository) dbRollback(ctx context.Context, dbTX pgx.Tx) {
+	err := dbTX.Rollback(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+		slog.ErrorContext(ctx, "failed to rollback db transaction", "error", err)
+	}
+}
@@ -0,0 +1,154 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) All(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "reconciliation.Repository.All")
+	defer span.End()
+
+	dbRuns, err := r.dbGetAll(ctx, externalSystem, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*model.ReconciliationRun, 0, len(dbRuns))
+	for _, dbR := range dbRuns {
+		runs = append(runs, runFromDB(dbR))
+	}
+	return runs, nil
+}
+
+func (r *Repository) GetOne(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "reconciliation.Repository.GetOne")
+	defer span.End()
+
+	dbR, err := r.dbGetOne(ctx, id, merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbDiscrepancies, err := r.dbGetDiscrepancies(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	run := runFromDB(dbR)
+	run.Discrepancies = make([]model.ReconciliationDiscrepancy, 0, len(dbDiscrepancies))
+	for _, dbD := range dbDiscrepancies {
+		run.Discrepancies = append(run.Discrepancies, discrepancyFromDB(dbD))
+	}
+	return run, nil
+}
+
+func (r *Repository) dbGetOne(ctx context.Context, id int64, merchantID *string) (dbRun, error) {
+	var dbR dbRun
+
+	whereStmt, args := r.whereStmt(&id, "", merchantID)
+
+	err := pgxscan.Get(ctx, r.conn, &dbR, fmt.Sprintf(`
+SELECT id,
+       merchant_id,
+       external_system,
+       period_from,
+       period_to,
+       registry_count,
+       matched_count,
+       discrepancy_count,
+       created_at
+FROM %v
+%v
+`, runTable, whereStmt), args...)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return dbR, sql.ErrNoRows
+		}
+		return dbR, err
+	}
+
+	return dbR, nil
+}
+
+func (r *Repository) dbGetAll(ctx context.Context, externalSystem string, merchantID *string) ([]dbRun, error) {
+	whereStmt, args := r.whereStmt(nil, externalSystem, merchantID)
+
+	var dbRuns []dbRun
+	err := pgxscan.Select(ctx, r.conn, &dbRuns, fmt.Sprintf(`
+SELECT id,
+       merchant_id,
+       external_system,
+       period_from,
+       period_to,
+       registry_count,
+       matched_count,
+       discrepancy_count,
+       created_at
+FROM %v
+%v ORDER BY id DESC LIMIT %v
+`, runTable, whereStmt, defaultRunMaxCount), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbRuns, nil
+}
+
+// whereStmt формирует условие выборки запусков сверки. Пустой магазин merchantID (nil) не ограничивает выборку.
+func (r *Repository) whereStmt(id *int64, externalSystem string, merchantID *string) (string, []any) {
+	var (
+		whereValues []string
+		args        []any
+	)
+
+	if id != nil {
+		args = append(args, *id)
+		whereValues = append(whereValues, fmt.Sprintf("id = $%d", len(args)))
+	}
+
+	if externalSystem != "" {
+		args = append(args, externalSystem)
+		whereValues = append(whereValues, fmt.Sprintf("external_system = $%d", len(args)))
+	}
+
+	if merchantID != nil {
+		args = append(args, *merchantID)
+		whereValues = append(whereValues, fmt.Sprintf("merchant_id = $%d", len(args)))
+	}
+
+	if len(whereValues) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(whereValues, " AND "), args
+}
+
+func (r *Repository) dbGetDiscrepancies(ctx context.Context, runID int64) ([]dbDiscrepancy, error) {
+	var dbDiscrepancies []dbDiscrepancy
+	err := pgxscan.Select(ctx, r.conn, &dbDiscrepancies, fmt.Sprintf(`
+SELECT run_id,
+       type,
+       external_id,
+       operation_id,
+       currency,
+       amount,
+       status,
+       registry_currency,
+       registry_amount,
+       registry_status
+FROM %v
+WHERE run_id = $1
+`, discrepancyTable), runID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbDiscrepancies, nil
+}
@@ -0,0 +1,123 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type dbRun struct {
+	ID               int64     `db:"id"`
+	MerchantID       string    `db:"merchant_id"`
+	ExternalSystem   string    `db:"external_system"`
+	PeriodFrom       time.Time `db:"period_from"`
+	PeriodTo         time.Time `db:"period_to"`
+	RegistryCount    int64     `db:"registry_count"`
+	MatchedCount     int64     `db:"matched_count"`
+	DiscrepancyCount int64     `db:"discrepancy_count"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+func runToDB(run *model.ReconciliationRun) dbRun {
+	return dbRun{
+		ID:               run.ID,
+		MerchantID:       run.MerchantID,
+		ExternalSystem:   run.ExternalSystem,
+		PeriodFrom:       run.PeriodFrom,
+		PeriodTo:         run.PeriodTo,
+		RegistryCount:    run.RegistryCount,
+		MatchedCount:     run.MatchedCount,
+		DiscrepancyCount: run.DiscrepancyCount,
+		CreatedAt:        run.CreatedAt,
+	}
+}
+
+func runFromDB(dbR dbRun) *model.ReconciliationRun {
+	return &model.ReconciliationRun{
+		ID:               dbR.ID,
+		MerchantID:       dbR.MerchantID,
+		ExternalSystem:   dbR.ExternalSystem,
+		PeriodFrom:       dbR.PeriodFrom,
+		PeriodTo:         dbR.PeriodTo,
+		RegistryCount:    dbR.RegistryCount,
+		MatchedCount:     dbR.MatchedCount,
+		DiscrepancyCount: dbR.DiscrepancyCount,
+		CreatedAt:        dbR.CreatedAt,
+	}
+}
+
+type dbDiscrepancy struct {
+	RunID            int64    `db:"run_id"`
+	Type             string   `db:"type"`
+	ExternalID       string   `db:"external_id"`
+	OperationID      *int64   `db:"operation_id"`
+	Currency         *string  `db:"currency"`
+	Amount           *float64 `db:"amount"`
+	Status           *string  `db:"status"`
+	RegistryCurrency *string  `db:"registry_currency"`
+	RegistryAmount   *float64 `db:"registry_amount"`
+	RegistryStatus   *string  `db:"registry_status"`
+}
+
+func discrepancyToDB(d model.ReconciliationDiscrepancy) dbDiscrepancy {
+	dbD := dbDiscrepancy{
+		Type:       string(d.Type),
+		ExternalID: d.ExternalID,
+	}
+
+	if d.OperationID != 0 {
+		dbD.OperationID = &d.OperationID
+
+		amount := convert.CentsToBase(d.Amount)
+		dbD.Amount = &amount
+		dbD.Currency = &d.Currency
+		dbD.Status = (*string)(&d.Status)
+	}
+
+	if d.RegistryStatus != "" {
+		registryAmount := convert.CentsToBase(d.RegistryAmount)
+		dbD.RegistryAmount = &registryAmount
+		dbD.RegistryCurrency = &d.RegistryCurrency
+		dbD.RegistryStatus = (*string)(&d.RegistryStatus)
+	}
+
+	return dbD
+}
+
+func discrepancyFromDB(dbD dbDiscrepancy) model.ReconciliationDiscrepancy {
+	d := model.ReconciliationDiscrepancy{
+		Type:       model.ReconciliationDiscrepancyType(dbD.Type),
+		ExternalID: dbD.ExternalID,
+	}
+
+	if dbD.OperationID != nil {
+		d.OperationID = *dbD.OperationID
+	}
+
+	if dbD.Currency != nil {
+		d.Currency = *dbD.Currency
+	}
+
+	if dbD.Amount != nil {
+		d.Amount = convert.BaseToCents(*dbD.Amount)
+	}
+
+	if dbD.Status != nil {
+		d.Status = model.OperationStatus(*dbD.Status)
+	}
+
+	if dbD.RegistryCurrency != nil {
+		d.RegistryCurrency = *dbD.RegistryCurrency
+	}
+
+	if dbD.RegistryAmount != nil {
+		d.RegistryAmount = convert.BaseToCents(*dbD.RegistryAmount)
+	}
+
+	if dbD.RegistryStatus != nil {
+		d.RegistryStatus = model.OperationExternalStatus(*dbD.RegistryStatus)
+	}
+
+	return d
+}
@@ -0,0 +1,20 @@
+package reconciliation
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+const (
+	runTable         = "reconciliation_run"
+	discrepancyTable = "reconciliation_discrepancy"
+
+	defaultRunMaxCount = 100
+)
+
+type Repository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepository(conn *pgxpool.Pool) *Repository {
+	return &Repository{
+		conn: conn,
+	}
+}
@@ -0,0 +1,71 @@
+package task
+
+import (
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type dbSettings struct {
+	IsPaused           bool   `db:"is_paused"`
+	IntervalSec        *int64 `db:"interval_sec"`
+	OperationBatchSize *int64 `db:"operation_batch_size"`
+}
+
+type dbRun struct {
+	ID             int64     `db:"id"`
+	TaskName       string    `db:"task_name"`
+	InstanceID     string    `db:"instance_id"`
+	TriggerType    string    `db:"trigger_type"`
+	ProcessedCount int64     `db:"processed_count"`
+	SucceededCount int64     `db:"succeeded_count"`
+	ErroredCount   int64     `db:"errored_count"`
+	StartedAt      time.Time `db:"started_at"`
+	FinishedAt     time.Time `db:"finished_at"`
+}
+
+func settingsFromDB(dbS dbSettings) model.SchedulerTaskSettings {
+	s := model.SchedulerTaskSettings{
+		IsPaused: dbS.IsPaused,
+	}
+
+	if dbS.IntervalSec != nil {
+		s.Interval = time.Duration(*dbS.IntervalSec) * time.Second
+	}
+
+	if dbS.OperationBatchSize != nil {
+		s.OperationBatchSize = *dbS.OperationBatchSize
+	}
+
+	return s
+}
+
+func runToDB(r *model.SchedulerTaskRun) dbRun {
+	return dbRun{
+		ID:             r.ID,
+		TaskName:       r.TaskName,
+		InstanceID:     r.InstanceID,
+		TriggerType:    string(r.Trigger),
+		ProcessedCount: r.Processed,
+		SucceededCount: r.Succeeded,
+		ErroredCount:   r.Errored,
+		StartedAt:      r.StartedAt,
+		FinishedAt:     r.FinishedAt,
+	}
+}
+
+func runFromDB(dbR dbRun) *model.SchedulerTaskRun {
+	return &model.SchedulerTaskRun{
+		SchedulerTaskRunResult: model.SchedulerTaskRunResult{
+			Processed: dbR.ProcessedCount,
+			Succeeded: dbR.SucceededCount,
+			Errored:   dbR.ErroredCount,
+		},
+		ID:         dbR.ID,
+		TaskName:   dbR.TaskName,
+		InstanceID: dbR.InstanceID,
+		Trigger:    model.SchedulerTaskTrigger(dbR.TriggerType),
+		StartedAt:  dbR.StartedAt,
+		FinishedAt: dbR.FinishedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package task
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+const (
+	taskTable    = "scheduler_task"
+	taskRunTable = "scheduler_task_run"
+
+	defaultTaskRunMaxCount = 100
+)
+
+type Repository struct {
+	conn *pgxpool.Pool
+}
+
+func NewRepository(conn *pgxpool.Pool) *Repository {
+	return &Repository{
+		conn: conn,
+	}
+}
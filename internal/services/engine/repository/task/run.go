@@ -0,0 +1,85 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+func (r *Repository) CreateRun(ctx context.Context, run *model.SchedulerTaskRun) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "task.Repository.CreateRun")
+	defer span.End()
+
+	dbR := runToDB(run)
+	if dbR.ID != 0 {
+		return fmt.Errorf("creating scheduler task run with existing ID: %v", dbR.ID)
+	}
+
+	if err := r.conn.QueryRow(ctx, fmt.Sprintf(`
+INSERT INTO %v (task_name,
+                instance_id,
+                trigger_type,
+                processed_count,
+                succeeded_count,
+                errored_count,
+                started_at,
+                finished_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id`,
+		taskRunTable),
+		dbR.TaskName,
+		dbR.InstanceID,
+		dbR.TriggerType,
+		dbR.ProcessedCount,
+		dbR.SucceededCount,
+		dbR.ErroredCount,
+		dbR.StartedAt,
+		dbR.FinishedAt,
+	).Scan(
+		&run.ID,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "task.Repository.Runs")
+	defer span.End()
+
+	if maxCount <= 0 {
+		maxCount = defaultTaskRunMaxCount
+	}
+
+	var dbRuns []dbRun
+	err := pgxscan.Select(ctx, r.conn, &dbRuns, fmt.Sprintf(`
+SELECT id,
+       task_name,
+       instance_id,
+       trigger_type,
+       processed_count,
+       succeeded_count,
+       errored_count,
+       started_at,
+       finished_at
+FROM %v
+WHERE task_name = $1
+ORDER BY started_at DESC
+LIMIT %v
+`, taskRunTable, maxCount),
+		name)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*model.SchedulerTaskRun, 0, len(dbRuns))
+	for _, dbR := range dbRuns {
+		runs = append(runs, runFromDB(dbR))
+	}
+	return runs, nil
+}
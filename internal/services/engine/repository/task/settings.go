@@ -0,0 +1,79 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Settings возвращает параметры фоновой задачи, измененные во время работы сервиса.
+// Если параметры не менялись, возвращаются нулевые значения.
+func (r *Repository) Settings(ctx context.Context, name string) (model.SchedulerTaskSettings, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "task.Repository.Settings")
+	defer span.End()
+
+	var dbS dbSettings
+	err := pgxscan.Get(ctx, r.conn, &dbS, fmt.Sprintf(`
+SELECT is_paused,
+       interval_sec,
+       operation_batch_size
+FROM %v
+WHERE name = $1
+`, taskTable),
+		name)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return model.SchedulerTaskSettings{}, nil
+		}
+		return model.SchedulerTaskSettings{}, err
+	}
+
+	return settingsFromDB(dbS), nil
+}
+
+func (r *Repository) SetPaused(ctx context.Context, name string, isPaused bool) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "task.Repository.SetPaused")
+	defer span.End()
+
+	_, err := r.conn.Exec(ctx, fmt.Sprintf(`
+INSERT INTO %[1]v (name, is_paused)
+VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET is_paused  = EXCLUDED.is_paused,
+                                 updated_at = NOW()
+`, taskTable),
+		name,
+		isPaused,
+	)
+	return err
+}
+
+// SetSchedule изменяет интервал запуска и размер пачки операций фоновой задачи.
+// Параметры, переданные как nil, остаются без изменений.
+func (r *Repository) SetSchedule(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "task.Repository.SetSchedule")
+	defer span.End()
+
+	var intervalSec *int64
+	if interval != nil {
+		sec := int64(interval.Seconds())
+		intervalSec = &sec
+	}
+
+	_, err := r.conn.Exec(ctx, fmt.Sprintf(`
+INSERT INTO %[1]v (name, interval_sec, operation_batch_size)
+VALUES ($1, $2, $3)
+ON CONFLICT (name) DO UPDATE SET interval_sec         = COALESCE(EXCLUDED.interval_sec, %[1]v.interval_sec),
+                                 operation_batch_size = COALESCE(EXCLUDED.operation_batch_size, %[1]v.operation_batch_size),
+                                 updated_at           = NOW()
+`, taskTable),
+		name,
+		intervalSec,
+		operationBatchSize,
+	)
+	return err
+}
@@ -5,9 +5,13 @@ import (
 	"errors"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 func (r *Repository) Create(ctx context.Context, tool *model.Tool) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.Create")
+	defer span.End()
+
 	if tool == nil {
 		return errors.New("creating nil tool")
 	}
@@ -28,18 +32,21 @@ func (r *Repository) dbCreate(ctx context.Context, dbT dbTool) (dbTool, error) {
 	var created dbTool
 
 	if err := r.conn.QueryRow(ctx, `
-INSERT INTO tool (id, user_id, external_method, type, details, displayed, name)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, user_id, external_method, type, details, displayed, name, status, fake, created_at, updated_at`,
+INSERT INTO tool (id, merchant_id, user_id, external_method, type, details, displayed, name, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, merchant_id, user_id, external_method, type, details, displayed, name, status, fake, expires_at, expiry_notified_at, created_at, updated_at`,
 		dbT.ID,
+		dbT.MerchantID,
 		dbT.UserID,
 		dbT.ExternalMethod,
 		dbT.Type,
 		dbT.Details,
 		dbT.Displayed,
 		dbT.Name,
+		dbT.ExpiresAt,
 	).Scan(
 		&created.ID,
+		&created.MerchantID,
 		&created.UserID,
 		&created.ExternalMethod,
 		&created.Type,
@@ -48,6 +55,8 @@ RETURNING id, user_id, external_method, type, details, displayed, name, status,
 		&created.Name,
 		&created.Status,
 		&created.Fake,
+		&created.ExpiresAt,
+		&created.ExpiryNotifiedAt,
 		&created.CreatedAt,
 		&created.UpdatedAt,
 	); err != nil {
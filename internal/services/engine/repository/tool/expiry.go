@@ -0,0 +1,74 @@
+package tool
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// ExpireOverdue переводит в статус EXPIRED активные платежные средства, срок действия которых истек к моменту now,
+// и возвращает их количество.
+func (r *Repository) ExpireOverdue(ctx context.Context, now time.Time) (int64, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.ExpireOverdue")
+	defer span.End()
+
+	tag, err := r.conn.Exec(ctx, `
+UPDATE tool
+SET status = $1, updated_at = NOW()
+WHERE status = $2 AND expires_at <= $3
+`, string(model.ToolStatusExpired), string(model.ToolStatusActive), now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ClaimExpiring отмечает как уведомленные и возвращает не больше maxCount активных платежных средств, срок действия
+// которых истекает до момента before. Отметка ставится до отправки уведомления, поэтому несколько экземпляров
+// сервиса не уведомят клиента дважды.
+func (r *Repository) ClaimExpiring(ctx context.Context, before time.Time, maxCount int64) ([]*model.Tool, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.ClaimExpiring")
+	defer span.End()
+
+	var dbTools []dbTool
+	err := pgxscan.Select(ctx, r.conn, &dbTools, `
+UPDATE tool
+SET expiry_notified_at = NOW()
+WHERE (merchant_id, id, user_id, external_method) IN (
+    SELECT merchant_id, id, user_id, external_method
+    FROM tool
+    WHERE status = $1
+      AND expiry_notified_at IS NULL
+      AND expires_at <= $2
+    ORDER BY expires_at
+    LIMIT $3 FOR UPDATE SKIP LOCKED
+)
+RETURNING id, merchant_id, user_id, external_method, type, details, displayed, name, status, fake, expires_at, expiry_notified_at, created_at, updated_at
+`, string(model.ToolStatusActive), before, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	tools := make([]*model.Tool, 0, len(dbTools))
+	for _, dbT := range dbTools {
+		tools = append(tools, toolFromDB(dbT))
+	}
+	return tools, nil
+}
+
+// ResetExpiryNotification снимает отметку об уведомлении, чтобы повторить его при следующем запуске.
+func (r *Repository) ResetExpiryNotification(ctx context.Context, tool *model.Tool) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.ResetExpiryNotification")
+	defer span.End()
+
+	_, err := r.conn.Exec(ctx, `
+UPDATE tool
+SET expiry_notified_at = NULL
+WHERE id = $1 AND merchant_id = $2 AND user_id = $3 AND external_method = $4
+`, tool.ID, tool.MerchantID, tool.UserID, tool.ExternalMethod)
+	return err
+}
@@ -0,0 +1,106 @@
+package tool
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// PendingFingerprint возвращает банковские карты, отпечаток которых миграция 20241026_tool_fingerprint
+// не смогла вычислить из-за зашифрованных реквизитов: у таких карт id совпадает с legacy_id.
+func (r *Repository) PendingFingerprint(ctx context.Context) ([]*model.Tool, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.PendingFingerprint")
+	defer span.End()
+
+	var dbTools []dbTool
+	err := pgxscan.Select(ctx, r.conn, &dbTools, `
+SELECT id,
+       legacy_id,
+       merchant_id,
+       user_id,
+       external_method,
+       type,
+       details,
+       displayed,
+       name,
+       status,
+       fake,
+       expires_at,
+       expiry_notified_at,
+       created_at,
+       updated_at
+FROM tool
+WHERE type = $1
+  AND legacy_id = id
+`, string(model.ToolTypeBankCard))
+	if err != nil {
+		return nil, err
+	}
+
+	tools := make([]*model.Tool, 0, len(dbTools))
+	for _, dbT := range dbTools {
+		tools = append(tools, toolFromDB(dbT))
+	}
+	return tools, nil
+}
+
+// SetFingerprint заменяет прежний идентификатор банковской карты отпечатком fingerprint в самой карте
+// и в операциях с ней. Карта, отпечаток которой уже вычислен другим экземпляром сервиса, не меняется.
+func (r *Repository) SetFingerprint(ctx context.Context, tool *model.Tool, fingerprint string) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.SetFingerprint")
+	defer span.End()
+
+	if tool == nil {
+		return errors.New("updating nil tool")
+	}
+
+	dbTX, err := r.conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := dbTX.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.ErrorContext(ctx, "failed to rollback db transaction", "error", err)
+		}
+	}()
+
+	tag, err := dbTX.Exec(ctx, `
+UPDATE tool
+SET id = $5
+WHERE id = $1 AND merchant_id = $2 AND user_id = $3 AND external_method = $4
+  AND legacy_id = id
+`, tool.ID, tool.MerchantID, tool.UserID, tool.ExternalMethod, fingerprint)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+
+	_, err = dbTX.Exec(ctx, `
+UPDATE operation_metadata m
+SET tool_id = $5
+FROM operation o
+WHERE m.operation_id = o.id
+  AND m.tool_id = $1
+  AND o.merchant_id = $2
+  AND o.user_id = $3
+  AND o.external_method = $4
+`, tool.ID, tool.MerchantID, tool.UserID, tool.ExternalMethod, fingerprint)
+	if err != nil {
+		return err
+	}
+
+	if err = dbTX.Commit(ctx); err != nil {
+		return err
+	}
+
+	tool.ID = fingerprint
+	return nil
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/georgysavva/scany/pgxscan"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
-func (r *Repository) All(ctx context.Context, userID string) ([]*model.Tool, error) {
-	dbTools, err := r.dbGetAll(ctx, userID)
+func (r *Repository) All(ctx context.Context, merchantID, userID string) ([]*model.Tool, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.All")
+	defer span.End()
+
+	dbTools, err := r.dbGetAll(ctx, merchantID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -22,19 +26,26 @@ func (r *Repository) All(ctx context.Context, userID string) ([]*model.Tool, err
 	return tools, nil
 }
 
-func (r *Repository) GetOne(ctx context.Context, id string, userID string, externalMethod string) (*model.Tool, error) {
-	dbT, err := r.dbGetOne(ctx, id, userID, externalMethod)
+// GetOne ищет платежное средство по идентификатору, а если такого нет - по идентификатору, который был у него
+// до перехода на отпечатки карт: клиенты могли сохранить у себя маскированный номер карты.
+func (r *Repository) GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.GetOne")
+	defer span.End()
+
+	dbT, err := r.dbGetOne(ctx, id, merchantID, userID, externalMethod)
 	if err != nil {
 		return nil, err
 	}
 	return toolFromDB(dbT), nil
 }
 
-func (r *Repository) dbGetOne(ctx context.Context, id string, userID string, externalMethod string) (dbTool, error) {
+func (r *Repository) dbGetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (dbTool, error) {
 	var dbT dbTool
 
 	err := pgxscan.Get(ctx, r.conn, &dbT, `
 SELECT id,
+       legacy_id,
+       merchant_id,
        user_id,
        external_method,
        type,
@@ -43,11 +54,15 @@ SELECT id,
        name,
        status,
        fake,
+       expires_at,
+       expiry_notified_at,
        created_at,
        updated_at
 FROM tool 
-WHERE id = $1 AND user_id = $2 AND external_method = $3
-`, id, userID, externalMethod)
+WHERE (id = $1 OR legacy_id = $1) AND merchant_id = $2 AND user_id = $3 AND external_method = $4
+ORDER BY id = $1 DESC
+LIMIT 1
+`, id, merchantID, userID, externalMethod)
 	if err != nil {
 		if pgxscan.NotFound(err) {
 			return dbT, sql.ErrNoRows
@@ -58,10 +73,11 @@ WHERE id = $1 AND user_id = $2 AND external_method = $3
 	return dbT, nil
 }
 
-func (r *Repository) dbGetAll(ctx context.Context, userID string) ([]dbTool, error) {
+func (r *Repository) dbGetAll(ctx context.Context, merchantID, userID string) ([]dbTool, error) {
 	var dbTools []dbTool
 	err := pgxscan.Select(ctx, r.conn, &dbTools, `
 SELECT id,
+       merchant_id,
        user_id,
        external_method,
        type,
@@ -70,11 +86,13 @@ SELECT id,
        name,
        status,
        fake,
+       expires_at,
+       expiry_notified_at,
        created_at,
        updated_at
 FROM tool
-WHERE user_id = $1
-`, userID)
+WHERE merchant_id = $1 AND user_id = $2
+`, merchantID, userID)
 	if err != nil {
 		return nil, err
 	}
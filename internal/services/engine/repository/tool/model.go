@@ -7,22 +7,27 @@ import (
 )
 
 type dbTool struct {
-	ID             string         `db:"id"`
-	UserID         string         `db:"user_id"`
-	ExternalMethod string         `db:"external_method"`
-	Type           *string        `db:"type"`
-	Details        map[string]any `db:"details"`
-	Displayed      string         `db:"displayed"`
-	Name           string         `db:"name"`
-	Status         string         `db:"status"`
-	Fake           bool           `db:"fake"`
-	CreatedAt      time.Time      `db:"created_at"`
-	UpdatedAt      time.Time      `db:"updated_at"`
+	ID               string         `db:"id"`
+	LegacyID         *string        `db:"legacy_id"`
+	MerchantID       string         `db:"merchant_id"`
+	UserID           string         `db:"user_id"`
+	ExternalMethod   string         `db:"external_method"`
+	Type             *string        `db:"type"`
+	Details          map[string]any `db:"details"`
+	Displayed        string         `db:"displayed"`
+	Name             string         `db:"name"`
+	Status           string         `db:"status"`
+	Fake             bool           `db:"fake"`
+	ExpiresAt        *time.Time     `db:"expires_at"`
+	ExpiryNotifiedAt *time.Time     `db:"expiry_notified_at"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
 }
 
 func toolToDB(t *model.Tool) dbTool {
 	dbT := dbTool{
 		ID:             t.ID,
+		MerchantID:     t.MerchantID,
 		UserID:         t.UserID,
 		ExternalMethod: t.ExternalMethod,
 		Displayed:      t.Displayed,
@@ -41,12 +46,21 @@ func toolToDB(t *model.Tool) dbTool {
 		dbT.Details = t.Details
 	}
 
+	if !t.ExpiresAt.IsZero() {
+		dbT.ExpiresAt = &t.ExpiresAt
+	}
+
+	if !t.ExpiryNotifiedAt.IsZero() {
+		dbT.ExpiryNotifiedAt = &t.ExpiryNotifiedAt
+	}
+
 	return dbT
 }
 
 func toolFromDB(dbT dbTool) *model.Tool {
 	t := &model.Tool{
 		ID:             dbT.ID,
+		MerchantID:     dbT.MerchantID,
 		UserID:         dbT.UserID,
 		ExternalMethod: dbT.ExternalMethod,
 		Displayed:      dbT.Displayed,
@@ -61,9 +75,21 @@ func toolFromDB(dbT dbTool) *model.Tool {
 		t.Type = model.ToolType(*dbT.Type)
 	}
 
+	if dbT.LegacyID != nil {
+		t.LegacyID = *dbT.LegacyID
+	}
+
 	if len(dbT.Details) > 0 {
 		t.Details = dbT.Details
 	}
 
+	if dbT.ExpiresAt != nil {
+		t.ExpiresAt = dbT.ExpiresAt.UTC()
+	}
+
+	if dbT.ExpiryNotifiedAt != nil {
+		t.ExpiryNotifiedAt = dbT.ExpiryNotifiedAt.UTC()
+	}
+
 	return t
 }
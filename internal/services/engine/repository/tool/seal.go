@@ -0,0 +1,68 @@
+package tool
+
+import (
+	"context"
+	"errors"
+
+	"github.com/georgysavva/scany/pgxscan"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
+
+// Unsealed возвращает не больше maxCount платежных средств, в реквизитах которых есть открытые значения
+// под ключами sensitiveKeys.
+func (r *Repository) Unsealed(ctx context.Context, sensitiveKeys []string, maxCount int64) ([]*model.Tool, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.Unsealed")
+	defer span.End()
+
+	var dbTools []dbTool
+	err := pgxscan.Select(ctx, r.conn, &dbTools, `
+SELECT id,
+       merchant_id,
+       user_id,
+       external_method,
+       type,
+       details,
+       displayed,
+       name,
+       status,
+       fake,
+       expires_at,
+       expiry_notified_at,
+       created_at,
+       updated_at
+FROM tool
+WHERE details ?| $1
+LIMIT $2
+`, sensitiveKeys, maxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	tools := make([]*model.Tool, 0, len(dbTools))
+	for _, dbT := range dbTools {
+		tools = append(tools, toolFromDB(dbT))
+	}
+	return tools, nil
+}
+
+// UpdateDetails сохраняет зашифрованные реквизиты платежного средства. Строка обновляется, только если в ней
+// еще остались открытые значения под ключами sensitiveKeys, поэтому одновременная обработка несколькими
+// экземплярами сервиса безопасна.
+func (r *Repository) UpdateDetails(ctx context.Context, tool *model.Tool, sensitiveKeys []string) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.UpdateDetails")
+	defer span.End()
+
+	if tool == nil {
+		return errors.New("updating nil tool")
+	}
+
+	_, err := r.conn.Exec(ctx, `
+UPDATE tool
+SET details = $5
+WHERE id = $1 AND merchant_id = $2 AND user_id = $3 AND external_method = $4
+  AND details ?| $6
+`, tool.ID, tool.MerchantID, tool.UserID, tool.ExternalMethod, tool.Details, sensitiveKeys)
+	return err
+}
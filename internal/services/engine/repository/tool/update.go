@@ -5,9 +5,13 @@ import (
 	"errors"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 func (r *Repository) Update(ctx context.Context, tool *model.Tool) error {
+	ctx, span := tracing.StartStorageSpan(ctx, "tool.Repository.Update")
+	defer span.End()
+
 	if tool == nil {
 		return errors.New("updating nil tool")
 	}
@@ -29,16 +33,18 @@ func (r *Repository) dbUpdate(ctx context.Context, dbT dbTool) (dbTool, error) {
 
 	if err := r.conn.QueryRow(ctx, `
 UPDATE tool
-SET name = $4, status = $5, updated_at = NOW()
-WHERE id = $1 AND user_id = $2 AND external_method = $3
-RETURNING id, user_id, external_method, type, details, displayed, name, status, fake, created_at, updated_at`,
+SET name = $5, status = $6, updated_at = NOW()
+WHERE id = $1 AND merchant_id = $2 AND user_id = $3 AND external_method = $4
+RETURNING id, merchant_id, user_id, external_method, type, details, displayed, name, status, fake, expires_at, expiry_notified_at, created_at, updated_at`,
 		dbT.ID,
+		dbT.MerchantID,
 		dbT.UserID,
 		dbT.ExternalMethod,
 		dbT.Name,
 		dbT.Status,
 	).Scan(
 		&updated.ID,
+		&updated.MerchantID,
 		&updated.UserID,
 		&updated.ExternalMethod,
 		&updated.Type,
@@ -47,6 +53,8 @@ RETURNING id, user_id, external_method, type, details, displayed, name, status,
 		&updated.Name,
 		&updated.Status,
 		&updated.Fake,
+		&updated.ExpiresAt,
+		&updated.ExpiryNotifiedAt,
 		&updated.CreatedAt,
 		&updated.UpdatedAt,
 	); err != nil {
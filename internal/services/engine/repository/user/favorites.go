@@ -9,10 +9,14 @@ import (
 	"github.com/georgysavva/scany/pgxscan"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 func (r *Repository) AddToFavorites(ctx context.Context, data model.FavoritesData) error {
-	favorites, err := r.dbGetFavorites(ctx, data.UserID)
+	ctx, span := tracing.StartStorageSpan(ctx, "user.Repository.AddToFavorites")
+	defer span.End()
+
+	favorites, err := r.dbGetFavorites(ctx, data.MerchantID, data.UserID)
 	if err != nil && !pgxscan.NotFound(err) {
 		return err
 	}
@@ -33,11 +37,14 @@ func (r *Repository) AddToFavorites(ctx context.Context, data model.FavoritesDat
 		return fmt.Errorf("unresolved operation type: %q", data.OperationType)
 	}
 
-	return r.dbSetFavorites(ctx, data.UserID, favorites)
+	return r.dbSetFavorites(ctx, data.MerchantID, data.UserID, favorites)
 }
 
 func (r *Repository) RemoveFromFavorites(ctx context.Context, data model.FavoritesData) error {
-	favorites, err := r.dbGetFavorites(ctx, data.UserID)
+	ctx, span := tracing.StartStorageSpan(ctx, "user.Repository.RemoveFromFavorites")
+	defer span.End()
+
+	favorites, err := r.dbGetFavorites(ctx, data.MerchantID, data.UserID)
 	if err != nil {
 		if pgxscan.NotFound(err) {
 			return nil
@@ -82,11 +89,14 @@ func (r *Repository) RemoveFromFavorites(ctx context.Context, data model.Favorit
 		return fmt.Errorf("unresolved operation type: %q", data.OperationType)
 	}
 
-	return r.dbSetFavorites(ctx, data.UserID, favorites)
+	return r.dbSetFavorites(ctx, data.MerchantID, data.UserID, favorites)
 }
 
-func (r *Repository) GetFavorites(ctx context.Context, userID string) (model.UserFavorites, error) {
-	favorites, err := r.dbGetFavorites(ctx, userID)
+func (r *Repository) GetFavorites(ctx context.Context, merchantID, userID string) (model.UserFavorites, error) {
+	ctx, span := tracing.StartStorageSpan(ctx, "user.Repository.GetFavorites")
+	defer span.End()
+
+	favorites, err := r.dbGetFavorites(ctx, merchantID, userID)
 	if err != nil {
 		if pgxscan.NotFound(err) {
 			return model.UserFavorites{}, nil
@@ -96,11 +106,11 @@ func (r *Repository) GetFavorites(ctx context.Context, userID string) (model.Use
 	return userFavoritesFromDB(favorites), nil
 }
 
-func (r *Repository) dbGetFavorites(ctx context.Context, userID string) (dbUserFavorites, error) {
+func (r *Repository) dbGetFavorites(ctx context.Context, merchantID, userID string) (dbUserFavorites, error) {
 	var favoritesRaw []byte
 	if err := pgxscan.Get(ctx, r.conn, &favoritesRaw,
-		`SELECT favorites FROM "user" WHERE id = $1`,
-		userID,
+		`SELECT favorites FROM "user" WHERE merchant_id = $1 AND id = $2`,
+		merchantID, userID,
 	); err != nil {
 		return dbUserFavorites{}, err
 	}
@@ -115,15 +125,15 @@ func (r *Repository) dbGetFavorites(ctx context.Context, userID string) (dbUserF
 	return result, nil
 }
 
-func (r *Repository) dbSetFavorites(ctx context.Context, userID string, favorites dbUserFavorites) error {
+func (r *Repository) dbSetFavorites(ctx context.Context, merchantID, userID string, favorites dbUserFavorites) error {
 	favoritesRaw, err := json.Marshal(favorites)
 	if err != nil {
 		return fmt.Errorf("marshal favorites %v: %w", favorites, err)
 	}
 
 	_, err = r.conn.Exec(ctx,
-		`INSERT INTO "user" (id, favorites) VALUES($1, $2) ON CONFLICT (id) DO UPDATE SET favorites = $2`,
-		userID, favoritesRaw,
+		`INSERT INTO "user" (merchant_id, id, favorites) VALUES($1, $2, $3) ON CONFLICT (merchant_id, id) DO UPDATE SET favorites = $3`,
+		merchantID, userID, favoritesRaw,
 	)
 	return err
 }
@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+// defaultClaimLease - время, на которое экземпляр сервиса захватывает операции, если оно не задано в конфигурации
+const defaultClaimLease = 5 * time.Minute
+
+// operationClaimer захватывает пачки операций от имени экземпляра сервиса, чтобы несколько
+// экземпляров не обрабатывали одни и те же операции одновременно.
+type operationClaimer struct {
+	operationService OperationService
+	instanceID       string
+	lease            time.Duration
+}
+
+func newOperationClaimer(operationService OperationService, instanceID string, leaseSec int) operationClaimer {
+	lease := time.Duration(leaseSec) * time.Second
+	if lease <= 0 {
+		lease = defaultClaimLease
+	}
+
+	return operationClaimer{
+		operationService: operationService,
+		instanceID:       instanceID,
+		lease:            lease,
+	}
+}
+
+func (c operationClaimer) claim(ctx context.Context, criteria model.OperationCriteria) ([]*model.Operation, error) {
+	return c.operationService.Claim(ctx, criteria, c.instanceID, c.lease)
+}
+
+func (c operationClaimer) release(ctx context.Context, log *slog.Logger, operations []*model.Operation) {
+	ids := make([]int64, 0, len(operations))
+	for _, op := range operations {
+		ids = append(ids, op.ID)
+	}
+
+	if err := c.operationService.Release(ctx, ids, c.instanceID); err != nil {
+		log.ErrorContext(
+			ctx,
+			"failed to release claimed operations",
+			"error", err,
+		)
+	}
+}
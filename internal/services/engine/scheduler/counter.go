@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	"sync/atomic"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+// runCounter подсчитывает результаты обработки операций в рамках одного запуска фоновой задачи.
+type runCounter struct {
+	processed atomic.Int64
+	succeeded atomic.Int64
+	errored   atomic.Int64
+}
+
+func (c *runCounter) done(err error) {
+	c.processed.Add(1)
+	if err != nil {
+		c.errored.Add(1)
+		return
+	}
+	c.succeeded.Add(1)
+}
+
+func (c *runCounter) result() model.SchedulerTaskRunResult {
+	return model.SchedulerTaskRunResult{
+		Processed: c.processed.Load(),
+		Succeeded: c.succeeded.Load(),
+		Errored:   c.errored.Load(),
+	}
+}
@@ -0,0 +1,212 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/gammazero/workerpool"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/config"
+)
+
+// stuckOperationAge - возраст, после которого незавершенный платеж с конечным статусом на стороне ПС считается зависшим
+const stuckOperationAge = 24 * time.Hour
+
+const detectStatusDriftTaskName = "detect_status_drift"
+
+var errUnsupportedTransition = errors.New("status transition is not supported")
+
+type DetectStatusDriftTask struct {
+	interval           time.Duration
+	operationBatchSize int64
+	maxWorkers         int
+	lookback           time.Duration
+	autoFix            bool
+	claimer            operationClaimer
+	integrationClient  IntegrationClient
+	driftService       DriftService
+	statusService      StatusService
+}
+
+func NewDetectStatusDriftTask(
+	cfg config.SchedulerTaskConfig,
+	instanceID string,
+	operationService OperationService,
+	integrationClient IntegrationClient,
+	driftService DriftService,
+	statusService StatusService,
+) *DetectStatusDriftTask {
+	return &DetectStatusDriftTask{
+		interval:           time.Duration(cfg.Interval) * time.Second,
+		operationBatchSize: cfg.OperationBatchSize,
+		maxWorkers:         cfg.MaxWorkers,
+		lookback:           time.Duration(cfg.LookbackDays) * 24 * time.Hour,
+		autoFix:            cfg.AutoFix,
+		claimer:            newOperationClaimer(operationService, instanceID, cfg.ClaimLease),
+		integrationClient:  integrationClient,
+		driftService:       driftService,
+		statusService:      statusService,
+	}
+}
+
+func (t *DetectStatusDriftTask) Name() string {
+	return detectStatusDriftTaskName
+}
+
+func (t *DetectStatusDriftTask) Interval() time.Duration {
+	return t.interval
+}
+
+func (t *DetectStatusDriftTask) OperationBatchSize() int64 {
+	return t.operationBatchSize
+}
+
+func (t *DetectStatusDriftTask) Execute(ctx context.Context, operationBatchSize int64) model.SchedulerTaskRunResult {
+	criteria := model.OperationCriteria{
+		StatusesByType: map[model.OperationType][]model.OperationStatus{
+			model.OperationTypePayment: {model.OperationStatusSuccess, model.OperationStatusFailed, model.OperationStatusNew},
+			model.OperationTypePayout:  {model.OperationStatusSuccess, model.OperationStatusFailed},
+		},
+		CreatedAtFrom: time.Now().UTC().Add(-t.lookback),
+		MaxCount:      operationBatchSize,
+	}
+
+	log := slog.Default().With("task", detectStatusDriftTaskName)
+
+	operations, err := t.claimer.claim(ctx, criteria)
+	if err != nil {
+		log.ErrorContext(
+			ctx,
+			"failed to receive operations by criteria",
+			"error", err,
+		)
+		return model.SchedulerTaskRunResult{}
+	}
+
+	var counter runCounter
+
+	// начатая обработка операции не прерывается при остановке сервиса
+	workCtx := context.WithoutCancel(ctx)
+
+	wp := workerpool.New(t.maxWorkers)
+
+	for _, operation := range operations {
+		operation := operation
+
+		wp.Submit(func() {
+			if ctx.Err() != nil {
+				return
+			}
+
+			ctx := workCtx
+
+			log := log.With("operation_id", operation.ID)
+
+			if operation.Status == model.OperationStatusNew && time.Since(operation.CreatedAt) < stuckOperationAge {
+				return
+			}
+
+			err := t.detect(ctx, operation)
+			if err != nil {
+				log.ErrorContext(
+					ctx,
+					"failed to detect status drift",
+					"error", err,
+				)
+			}
+			counter.done(err)
+		})
+	}
+
+	wp.StopWait()
+
+	t.claimer.release(workCtx, log, operations)
+
+	return counter.result()
+}
+
+func (t *DetectStatusDriftTask) detect(ctx context.Context, operation *model.Operation) error {
+	data := model.GetOperationStatusData{
+		CreatedAt:      operation.CreatedAt,
+		ExternalID:     operation.ExternalID,
+		ExternalSystem: operation.ExternalSystem,
+		ExternalMethod: operation.ExternalMethod,
+		Currency:       operation.Currency,
+		OperationType:  operation.Type,
+		OperationID:    operation.ID,
+		MerchantID:     operation.MerchantID,
+		UserID:         operation.UserID,
+		Amount:         operation.Amount,
+	}
+
+	result, err := t.integrationClient.GetOperationStatus(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to get operation external status: %w", err)
+	}
+
+	if !isStatusDrift(operation.Status, result.ExternalStatus) {
+		return nil
+	}
+
+	drift := &model.OperationStatusDrift{
+		OperationID:    operation.ID,
+		OperationType:  operation.Type,
+		ExternalSystem: operation.ExternalSystem,
+		ExternalMethod: operation.ExternalMethod,
+		Status:         operation.Status,
+		ExternalStatus: operation.ExternalStatus,
+		ProviderStatus: result.ExternalStatus,
+	}
+
+	if t.autoFix {
+		if err = t.fix(ctx, operation, result.ExternalStatus); err != nil {
+			drift.FixError = err.Error()
+		} else {
+			drift.Fixed = true
+		}
+	}
+
+	slog.WarnContext(
+		ctx,
+		"operation status drift detected",
+		"operation_id", operation.ID,
+		"status", operation.Status,
+		"provider_status", result.ExternalStatus,
+		"fixed", drift.Fixed,
+	)
+
+	if err = t.driftService.Record(ctx, drift); err != nil {
+		return fmt.Errorf("failed to record status drift: %w", err)
+	}
+
+	return nil
+}
+
+func (t *DetectStatusDriftTask) fix(ctx context.Context, operation *model.Operation, providerStatus model.OperationExternalStatus) error {
+	result, err := t.statusService.Change(ctx, operation.ID, operation.Status, providerStatus)
+	if err != nil {
+		return err
+	}
+
+	if result == "" {
+		return errUnsupportedTransition
+	}
+
+	return nil
+}
+
+// isStatusDrift сообщает, расходится ли статус операции с конечным статусом на стороне ПС.
+func isStatusDrift(status model.OperationStatus, providerStatus model.OperationExternalStatus) bool {
+	switch providerStatus {
+	case model.OperationExternalStatusSuccess:
+		return status != model.OperationStatusSuccess
+	case model.OperationExternalStatusFailed:
+		return status != model.OperationStatusFailed
+	default:
+		return false
+	}
+}
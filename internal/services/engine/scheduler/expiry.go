@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/config"
+)
+
+const defaultNotifyBeforeExpiry = 14 * 24 * time.Hour
+
+const trackToolExpiryTaskName = "track_tool_expiry"
+
+// TrackToolExpiryTask переводит платежные средства с истекшим сроком действия в статус EXPIRED
+// и заранее уведомляет клиентов о скором окончании срока действия карт.
+type TrackToolExpiryTask struct {
+	interval      time.Duration
+	toolBatchSize int64
+	notifyBefore  time.Duration
+	toolService   ToolService
+}
+
+func NewTrackToolExpiryTask(cfg config.SchedulerTaskConfig, toolService ToolService) *TrackToolExpiryTask {
+	notifyBefore := time.Duration(cfg.NotifyBeforeDays) * 24 * time.Hour
+	if cfg.NotifyBeforeDays <= 0 {
+		notifyBefore = defaultNotifyBeforeExpiry
+	}
+	return &TrackToolExpiryTask{
+		interval:      time.Duration(cfg.Interval) * time.Second,
+		toolBatchSize: cfg.OperationBatchSize,
+		notifyBefore:  notifyBefore,
+		toolService:   toolService,
+	}
+}
+
+func (t *TrackToolExpiryTask) Name() string {
+	return trackToolExpiryTaskName
+}
+
+func (t *TrackToolExpiryTask) Interval() time.Duration {
+	return t.interval
+}
+
+func (t *TrackToolExpiryTask) OperationBatchSize() int64 {
+	return t.toolBatchSize
+}
+
+// Execute обрабатывает платежные средства, а не операции: размер пачки ограничивает число отправляемых уведомлений.
+func (t *TrackToolExpiryTask) Execute(ctx context.Context, toolBatchSize int64) model.SchedulerTaskRunResult {
+	log := slog.Default().With("task", trackToolExpiryTaskName)
+
+	var counter runCounter
+
+	now := time.Now().UTC()
+
+	expired, err := t.toolService.ExpireOverdue(ctx, now)
+	if err != nil {
+		log.ErrorContext(
+			ctx,
+			"failed to expire overdue tools",
+			"error", err,
+		)
+	} else if expired > 0 {
+		log.InfoContext(
+			ctx,
+			"overdue tools have been expired",
+			"count", expired,
+		)
+	}
+
+	tools, err := t.toolService.ClaimExpiring(ctx, now.Add(t.notifyBefore), toolBatchSize)
+	if err != nil {
+		log.ErrorContext(
+			ctx,
+			"failed to receive expiring tools",
+			"error", err,
+		)
+		return counter.result()
+	}
+
+	for _, tool := range tools {
+		err := t.toolService.NotifyExpiry(context.WithoutCancel(ctx), tool)
+		if err != nil {
+			log.ErrorContext(
+				ctx,
+				"failed to notify user about tool expiry",
+				"tool_id", tool.ID,
+				"user_id", tool.UserID,
+				"error", err,
+			)
+		}
+		counter.done(err)
+	}
+
+	return counter.result()
+}
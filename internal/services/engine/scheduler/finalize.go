@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/gammazero/workerpool"
@@ -12,24 +13,31 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/config"
 )
 
+const finalizeOperationsTaskName = "finalize_operations"
+
 type FinalizeOperationsTask struct {
 	interval                 time.Duration
 	operationBatchSize       int64
 	maxWorkers               int
 	actualizeStatusIntervals map[time.Duration]time.Duration
 	externalSystemLifetime   map[string]time.Duration
-	operationService         OperationService
+	claimer                  operationClaimer
+	retryBudget              retryBudget
 	integrationClient        IntegrationClient
 	paymentService           PaymentService
 	payoutService            PayoutService
+	toolService              ToolService
 }
 
 func NewFinalizeOperationsTask(
 	cfg config.SchedulerTaskConfig,
+	instanceID string,
 	operationService OperationService,
 	integrationClient IntegrationClient,
 	paymentService PaymentService,
 	payoutService PayoutService,
+	toolService ToolService,
+	deadLetterService DeadLetterService,
 ) *FinalizeOperationsTask {
 	task := &FinalizeOperationsTask{
 		interval:                 time.Duration(cfg.Interval) * time.Second,
@@ -37,10 +45,12 @@ func NewFinalizeOperationsTask(
 		maxWorkers:               cfg.MaxWorkers,
 		actualizeStatusIntervals: make(map[time.Duration]time.Duration, len(cfg.ActualizeStatusIntervals)),
 		externalSystemLifetime:   make(map[string]time.Duration, len(cfg.ExternalSystemLifetime)),
-		operationService:         operationService,
+		claimer:                  newOperationClaimer(operationService, instanceID, cfg.ClaimLease),
+		retryBudget:              newRetryBudget(deadLetterService, finalizeOperationsTaskName, cfg.RetryBudget),
 		integrationClient:        integrationClient,
 		paymentService:           paymentService,
 		payoutService:            payoutService,
+		toolService:              toolService,
 	}
 
 	for slot, interval := range cfg.ActualizeStatusIntervals {
@@ -54,49 +64,74 @@ func NewFinalizeOperationsTask(
 	return task
 }
 
-func (t *FinalizeOperationsTask) Start(ctx context.Context) {
+func (t *FinalizeOperationsTask) Name() string {
+	return finalizeOperationsTaskName
+}
+
+func (t *FinalizeOperationsTask) Interval() time.Duration {
+	return t.interval
+}
+
+func (t *FinalizeOperationsTask) OperationBatchSize() int64 {
+	return t.operationBatchSize
+}
+
+// Execute обрабатывает операции каждой платежной системы параллельно.
+func (t *FinalizeOperationsTask) Execute(ctx context.Context, operationBatchSize int64) model.SchedulerTaskRunResult {
+	var (
+		counter runCounter
+		wg      sync.WaitGroup
+	)
+
 	for externalSystem := range t.externalSystemLifetime {
+		wg.Add(1)
 		go func(externalSystem string) {
-			ticker := time.NewTicker(t.interval)
-			defer ticker.Stop()
-
-			for {
-				select {
-				case <-ticker.C:
-					t.execute(ctx, externalSystem)
-				case <-ctx.Done():
-					return
-				}
-			}
+			defer wg.Done()
+			t.execute(ctx, externalSystem, operationBatchSize, &counter)
 		}(externalSystem)
 	}
+
+	wg.Wait()
+
+	return counter.result()
 }
 
-func (t *FinalizeOperationsTask) execute(ctx context.Context, externalSystem string) {
+func (t *FinalizeOperationsTask) execute(ctx context.Context, externalSystem string, operationBatchSize int64, counter *runCounter) {
 	criteria := model.OperationCriteria{
-		Statuses:        &[]model.OperationStatus{model.OperationStatusNew},
+		StatusesByType: map[model.OperationType][]model.OperationStatus{
+			model.OperationTypePayment: {model.OperationStatusNew},
+			model.OperationTypePayout:  {model.OperationStatusNew, model.OperationStatusPending},
+		},
 		ExternalSystems: &[]string{externalSystem},
-		MaxCount:        t.operationBatchSize,
+		MaxCount:        operationBatchSize,
 	}
 
-	log := slog.Default().With("task", "finalize_operations")
+	log := slog.Default().With("task", finalizeOperationsTaskName)
 
-	operations, err := t.operationService.All(ctx, criteria)
+	operations, err := t.claimer.claim(ctx, criteria)
 	if err != nil {
-		log.Error(
+		log.ErrorContext(
+			ctx,
 			"failed to receive operations by criteria",
 			"error", err,
 		)
 		return
 	}
 
+	// начатая обработка операции не прерывается при остановке сервиса
+	workCtx := context.WithoutCancel(ctx)
+
 	wp := workerpool.New(t.maxWorkers)
 
 	for _, operation := range operations {
 		operation := operation
 
 		wp.Submit(func() {
-			ctx := context.Background()
+			if ctx.Err() != nil {
+				return
+			}
+
+			ctx := workCtx
 
 			log := log.With("operation_id", operation.ID)
 
@@ -104,27 +139,44 @@ func (t *FinalizeOperationsTask) execute(ctx context.Context, externalSystem str
 				return
 			}
 
-			if operation.Status != model.OperationStatusNew {
-				return
-			}
-
 			switch operation.Type {
 			case model.OperationTypePayment:
-				if err := t.finalizePayment(ctx, operation); err != nil {
-					log.Error(
+				if operation.Status != model.OperationStatusNew {
+					return
+				}
+
+				err := t.finalizePayment(ctx, operation)
+				if err != nil {
+					log.ErrorContext(
+						ctx,
 						"failed to finalize payment",
 						"error", err,
 					)
 				}
+				t.retryBudget.done(ctx, log, operation, err)
+				counter.done(err)
 			case model.OperationTypePayout:
-				if err := t.finalizePayout(ctx, operation); err != nil {
-					log.Error(
+				var err error
+				switch operation.Status {
+				case model.OperationStatusNew:
+					err = t.finalizePayout(ctx, operation)
+				case model.OperationStatusPending:
+					err = t.finalizePendingPayout(ctx, operation)
+				default:
+					return
+				}
+				if err != nil {
+					log.ErrorContext(
+						ctx,
 						"failed to finalize payout",
 						"error", err,
 					)
 				}
+				t.retryBudget.done(ctx, log, operation, err)
+				counter.done(err)
 			default:
-				log.Error(
+				log.ErrorContext(
+					ctx,
 					"unresolved operation type",
 					"type", operation.Type,
 				)
@@ -133,6 +185,8 @@ func (t *FinalizeOperationsTask) execute(ctx context.Context, externalSystem str
 	}
 
 	wp.StopWait()
+
+	t.claimer.release(workCtx, log, operations)
 }
 
 func (t *FinalizeOperationsTask) finalizePayment(ctx context.Context, operation *model.Operation) error {
@@ -144,6 +198,7 @@ func (t *FinalizeOperationsTask) finalizePayment(ctx context.Context, operation
 		Currency:       operation.Currency,
 		OperationType:  operation.Type,
 		OperationID:    operation.ID,
+		MerchantID:     operation.MerchantID,
 		UserID:         operation.UserID,
 		Amount:         operation.Amount,
 	}
@@ -184,9 +239,7 @@ func (t *FinalizeOperationsTask) finalizePayment(ctx context.Context, operation
 }
 
 func (t *FinalizeOperationsTask) finalizePayout(ctx context.Context, operation *model.Operation) error {
-	now := time.Now().UTC()
-
-	if now.Before(operation.CreatedAt.UTC().Add(time.Hour)) {
+	if time.Now().Before(t.payoutService.ConfirmationExpiresAt(operation)) {
 		return nil
 	}
 
@@ -202,6 +255,65 @@ func (t *FinalizeOperationsTask) finalizePayout(ctx context.Context, operation *
 	return nil
 }
 
+// finalizePendingPayout запрашивает статус выплаты, ожидающей обработки на стороне ПС.
+func (t *FinalizeOperationsTask) finalizePendingPayout(ctx context.Context, operation *model.Operation) error {
+	data := model.GetOperationStatusData{
+		CreatedAt:      operation.CreatedAt,
+		ExternalID:     operation.ExternalID,
+		ExternalSystem: operation.ExternalSystem,
+		ExternalMethod: operation.ExternalMethod,
+		Currency:       operation.Currency,
+		OperationType:  operation.Type,
+		OperationID:    operation.ID,
+		MerchantID:     operation.MerchantID,
+		UserID:         operation.UserID,
+		Amount:         operation.Amount,
+	}
+
+	result, err := t.integrationClient.GetOperationStatus(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to get operation external status: %w", err)
+	}
+
+	externalID := result.ExternalID
+	if externalID == "" {
+		externalID = operation.ExternalID
+	}
+
+	switch result.ExternalStatus {
+	case model.OperationExternalStatusSuccess:
+		tool, err := t.toolService.GetOne(ctx, operation.ToolID, operation.MerchantID, operation.UserID, operation.ExternalMethod)
+		if err != nil {
+			return fmt.Errorf("failed to get payout tool: %w", err)
+		}
+
+		data := model.SuccessPayoutData{
+			ProcessedAt:    result.ProcessedAt,
+			ExternalID:     externalID,
+			ExternalStatus: result.ExternalStatus,
+			OperationID:    operation.ID,
+			Tool:           tool,
+		}
+
+		if err = t.payoutService.Success(ctx, data); err != nil {
+			return fmt.Errorf("failed to success payout: %w", err)
+		}
+	case model.OperationExternalStatusFailed:
+		data := model.FailPayoutData{
+			ExternalID:     externalID,
+			ExternalStatus: result.ExternalStatus,
+			FailReason:     result.FailReason,
+			OperationID:    operation.ID,
+		}
+
+		if err = t.payoutService.Fail(ctx, data); err != nil {
+			return fmt.Errorf("failed to fail payout: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (t *FinalizeOperationsTask) needsActualizeExternalStatus(op *model.Operation) bool {
 	if time.Since(op.UpdatedAt) < t.externalSystemLifetime[op.ExternalSystem] {
 		return false
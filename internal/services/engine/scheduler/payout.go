@@ -11,69 +11,81 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/config"
 )
 
+const requestPayoutsTaskName = "request_payouts"
+
 type RequestPayoutsTask struct {
 	interval           time.Duration
 	operationBatchSize int64
 	maxWorkers         int
-	operationService   OperationService
+	claimer            operationClaimer
+	retryBudget        retryBudget
 	payoutService      PayoutService
 }
 
 func NewRequestPayoutsTask(
 	cfg config.SchedulerTaskConfig,
+	instanceID string,
 	operationService OperationService,
 	payoutService PayoutService,
+	deadLetterService DeadLetterService,
 ) *RequestPayoutsTask {
 	return &RequestPayoutsTask{
 		interval:           time.Duration(cfg.Interval) * time.Second,
 		operationBatchSize: cfg.OperationBatchSize,
 		maxWorkers:         cfg.MaxWorkers,
-		operationService:   operationService,
+		claimer:            newOperationClaimer(operationService, instanceID, cfg.ClaimLease),
+		retryBudget:        newRetryBudget(deadLetterService, requestPayoutsTaskName, cfg.RetryBudget),
 		payoutService:      payoutService,
 	}
 }
 
-func (t *RequestPayoutsTask) Start(ctx context.Context) {
-	go func() {
-		ticker := time.NewTicker(t.interval)
-		defer ticker.Stop()
+func (t *RequestPayoutsTask) Name() string {
+	return requestPayoutsTaskName
+}
 
-		for {
-			select {
-			case <-ticker.C:
-				t.execute(ctx)
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
+func (t *RequestPayoutsTask) Interval() time.Duration {
+	return t.interval
 }
 
-func (t *RequestPayoutsTask) execute(ctx context.Context) {
+func (t *RequestPayoutsTask) OperationBatchSize() int64 {
+	return t.operationBatchSize
+}
+
+func (t *RequestPayoutsTask) Execute(ctx context.Context, operationBatchSize int64) model.SchedulerTaskRunResult {
 	criteria := model.OperationCriteria{
 		Statuses: &[]model.OperationStatus{model.OperationStatusConfirmed},
 		Types:    &[]model.OperationType{model.OperationTypePayout},
-		MaxCount: t.operationBatchSize,
+		MaxCount: operationBatchSize,
 	}
 
-	log := slog.Default().With("task", "request_payouts")
+	log := slog.Default().With("task", requestPayoutsTaskName)
 
-	operations, err := t.operationService.All(ctx, criteria)
+	operations, err := t.claimer.claim(ctx, criteria)
 	if err != nil {
-		log.Error(
+		log.ErrorContext(
+			ctx,
 			"failed to receive operations by criteria",
 			"error", err,
 		)
-		return
+		return model.SchedulerTaskRunResult{}
 	}
 
+	var counter runCounter
+
+	// начатая обработка операции не прерывается при остановке сервиса
+	workCtx := context.WithoutCancel(ctx)
+
 	wp := workerpool.New(t.maxWorkers)
 
 	for _, operation := range operations {
 		operation := operation
 
 		wp.Submit(func() {
-			ctx := context.Background()
+			if ctx.Err() != nil {
+				return
+			}
+
+			ctx := workCtx
 
 			log := log.With("operation_id", operation.ID)
 
@@ -87,20 +99,29 @@ func (t *RequestPayoutsTask) execute(ctx context.Context) {
 				ExternalMethod: operation.ExternalMethod,
 				Currency:       operation.Currency,
 				ToolID:         operation.ToolID,
+				MerchantID:     operation.MerchantID,
 				UserID:         operation.UserID,
+				ClientIP:       operation.ClientIP,
 				Amount:         operation.Amount,
 				OperationID:    operation.ID,
 			}
 
-			if err := t.payoutService.RequestPayout(ctx, requestPayoutData); err != nil {
-				log.Error(
+			err := t.payoutService.RequestPayout(ctx, requestPayoutData)
+			if err != nil {
+				log.ErrorContext(
+					ctx,
 					"failed to request payout",
 					"error", err,
 				)
-				return
 			}
+			t.retryBudget.done(ctx, log, operation, err)
+			counter.done(err)
 		})
 	}
 
 	wp.StopWait()
+
+	t.claimer.release(workCtx, log, operations)
+
+	return counter.result()
 }
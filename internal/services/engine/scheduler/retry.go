@@ -0,0 +1,61 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+// defaultRetryBudget - количество неудачных попыток обработки операции подряд, после которого
+// операция переводится в очередь недоставленных, если оно не задано в конфигурации
+const defaultRetryBudget = 10
+
+// retryBudget учитывает неудачные попытки обработки операций фоновой задачей.
+type retryBudget struct {
+	deadLetterService DeadLetterService
+	taskName          string
+	budget            int
+}
+
+func newRetryBudget(deadLetterService DeadLetterService, taskName string, budget int) retryBudget {
+	if budget <= 0 {
+		budget = defaultRetryBudget
+	}
+
+	return retryBudget{
+		deadLetterService: deadLetterService,
+		taskName:          taskName,
+		budget:            budget,
+	}
+}
+
+// done учитывает результат обработки операции. Счетчик неудачных попыток сбрасывается только у операций,
+// которые при захвате уже имели неудачные попытки, чтобы успешная обработка не требовала записи в базу.
+func (b retryBudget) done(ctx context.Context, log *slog.Logger, op *model.Operation, err error) {
+	if err == nil {
+		if !op.HasFailedAttempts {
+			return
+		}
+
+		err = b.deadLetterService.Succeed(ctx, op.ID)
+		if err != nil {
+			log.ErrorContext(
+				ctx,
+				"failed to reset operation attempts",
+				"error", err,
+			)
+		}
+		return
+	}
+
+	if err = b.deadLetterService.Fail(ctx, op, b.taskName, err, b.budget); err != nil {
+		log.ErrorContext(
+			ctx,
+			"failed to record operation attempt",
+			"error", err,
+		)
+		return
+	}
+	op.HasFailedAttempts = true
+}
@@ -1,23 +1,309 @@
 package scheduler
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
+)
 
 type BackgroundTask interface {
-	Start(ctx context.Context)
+	Name() string
+	// Interval и OperationBatchSize возвращают значения из конфигурации
+	Interval() time.Duration
+	OperationBatchSize() int64
+	// Execute после отмены ctx не должен брать в обработку новые операции, но должен
+	// довести до конца обработку уже начатых
+	Execute(ctx context.Context, operationBatchSize int64) model.SchedulerTaskRunResult
+}
+
+type scheduledTask struct {
+	task    BackgroundTask
+	trigger chan struct{}
+	// reschedule сообщает циклу задачи об изменении интервала, чтобы новый интервал отсчитывался сразу
+	reschedule chan struct{}
 }
 
 type Scheduler struct {
-	backgroundTasks []BackgroundTask
+	instanceID  string
+	taskService TaskService
+	tasks       map[string]*scheduledTask
+	names       []string
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
 }
 
-func NewScheduler(tasks ...BackgroundTask) *Scheduler {
-	return &Scheduler{
-		backgroundTasks: tasks,
+func NewScheduler(instanceID string, taskService TaskService, tasks ...BackgroundTask) *Scheduler {
+	s := &Scheduler{
+		instanceID:  instanceID,
+		taskService: taskService,
+		tasks:       make(map[string]*scheduledTask, len(tasks)),
+		names:       make([]string, 0, len(tasks)),
+	}
+
+	for _, task := range tasks {
+		s.tasks[task.Name()] = &scheduledTask{
+			task:       task,
+			trigger:    make(chan struct{}, 1),
+			reschedule: make(chan struct{}, 1),
+		}
+		s.names = append(s.names, task.Name())
 	}
+
+	return s
 }
 
 func (s *Scheduler) Start(ctx context.Context) {
-	for _, task := range s.backgroundTasks {
-		task.Start(ctx)
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, name := range s.names {
+		s.wg.Add(1)
+		go func(st *scheduledTask) {
+			defer s.wg.Done()
+			s.run(ctx, st)
+		}(s.tasks[name])
+	}
+}
+
+// Stop прекращает запуск фоновых задач и ожидает завершения обработки уже взятых в работу операций,
+// но не дольше дедлайна контекста.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for scheduler tasks to finish: %w", ctx.Err())
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, st *scheduledTask) {
+	timer := time.NewTimer(s.settings(ctx, st.task).Interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			settings := s.settings(ctx, st.task)
+			if !settings.IsPaused {
+				s.execute(ctx, st.task, settings.OperationBatchSize, model.SchedulerTaskTriggerSchedule)
+			}
+			timer.Reset(settings.Interval)
+		case <-st.trigger:
+			settings := s.settings(ctx, st.task)
+			s.execute(ctx, st.task, settings.OperationBatchSize, model.SchedulerTaskTriggerManual)
+		case <-st.reschedule:
+			timer.Reset(s.settings(ctx, st.task).Interval)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Scheduler) execute(ctx context.Context, task BackgroundTask, operationBatchSize int64, trigger model.SchedulerTaskTrigger) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "scheduler."+task.Name(), trace.WithAttributes(
+		attribute.String("scheduler.task", task.Name()),
+		attribute.String("scheduler.trigger", string(trigger)),
+		attribute.Int64("scheduler.operation_batch_size", operationBatchSize),
+	))
+	defer span.End()
+
+	startedAt := time.Now().UTC()
+	result := task.Execute(ctx, operationBatchSize)
+	finishedAt := time.Now().UTC()
+
+	span.SetAttributes(
+		attribute.Int64("scheduler.processed", result.Processed),
+		attribute.Int64("scheduler.succeeded", result.Succeeded),
+		attribute.Int64("scheduler.errored", result.Errored),
+	)
+
+	metrics.ObserveSchedulerTaskRun(task.Name(), trigger, result, finishedAt.Sub(startedAt))
+
+	// статистику запуска сохраняем и в том случае, если во время его выполнения началась остановка сервиса
+	ctx = context.WithoutCancel(ctx)
+
+	// пустые запуски по расписанию не сохраняем, чтобы не вытеснять из истории содержательные
+	if result.Processed == 0 && trigger == model.SchedulerTaskTriggerSchedule {
+		return
+	}
+
+	run := &model.SchedulerTaskRun{
+		SchedulerTaskRunResult: result,
+		TaskName:               task.Name(),
+		InstanceID:             s.instanceID,
+		Trigger:                trigger,
+		StartedAt:              startedAt,
+		FinishedAt:             finishedAt,
+	}
+
+	if err := s.taskService.RecordRun(ctx, run); err != nil {
+		slog.Default().ErrorContext(
+			ctx,
+			"failed to record scheduler task run",
+			"task", task.Name(),
+			"error", err,
+		)
+	}
+}
+
+// settings возвращает действующие параметры фоновой задачи с учетом изменений, сделанных во время работы сервиса.
+func (s *Scheduler) settings(ctx context.Context, task BackgroundTask) model.SchedulerTaskSettings {
+	settings, err := s.taskService.Settings(ctx, task.Name())
+	if err != nil {
+		slog.Default().Error(
+			"failed to receive scheduler task settings",
+			"task", task.Name(),
+			"error", err,
+		)
+	}
+
+	if settings.Interval <= 0 {
+		settings.Interval = task.Interval()
+	}
+	if settings.OperationBatchSize <= 0 {
+		settings.OperationBatchSize = task.OperationBatchSize()
 	}
+
+	return settings
+}
+
+func (s *Scheduler) Tasks(ctx context.Context) ([]*model.SchedulerTask, error) {
+	tasks := make([]*model.SchedulerTask, 0, len(s.names))
+	for _, name := range s.names {
+		task := s.tasks[name].task
+
+		settings, err := s.taskService.Settings(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &model.SchedulerTask{
+			Name:                      name,
+			IsPaused:                  settings.IsPaused,
+			Interval:                  task.Interval(),
+			OperationBatchSize:        task.OperationBatchSize(),
+			DefaultInterval:           task.Interval(),
+			DefaultOperationBatchSize: task.OperationBatchSize(),
+		}
+		if settings.Interval > 0 {
+			result.Interval = settings.Interval
+		}
+		if settings.OperationBatchSize > 0 {
+			result.OperationBatchSize = settings.OperationBatchSize
+		}
+
+		tasks = append(tasks, result)
+	}
+	return tasks, nil
+}
+
+func (s *Scheduler) Pause(ctx context.Context, name string) error {
+	if _, err := s.task(name); err != nil {
+		return err
+	}
+	return s.taskService.SetPaused(ctx, name, true)
+}
+
+func (s *Scheduler) Resume(ctx context.Context, name string) error {
+	if _, err := s.task(name); err != nil {
+		return err
+	}
+	return s.taskService.SetPaused(ctx, name, false)
+}
+
+// Trigger запускает фоновую задачу вне очереди на текущем экземпляре сервиса.
+// Если внеочередной запуск уже ожидает выполнения, повторный запрос игнорируется.
+func (s *Scheduler) Trigger(_ context.Context, name string) error {
+	st, err := s.task(name)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case st.trigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Update меняет параметры фоновой задачи. Новый интервал на текущем экземпляре сервиса отсчитывается
+// с момента изменения, на остальных - после ближайшего запуска по расписанию.
+func (s *Scheduler) Update(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error {
+	st, err := s.task(name)
+	if err != nil {
+		return err
+	}
+
+	if interval == nil && operationBatchSize == nil {
+		return invalidRequestError(fmt.Sprintf("neither interval nor operation batch size stated for task %q", name))
+	}
+	if interval != nil && *interval < time.Second {
+		return invalidRequestError(fmt.Sprintf("invalid interval for task %q: %v", name, *interval))
+	}
+	if operationBatchSize != nil && *operationBatchSize <= 0 {
+		return invalidRequestError(fmt.Sprintf("invalid operation batch size for task %q: %v", name, *operationBatchSize))
+	}
+
+	if err = s.taskService.SetSchedule(ctx, name, interval, operationBatchSize); err != nil {
+		return err
+	}
+
+	if interval != nil {
+		select {
+		case st.reschedule <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error) {
+	if _, err := s.task(name); err != nil {
+		return nil, err
+	}
+	return s.taskService.Runs(ctx, name, maxCount)
+}
+
+func (s *Scheduler) task(name string) (*scheduledTask, error) {
+	st, ok := s.tasks[name]
+	if !ok {
+		return nil, perror.NewInternal().WithCode(
+			perror.CodeObjectNotFound,
+		).WithDescription(
+			fmt.Sprintf("scheduler task %q not found", name),
+		)
+	}
+	return st, nil
+}
+
+func invalidRequestError(description string) error {
+	return perror.NewInternal().WithCode(
+		perror.CodeInvalidRequest,
+	).WithDescription(
+		description,
+	)
 }
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/config"
+)
+
+const sealToolDetailsTaskName = "seal_tool_details"
+
+// SealToolDetailsTask шифрует реквизиты платежных средств, сохраненных до включения шифрования
+// или экземплярами сервиса, на которых оно еще не было включено.
+type SealToolDetailsTask struct {
+	interval      time.Duration
+	toolBatchSize int64
+	toolService   ToolService
+}
+
+func NewSealToolDetailsTask(cfg config.SchedulerTaskConfig, toolService ToolService) *SealToolDetailsTask {
+	return &SealToolDetailsTask{
+		interval:      time.Duration(cfg.Interval) * time.Second,
+		toolBatchSize: cfg.OperationBatchSize,
+		toolService:   toolService,
+	}
+}
+
+func (t *SealToolDetailsTask) Name() string {
+	return sealToolDetailsTaskName
+}
+
+func (t *SealToolDetailsTask) Interval() time.Duration {
+	return t.interval
+}
+
+func (t *SealToolDetailsTask) OperationBatchSize() int64 {
+	return t.toolBatchSize
+}
+
+// Execute обрабатывает платежные средства, а не операции: размер пачки ограничивает число шифруемых средств.
+func (t *SealToolDetailsTask) Execute(ctx context.Context, toolBatchSize int64) model.SchedulerTaskRunResult {
+	log := slog.Default().With("task", sealToolDetailsTaskName)
+
+	var counter runCounter
+
+	tools, err := t.toolService.Unsealed(ctx, toolBatchSize)
+	if err != nil {
+		log.ErrorContext(
+			ctx,
+			"failed to receive tools with plaintext details",
+			"error", err,
+		)
+		return counter.result()
+	}
+
+	for _, tool := range tools {
+		if ctx.Err() != nil {
+			break
+		}
+
+		err = t.toolService.SealOne(ctx, tool)
+		if err != nil {
+			log.ErrorContext(
+				ctx,
+				"failed to seal tool details",
+				"tool_id", tool.ID,
+				"user_id", tool.UserID,
+				"error", err,
+			)
+		}
+		counter.done(err)
+	}
+
+	return counter.result()
+}
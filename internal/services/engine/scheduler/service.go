@@ -2,12 +2,14 @@ package scheduler
 
 import (
 	"context"
+	"time"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
 type OperationService interface {
-	All(ctx context.Context, criteria model.OperationCriteria) ([]*model.Operation, error)
+	Claim(ctx context.Context, criteria model.OperationCriteria, claimedBy string, lease time.Duration) ([]*model.Operation, error)
+	Release(ctx context.Context, ids []int64, claimedBy string) error
 }
 
 type IntegrationClient interface {
@@ -20,6 +22,38 @@ type PaymentService interface {
 }
 
 type PayoutService interface {
+	Success(ctx context.Context, data model.SuccessPayoutData) error
 	Fail(ctx context.Context, data model.FailPayoutData) error
+	ConfirmationExpiresAt(op *model.Operation) time.Time
 	RequestPayout(ctx context.Context, data model.CreatePayoutData) error
 }
+
+type ToolService interface {
+	GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error)
+	ExpireOverdue(ctx context.Context, now time.Time) (int64, error)
+	ClaimExpiring(ctx context.Context, before time.Time, maxCount int64) ([]*model.Tool, error)
+	NotifyExpiry(ctx context.Context, tool *model.Tool) error
+	Unsealed(ctx context.Context, maxCount int64) ([]*model.Tool, error)
+	SealOne(ctx context.Context, tool *model.Tool) error
+}
+
+type DriftService interface {
+	Record(ctx context.Context, drift *model.OperationStatusDrift) error
+}
+
+type StatusService interface {
+	Change(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) (model.OperationChangeStatusResult, error)
+}
+
+type DeadLetterService interface {
+	Fail(ctx context.Context, op *model.Operation, taskName string, cause error, budget int) error
+	Succeed(ctx context.Context, operationID int64) error
+}
+
+type TaskService interface {
+	Settings(ctx context.Context, name string) (model.SchedulerTaskSettings, error)
+	SetPaused(ctx context.Context, name string, isPaused bool) error
+	SetSchedule(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error
+	RecordRun(ctx context.Context, run *model.SchedulerTaskRun) error
+	Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error)
+}
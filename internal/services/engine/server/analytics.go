@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) ReportOperationsAnalytics(ctx context.Context, request *pb.ReportOperationsAnalyticsRequest) (*pb.ReportOperationsAnalyticsResponse, error) {
+	criteria := criteriaFromReportOperationsAnalyticsRequest(request)
+
+	items, err := s.operationService.Analytics(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	pbItems := make([]*pb.OperationsAnalyticsItem, 0, len(items))
+	for _, item := range items {
+		pbItems = append(pbItems, operationsAnalyticsItemToProto(item))
+	}
+
+	return &pb.ReportOperationsAnalyticsResponse{
+		Items: pbItems,
+	}, nil
+}
+
+func criteriaFromReportOperationsAnalyticsRequest(request *pb.ReportOperationsAnalyticsRequest) model.OperationAnalyticsCriteria {
+	// аналитика всегда строится по одному магазину, чтобы не смешивать операции разных магазинов
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
+
+	criteria := model.OperationAnalyticsCriteria{
+		Granularity:   model.AnalyticsGranularity(request.GetGranularity()),
+		TimeZone:      request.GetTimeZone(),
+		MerchantID:    &merchantID,
+		CreatedAtFrom: time.Unix(request.GetCreatedAtFrom(), 0).UTC(),
+		CreatedAtTo:   time.Unix(request.GetCreatedAtTo(), 0).UTC(),
+	}
+	if len(request.GroupBy) > 0 {
+		groupBy := make([]model.AnalyticsGroupField, 0, len(request.GroupBy))
+		for _, field := range request.GroupBy {
+			groupBy = append(groupBy, model.AnalyticsGroupField(field))
+		}
+		criteria.GroupBy = groupBy
+	}
+	if len(request.Types) > 0 {
+		types := make([]model.OperationType, 0, len(request.Types))
+		for _, pbType := range request.Types {
+			types = append(types, convert.OperationTypeFromProto(pbType))
+		}
+		criteria.Types = &types
+	}
+	if len(request.ExternalSystems) > 0 {
+		externalSystems := request.GetExternalSystems()
+		criteria.ExternalSystems = &externalSystems
+	}
+	return criteria
+}
+
+func operationsAnalyticsItemToProto(item model.OperationAnalyticsItem) *pb.OperationsAnalyticsItem {
+	result := &pb.OperationsAnalyticsItem{
+		PeriodStart:          item.PeriodStart.UTC().Unix(),
+		CreatedCount:         item.CreatedCount,
+		SucceededCount:       item.SucceededCount,
+		FailedCount:          item.FailedCount,
+		SucceededAmount:      item.SucceededAmount,
+		MedianProcessingTime: int64(item.MedianProcessingTime.Seconds()),
+	}
+
+	if item.ExternalSystem != "" {
+		result.ExternalSystem = &item.ExternalSystem
+	}
+
+	if item.ExternalMethod != "" {
+		result.ExternalMethod = &item.ExternalMethod
+	}
+
+	if item.Currency != "" {
+		result.Currency = &item.Currency
+	}
+
+	if item.Type != "" {
+		pbType := convert.OperationTypeToProto(item.Type)
+		result.Type = &pbType
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) ReportAuditLog(ctx context.Context, request *pb.ReportAuditLogRequest) (*pb.ReportAuditLogResponse, error) {
+	criteria := model.AuditEntryCriteria{
+		ActorID:    request.ActorId,
+		TargetID:   request.TargetId,
+		ClientID:   request.ClientId,
+		MerchantID: request.MerchantId,
+		MaxCount:   request.GetMaxCount(),
+	}
+	if request.Action != nil {
+		action := model.AuditAction(request.GetAction())
+		criteria.Action = &action
+	}
+	if request.TargetType != nil {
+		targetType := model.AuditTargetType(request.GetTargetType())
+		criteria.TargetType = &targetType
+	}
+	if request.CreatedAtFrom != nil {
+		criteria.CreatedAtFrom = time.Unix(request.GetCreatedAtFrom(), 0).UTC()
+	}
+	if request.CreatedAtTo != nil {
+		criteria.CreatedAtTo = time.Unix(request.GetCreatedAtTo(), 0).UTC()
+	}
+
+	entries, err := s.auditService.All(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	pbEntries := make([]*pb.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, auditEntryToProto(entry))
+	}
+
+	return &pb.ReportAuditLogResponse{
+		Entries: pbEntries,
+	}, nil
+}
+
+// recordAudit дополняет запись сведениями об исполнителе из запроса и сохраняет её в журнал аудита.
+// Действие к этому моменту уже выполнено, поэтому ошибка записи возвращается с пояснением.
+func (s *Server) recordAudit(ctx context.Context, audit *pb.Audit, entry *model.AuditEntry) error {
+	entry.ActorID = audit.GetActorId()
+	entry.Reason = audit.GetReason()
+
+	if err := s.auditService.Record(ctx, entry); err != nil {
+		return fmt.Errorf("action %v on %v %q has been applied, but audit entry was not recorded: %w",
+			entry.Action, entry.TargetType, entry.TargetID, err)
+	}
+	return nil
+}
+
+func toolAuditState(tool *model.Tool) map[string]string {
+	return map[string]string{
+		"status": string(tool.Status),
+		"name":   tool.Name,
+	}
+}
+
+func auditEntryToProto(entry *model.AuditEntry) *pb.AuditEntry {
+	return &pb.AuditEntry{
+		Id:         entry.ID,
+		ActorId:    entry.ActorID,
+		Action:     string(entry.Action),
+		TargetType: string(entry.TargetType),
+		TargetId:   entry.TargetID,
+		ClientId:   entry.ClientID,
+		MerchantId: entry.MerchantID,
+		Before:     entry.Before,
+		After:      entry.After,
+		Reason:     entry.Reason,
+		CreatedAt:  entry.CreatedAt.UTC().Unix(),
+	}
+}
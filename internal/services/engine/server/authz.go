@@ -0,0 +1,58 @@
+package server
+
+import (
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+)
+
+var (
+	gatewayOnly = []string{pkggrpc.CallerGateway}
+	reportOnly  = []string{pkggrpc.CallerReport}
+	anyFrontend = []string{pkggrpc.CallerGateway, pkggrpc.CallerReport}
+)
+
+// CallerPolicy - клиенты, которым разрешен вызов методов сервиса при включенном mTLS; в ней перечислены все методы сервиса.
+// Клиентские сценарии доступны только сервису gateway, административные - только сервису report.
+// Для RemoveTool и ReportOperations права дополнительно проверяются по содержимому запроса.
+var CallerPolicy = pkggrpc.Policy{
+	healthpb.Health_Check_FullMethodName: anyFrontend,
+	healthpb.Health_Watch_FullMethodName: nil,
+
+	pb.EngineService_AvailableMethods_FullMethodName:       gatewayOnly,
+	pb.EngineService_CreatePayment_FullMethodName:          gatewayOnly,
+	pb.EngineService_AvailableTools_FullMethodName:         anyFrontend,
+	pb.EngineService_CreatePayout_FullMethodName:           gatewayOnly,
+	pb.EngineService_EditTool_FullMethodName:               gatewayOnly,
+	pb.EngineService_RemoveTool_FullMethodName:             anyFrontend,
+	pb.EngineService_ConfirmPayout_FullMethodName:          gatewayOnly,
+	pb.EngineService_AddToFavorites_FullMethodName:         gatewayOnly,
+	pb.EngineService_RemoveFromFavorites_FullMethodName:    gatewayOnly,
+	pb.EngineService_ResendConfirmationCode_FullMethodName: gatewayOnly,
+	pb.EngineService_CancelPayout_FullMethodName:           gatewayOnly,
+
+	pb.EngineService_ReportOperations_FullMethodName:           anyFrontend,
+	pb.EngineService_GetOperationExternalStatus_FullMethodName: reportOnly,
+	pb.EngineService_RecoverTool_FullMethodName:                reportOnly,
+	pb.EngineService_ChangeOperationStatus_FullMethodName:      reportOnly,
+	pb.EngineService_ReportOperationsAnalytics_FullMethodName:  reportOnly,
+	pb.EngineService_Reconcile_FullMethodName:                  reportOnly,
+	pb.EngineService_ReconciliationRuns_FullMethodName:         reportOnly,
+	pb.EngineService_GetReconciliationRun_FullMethodName:       reportOnly,
+	pb.EngineService_ReportStatusDrifts_FullMethodName:         reportOnly,
+	pb.EngineService_SchedulerTasks_FullMethodName:             reportOnly,
+	pb.EngineService_PauseSchedulerTask_FullMethodName:         reportOnly,
+	pb.EngineService_ResumeSchedulerTask_FullMethodName:        reportOnly,
+	pb.EngineService_TriggerSchedulerTask_FullMethodName:       reportOnly,
+	pb.EngineService_UpdateSchedulerTask_FullMethodName:        reportOnly,
+	pb.EngineService_SchedulerTaskRuns_FullMethodName:          reportOnly,
+	pb.EngineService_DeadLetterOperations_FullMethodName:       reportOnly,
+	pb.EngineService_RetryDeadLetterOperation_FullMethodName:   reportOnly,
+	pb.EngineService_ResolveDeadLetterOperation_FullMethodName: reportOnly,
+	pb.EngineService_ReportAuditLog_FullMethodName:             reportOnly,
+	pb.EngineService_ReportBlockList_FullMethodName:            reportOnly,
+	pb.EngineService_CreateBlockEntry_FullMethodName:           reportOnly,
+	pb.EngineService_UpdateBlockEntry_FullMethodName:           reportOnly,
+	pb.EngineService_DeleteBlockEntry_FullMethodName:           reportOnly,
+}
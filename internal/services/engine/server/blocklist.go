@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) ReportBlockList(ctx context.Context, request *pb.ReportBlockListRequest) (*pb.ReportBlockListResponse, error) {
+	criteria := model.BlockEntryCriteria{
+		MerchantID: request.MerchantId,
+		Value:      request.Value,
+		ActiveOnly: request.GetActiveOnly(),
+		MaxCount:   request.GetMaxCount(),
+	}
+	if request.Type != nil {
+		entryType := model.BlockEntryType(request.GetType())
+		criteria.Type = &entryType
+	}
+
+	entries, err := s.blockListService.All(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	pbEntries := make([]*pb.BlockEntry, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, blockEntryToProto(entry))
+	}
+
+	return &pb.ReportBlockListResponse{
+		Entries: pbEntries,
+	}, nil
+}
+
+// CreateBlockEntry добавляет запись в список блокировок. Причина из сведений аудита сохраняется и в самой записи.
+func (s *Server) CreateBlockEntry(ctx context.Context, request *pb.CreateBlockEntryRequest) (*pb.BlockEntryResponse, error) {
+	entry := &model.BlockEntry{
+		MerchantID: request.GetMerchantId(),
+		Type:       model.BlockEntryType(request.GetType()),
+		Value:      request.GetValue(),
+		ValueTo:    request.GetValueTo(),
+		Reason:     request.GetAudit().GetReason(),
+		CreatedBy:  request.GetAudit().GetActorId(),
+	}
+	if request.ExpiresAt != nil {
+		entry.ExpiresAt = time.Unix(request.GetExpiresAt(), 0).UTC()
+	}
+
+	if err := s.blockListService.Create(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordAudit(ctx, request.GetAudit(), blockEntryAuditEntry(model.AuditActionBlockEntryCreate, nil, entry)); err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockEntryResponse{
+		Entry: blockEntryToProto(entry),
+	}, nil
+}
+
+// UpdateBlockEntry меняет срок действия записи и заменяет её причину причиной из сведений аудита.
+func (s *Server) UpdateBlockEntry(ctx context.Context, request *pb.UpdateBlockEntryRequest) (*pb.BlockEntryResponse, error) {
+	entry, err := s.blockListService.GetOne(ctx, request.GetId(), request.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *entry
+
+	entry.Reason = request.GetAudit().GetReason()
+	entry.ExpiresAt = time.Time{}
+	if request.ExpiresAt != nil {
+		entry.ExpiresAt = time.Unix(request.GetExpiresAt(), 0).UTC()
+	}
+
+	if err = s.blockListService.Update(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err = s.recordAudit(ctx, request.GetAudit(), blockEntryAuditEntry(model.AuditActionBlockEntryUpdate, &before, entry)); err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockEntryResponse{
+		Entry: blockEntryToProto(entry),
+	}, nil
+}
+
+func (s *Server) DeleteBlockEntry(ctx context.Context, request *pb.DeleteBlockEntryRequest) (*emptypb.Empty, error) {
+	entry, err := s.blockListService.GetOne(ctx, request.GetId(), request.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.blockListService.Delete(ctx, entry.ID); err != nil {
+		return nil, err
+	}
+
+	if err = s.recordAudit(ctx, request.GetAudit(), blockEntryAuditEntry(model.AuditActionBlockEntryDelete, entry, nil)); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// blockEntryAuditEntry готовит запись журнала аудита; before и after равны nil для созданной и удаленной записей соответственно.
+func blockEntryAuditEntry(action model.AuditAction, before, after *model.BlockEntry) *model.AuditEntry {
+	entry := after
+	if entry == nil {
+		entry = before
+	}
+
+	auditEntry := &model.AuditEntry{
+		Action:     action,
+		TargetType: model.AuditTargetTypeBlockEntry,
+		TargetID:   strconv.FormatInt(entry.ID, 10),
+		MerchantID: entry.MerchantID,
+		Before:     blockEntryAuditState(before),
+		After:      blockEntryAuditState(after),
+	}
+	if entry.Type == model.BlockEntryTypeUser {
+		auditEntry.ClientID = entry.Value
+	}
+	return auditEntry
+}
+
+func blockEntryAuditState(entry *model.BlockEntry) map[string]string {
+	if entry == nil {
+		return nil
+	}
+
+	state := map[string]string{
+		"type":   string(entry.Type),
+		"value":  entry.Value,
+		"reason": entry.Reason,
+	}
+	if entry.ValueTo != "" {
+		state["value_to"] = entry.ValueTo
+	}
+	if !entry.ExpiresAt.IsZero() {
+		state["expires_at"] = entry.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return state
+}
+
+func blockEntryToProto(entry *model.BlockEntry) *pb.BlockEntry {
+	pbEntry := &pb.BlockEntry{
+		Id:         entry.ID,
+		MerchantId: entry.MerchantID,
+		Type:       string(entry.Type),
+		Value:      entry.Value,
+		ValueTo:    entry.ValueTo,
+		Reason:     entry.Reason,
+		CreatedBy:  entry.CreatedBy,
+		CreatedAt:  entry.CreatedAt.UTC().Unix(),
+		UpdatedAt:  entry.UpdatedAt.UTC().Unix(),
+	}
+	if !entry.ExpiresAt.IsZero() {
+		expiresAt := entry.ExpiresAt.UTC().Unix()
+		pbEntry.ExpiresAt = &expiresAt
+	}
+	return pbEntry
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) DeadLetterOperations(ctx context.Context, request *pb.DeadLetterOperationsRequest) (*pb.DeadLetterOperationsResponse, error) {
+	deadLettered := true
+	criteria := model.OperationAttemptCriteria{
+		OperationID:  request.OperationId,
+		MerchantID:   request.MerchantId,
+		DeadLettered: &deadLettered,
+		MaxCount:     request.GetMaxCount(),
+	}
+
+	attempts, err := s.deadLetterService.All(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	pbOperations := make([]*pb.DeadLetterOperation, 0, len(attempts))
+	for _, attempt := range attempts {
+		pbOperations = append(pbOperations, deadLetterOperationToProto(attempt))
+	}
+
+	return &pb.DeadLetterOperationsResponse{
+		Operations: pbOperations,
+	}, nil
+}
+
+func (s *Server) RetryDeadLetterOperation(ctx context.Context, request *pb.RetryDeadLetterOperationRequest) (*emptypb.Empty, error) {
+	if err := s.deadLetterService.Retry(ctx, request.GetOperationId(), request.MerchantId); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ResolveDeadLetterOperation(ctx context.Context, request *pb.ResolveDeadLetterOperationRequest) (*emptypb.Empty, error) {
+	newStatus := convert.OperationStatusFromProto(request.GetNewStatus())
+	newExternalStatus := convert.OperationExternalStatusFromProto(request.GetNewExternalStatus())
+
+	if err := s.deadLetterService.Resolve(ctx, request.GetOperationId(), request.MerchantId, newStatus, newExternalStatus); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func deadLetterOperationToProto(attempt *model.OperationAttempt) *pb.DeadLetterOperation {
+	return &pb.DeadLetterOperation{
+		OperationId:     attempt.OperationID,
+		OperationType:   convert.OperationTypeToProto(attempt.OperationType),
+		OperationStatus: convert.OperationStatusToProto(attempt.OperationStatus),
+		ExternalSystem:  attempt.ExternalSystem,
+		ExternalMethod:  attempt.ExternalMethod,
+		TaskName:        attempt.TaskName,
+		Attempts:        int64(attempt.Attempts),
+		LastError:       attempt.LastError,
+		LastAttemptAt:   attempt.LastAttemptAt.UTC().Unix(),
+		DeadLetteredAt:  attempt.DeadLetteredAt.UTC().Unix(),
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) ReportStatusDrifts(ctx context.Context, request *pb.ReportStatusDriftsRequest) (*pb.ReportStatusDriftsResponse, error) {
+	criteria := model.OperationStatusDriftCriteria{
+		OperationID: request.OperationId,
+		MerchantID:  request.MerchantId,
+		Fixed:       request.Fixed,
+	}
+	if request.DetectedAtFrom != nil {
+		criteria.DetectedAtFrom = time.Unix(request.GetDetectedAtFrom(), 0).UTC()
+	}
+	if request.DetectedAtTo != nil {
+		criteria.DetectedAtTo = time.Unix(request.GetDetectedAtTo(), 0).UTC()
+	}
+
+	drifts, err := s.driftService.All(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDrifts := make([]*pb.StatusDrift, 0, len(drifts))
+	for _, drift := range drifts {
+		pbDrifts = append(pbDrifts, statusDriftToProto(drift))
+	}
+
+	return &pb.ReportStatusDriftsResponse{
+		Drifts: pbDrifts,
+	}, nil
+}
+
+func statusDriftToProto(drift *model.OperationStatusDrift) *pb.StatusDrift {
+	result := &pb.StatusDrift{
+		Id:             drift.ID,
+		OperationId:    drift.OperationID,
+		OperationType:  convert.OperationTypeToProto(drift.OperationType),
+		ExternalSystem: drift.ExternalSystem,
+		ExternalMethod: drift.ExternalMethod,
+		Status:         convert.OperationStatusToProto(drift.Status),
+		ProviderStatus: convert.OperationExternalStatusToProto(drift.ProviderStatus),
+		Fixed:          drift.Fixed,
+		DetectedAt:     drift.DetectedAt.UTC().Unix(),
+	}
+
+	if drift.ExternalStatus != "" {
+		pbExternalStatus := convert.OperationExternalStatusToProto(drift.ExternalStatus)
+		result.ExternalStatus = &pbExternalStatus
+	}
+
+	if drift.FixError != "" {
+		result.FixError = &drift.FixError
+	}
+
+	return result
+}
@@ -15,8 +15,9 @@ func (s *Server) AddToFavorites(ctx context.Context, request *pbEngine.Favorites
 	currency := request.GetCurrency()
 	externalSystem := request.GetExternalSystem()
 	externalMethod := request.GetExternalMethod()
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
 
-	_, err := s.methodService.GetOne(ctx, opType, currency, externalSystem, externalMethod)
+	_, err := s.methodService.GetOne(ctx, opType, merchantID, currency, externalSystem, externalMethod)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +27,7 @@ func (s *Server) AddToFavorites(ctx context.Context, request *pbEngine.Favorites
 		Currency:       currency,
 		ExternalSystem: externalSystem,
 		ExternalMethod: externalMethod,
+		MerchantID:     merchantID,
 		UserID:         request.GetUserId(),
 	}
 
@@ -41,8 +43,9 @@ func (s *Server) RemoveFromFavorites(ctx context.Context, request *pbEngine.Favo
 	currency := request.GetCurrency()
 	externalSystem := request.GetExternalSystem()
 	externalMethod := request.GetExternalMethod()
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
 
-	_, err := s.methodService.GetOne(ctx, opType, currency, externalSystem, externalMethod)
+	_, err := s.methodService.GetOne(ctx, opType, merchantID, currency, externalSystem, externalMethod)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +55,7 @@ func (s *Server) RemoveFromFavorites(ctx context.Context, request *pbEngine.Favo
 		Currency:       currency,
 		ExternalSystem: externalSystem,
 		ExternalMethod: externalMethod,
+		MerchantID:     merchantID,
 		UserID:         request.GetUserId(),
 	}
 
@@ -5,17 +5,20 @@ import (
 
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
 func (s *Server) AvailableMethods(ctx context.Context, request *pb.AvailableMethodsRequest) (*pb.AvailableMethodsResponse, error) {
 	opType := convert.OperationTypeFromProto(request.GetOperationType())
 
-	methods, err := s.methodService.All(ctx, opType, request.GetCurrency())
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
+
+	methods, err := s.methodService.All(ctx, opType, merchantID, request.GetCurrency())
 	if err != nil {
 		return nil, err
 	}
 
-	if err = s.favoritesService.FillForMethods(ctx, opType, request.GetUserId(), methods); err != nil {
+	if err = s.favoritesService.FillForMethods(ctx, opType, merchantID, request.GetUserId(), methods); err != nil {
 		return nil, err
 	}
 
@@ -2,17 +2,25 @@ package server
 
 import (
 	"context"
-	"fmt"
+	"strconv"
 	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
 func (s *Server) ReportOperations(ctx context.Context, request *pb.ReportOperationsRequest) (*pb.ReportOperationsResponse, error) {
+	// без указания клиента выборка затрагивает операции всех клиентов и доступна только административному интерфейсу
+	if request.GetUserId() == "" {
+		if err := pkggrpc.Authorize(ctx, pkggrpc.CallerReport); err != nil {
+			return nil, err
+		}
+	}
+
 	criteria := criteriaFromReportOperationsRequest(request)
 
 	operations, err := s.operationService.AllForReport(ctx, criteria)
@@ -31,8 +39,10 @@ func (s *Server) ReportOperations(ctx context.Context, request *pb.ReportOperati
 }
 
 func (s *Server) GetOperationExternalStatus(ctx context.Context, request *pb.GetOperationExternalStatusRequest) (*pb.GetOperationExternalStatusResponse, error) {
+	// клиенту, привязанному к магазину, доступны только операции этого магазина
 	criteria := model.OperationCriteria{
-		ID: &request.OperationId,
+		ID:         &request.OperationId,
+		MerchantID: request.MerchantId,
 	}
 
 	operation, err := s.operationService.GetOne(ctx, criteria)
@@ -48,6 +58,7 @@ func (s *Server) GetOperationExternalStatus(ctx context.Context, request *pb.Get
 		Currency:       operation.Currency,
 		OperationType:  operation.Type,
 		OperationID:    operation.ID,
+		MerchantID:     operation.MerchantID,
 		UserID:         operation.UserID,
 		Amount:         operation.Amount,
 	}
@@ -63,68 +74,38 @@ func (s *Server) GetOperationExternalStatus(ctx context.Context, request *pb.Get
 }
 
 func (s *Server) ChangeOperationStatus(ctx context.Context, request *pb.ChangeOperationStatusRequest) (*emptypb.Empty, error) {
-	id := request.GetId()
+	newStatus := convert.OperationStatusFromProto(request.GetNewStatus())
+	newExternalStatus := convert.OperationExternalStatusFromProto(request.GetNewExternalStatus())
 
-	criteria := model.OperationCriteria{
-		ID: &id,
-	}
+	id := request.GetId()
 
-	operation, err := s.operationService.GetOne(ctx, criteria)
+	before, err := s.operationService.GetOne(ctx, model.OperationCriteria{ID: &id, MerchantID: request.MerchantId})
 	if err != nil {
 		return nil, err
 	}
 
-	newStatus := convert.OperationStatusFromProto(request.GetNewStatus())
-	newExternalStatus := convert.OperationExternalStatusFromProto(request.GetNewExternalStatus())
+	entry := &model.AuditEntry{
+		ActorID:    request.GetAudit().GetActorId(),
+		Action:     model.AuditActionOperationChangeStatus,
+		TargetType: model.AuditTargetTypeOperation,
+		TargetID:   strconv.FormatInt(id, 10),
+		ClientID:   before.UserID,
+		MerchantID: before.MerchantID,
+		Before:     model.OperationAuditState(before),
+		Reason:     request.GetAudit().GetReason(),
+	}
 
-	result, err := s.operationService.ChangeStatus(ctx, id, newStatus, newExternalStatus)
-	if err != nil {
+	// запись аудита сохраняется хранилищем операций в транзакции, изменяющей статус
+	if _, err = s.statusService.Change(model.ContextWithAuditEntry(ctx, entry), id, newStatus, newExternalStatus); err != nil {
 		return nil, err
 	}
 
-	if result == "" {
-		return &emptypb.Empty{}, nil
-	}
-
-	switch result {
-	case model.OperationChangeStatusResultFailPayment:
-		err = s.paymentService.Fail(ctx, model.FailPaymentData{
-			ExternalID:     operation.ExternalID,
-			ExternalStatus: newExternalStatus,
-			FailReason:     model.OperationFailReasonManual,
-			OperationID:    operation.ID,
-		})
-	case model.OperationChangeStatusResultFailPayout:
-		err = s.payoutService.Fail(ctx, model.FailPayoutData{
-			ExternalID:     operation.ExternalID,
-			ExternalStatus: newExternalStatus,
-			FailReason:     model.OperationFailReasonManual,
-			OperationID:    operation.ID,
-		})
-	case model.OperationChangeStatusResultSuccessPayment:
-		err = s.paymentService.Success(ctx, model.SuccessPaymentData{
-			ProcessedAt:    time.Now(),
-			ExternalID:     operation.ExternalID,
-			ExternalStatus: operation.ExternalStatus,
-			OperationID:    operation.ID,
-			NewAmount:      operation.Amount,
-		})
-	case model.OperationChangeStatusResultSuccessPayout:
-		tool, err := s.toolService.GetOne(ctx, operation.ToolID, operation.UserID, operation.ExternalMethod)
-		if err != nil {
-			return nil, fmt.Errorf("get tool from db: %w", err)
+	// статус не изменился (например, операция уже в запрошенном состоянии) - фиксируем попытку отдельно
+	if entry.ID == 0 {
+		entry.After = entry.Before
+		if err = s.recordAudit(ctx, request.GetAudit(), entry); err != nil {
+			return nil, err
 		}
-
-		err = s.payoutService.Success(ctx, model.SuccessPayoutData{
-			ProcessedAt:    time.Now(),
-			ExternalID:     operation.ExternalID,
-			ExternalStatus: newExternalStatus,
-			OperationID:    operation.ID,
-			Tool:           tool,
-		})
-	}
-	if err != nil {
-		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -133,6 +114,7 @@ func (s *Server) ChangeOperationStatus(ctx context.Context, request *pb.ChangeOp
 func criteriaFromReportOperationsRequest(request *pb.ReportOperationsRequest) model.OperationCriteria {
 	criteria := model.OperationCriteria{
 		ID:         request.Id,
+		MerchantID: request.MerchantId,
 		UserID:     request.UserId,
 		ExternalID: request.ExternalId,
 	}
@@ -162,6 +144,7 @@ func criteriaFromReportOperationsRequest(request *pb.ReportOperationsRequest) mo
 func reportOperationToProto(op model.ReportOperation) *pb.ReportOperation {
 	result := &pb.ReportOperation{
 		Id:             op.ID,
+		MerchantId:     op.MerchantID,
 		UserId:         op.UserID,
 		Type:           convert.OperationTypeToProto(op.Type),
 		Currency:       op.Currency,
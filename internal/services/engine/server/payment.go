@@ -9,7 +9,9 @@ import (
 )
 
 func (s *Server) CreatePayment(ctx context.Context, request *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
-	method, err := s.methodService.GetOne(ctx, model.OperationTypePayment, request.GetCurrency(), request.GetExternalSystem(), request.GetExternalMethod())
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
+
+	method, err := s.methodService.GetOne(ctx, model.OperationTypePayment, merchantID, request.GetCurrency(), request.GetExternalSystem(), request.GetExternalMethod())
 	if err != nil {
 		return nil, err
 	}
@@ -25,8 +27,10 @@ func (s *Server) CreatePayment(ctx context.Context, request *pb.CreatePaymentReq
 		ExternalMethod: request.GetExternalMethod(),
 		Currency:       request.GetCurrency(),
 		LangCode:       request.GetLangCode(),
+		MerchantID:     merchantID,
 		UserID:         request.GetUserId(),
 		ToolID:         request.GetToolId(),
+		ClientIP:       request.GetClientIp(),
 		Amount:         request.GetAmount(),
 	}
 
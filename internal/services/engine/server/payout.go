@@ -10,7 +10,9 @@ import (
 )
 
 func (s *Server) CreatePayout(ctx context.Context, request *pb.CreatePayoutRequest) (*pb.CreatePayoutResponse, error) {
-	method, err := s.methodService.GetOne(ctx, model.OperationTypePayout, request.GetCurrency(), request.GetExternalSystem(), request.GetExternalMethod())
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
+
+	method, err := s.methodService.GetOne(ctx, model.OperationTypePayout, merchantID, request.GetCurrency(), request.GetExternalSystem(), request.GetExternalMethod())
 	if err != nil {
 		return nil, err
 	}
@@ -25,8 +27,10 @@ func (s *Server) CreatePayout(ctx context.Context, request *pb.CreatePayoutReque
 		ExternalMethod: request.GetExternalMethod(),
 		Currency:       request.GetCurrency(),
 		LangCode:       request.GetLangCode(),
+		MerchantID:     merchantID,
 		UserID:         request.GetUserId(),
 		ToolID:         request.GetToolId(),
+		ClientIP:       request.GetClientIp(),
 		Amount:         request.GetAmount(),
 	}
 
@@ -36,8 +40,9 @@ func (s *Server) CreatePayout(ctx context.Context, request *pb.CreatePayoutReque
 	}
 
 	return &pb.CreatePayoutResponse{
-		OperationId: result.OperationID,
-		Status:      string(result.Status),
+		OperationId:           result.OperationID,
+		Status:                string(result.Status),
+		ConfirmationExpiresAt: result.ConfirmationExpiresAt.Unix(),
 	}, nil
 }
 
@@ -45,6 +50,7 @@ func (s *Server) ConfirmPayout(ctx context.Context, request *pb.ConfirmPayoutReq
 	data := model.ConfirmPayoutData{
 		ConfirmationCode: request.GetConfirmationCode(),
 		LangCode:         request.GetLangCode(),
+		MerchantID:       model.MerchantIDOrDefault(request.GetMerchantId()),
 		UserID:           request.GetUserId(),
 		OperationID:      request.GetOperationId(),
 	}
@@ -58,10 +64,19 @@ func (s *Server) ConfirmPayout(ctx context.Context, request *pb.ConfirmPayoutReq
 
 func (s *Server) ResendConfirmationCode(ctx context.Context, request *pb.ResendConfirmationCodeRequest) (*emptypb.Empty, error) {
 	opID := request.GetOperationId()
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
 	userID := request.GetUserId()
 	langCode := request.GetLangCode()
 
-	if err := s.payoutService.ResendCode(ctx, opID, userID, langCode); err != nil {
+	if err := s.payoutService.ResendCode(ctx, opID, merchantID, userID, langCode); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) CancelPayout(ctx context.Context, request *pb.CancelPayoutRequest) (*emptypb.Empty, error) {
+	if err := s.payoutService.Cancel(ctx, request.GetOperationId(), model.MerchantIDOrDefault(request.GetMerchantId()), request.GetUserId()); err != nil {
 		return nil, err
 	}
 
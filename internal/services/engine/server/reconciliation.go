@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) Reconcile(ctx context.Context, request *pb.ReconcileRequest) (*pb.ReconcileResponse, error) {
+	data := model.ReconcileData{
+		MerchantID:     model.MerchantIDOrDefault(request.GetMerchantId()),
+		ExternalSystem: request.GetExternalSystem(),
+		PeriodFrom:     time.Unix(request.GetPeriodFrom(), 0).UTC(),
+		PeriodTo:       time.Unix(request.GetPeriodTo(), 0).UTC(),
+		Items:          make([]model.RegistryItem, 0, len(request.GetItems())),
+	}
+	for _, pbItem := range request.GetItems() {
+		data.Items = append(data.Items, model.RegistryItem{
+			ExternalID: pbItem.GetExternalId(),
+			Currency:   pbItem.GetCurrency(),
+			Amount:     pbItem.GetAmount(),
+			Status:     convert.OperationExternalStatusFromProto(pbItem.GetStatus()),
+		})
+	}
+
+	run, err := s.reconciliationService.Reconcile(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReconcileResponse{
+		Run: reconciliationRunToProto(run),
+	}, nil
+}
+
+func (s *Server) ReconciliationRuns(ctx context.Context, request *pb.ReconciliationRunsRequest) (*pb.ReconciliationRunsResponse, error) {
+	runs, err := s.reconciliationService.All(ctx, request.GetExternalSystem(), request.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+
+	pbRuns := make([]*pb.ReconciliationRun, 0, len(runs))
+	for _, run := range runs {
+		pbRuns = append(pbRuns, reconciliationRunToProto(run))
+	}
+
+	return &pb.ReconciliationRunsResponse{
+		Runs: pbRuns,
+	}, nil
+}
+
+func (s *Server) GetReconciliationRun(ctx context.Context, request *pb.GetReconciliationRunRequest) (*pb.GetReconciliationRunResponse, error) {
+	run, err := s.reconciliationService.GetOne(ctx, request.GetId(), request.MerchantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetReconciliationRunResponse{
+		Run: reconciliationRunToProto(run),
+	}, nil
+}
+
+func reconciliationRunToProto(run *model.ReconciliationRun) *pb.ReconciliationRun {
+	result := &pb.ReconciliationRun{
+		Id:               run.ID,
+		MerchantId:       run.MerchantID,
+		ExternalSystem:   run.ExternalSystem,
+		PeriodFrom:       run.PeriodFrom.UTC().Unix(),
+		PeriodTo:         run.PeriodTo.UTC().Unix(),
+		RegistryCount:    run.RegistryCount,
+		MatchedCount:     run.MatchedCount,
+		DiscrepancyCount: run.DiscrepancyCount,
+		CreatedAt:        run.CreatedAt.UTC().Unix(),
+	}
+
+	if len(run.Discrepancies) > 0 {
+		result.Discrepancies = make([]*pb.ReconciliationDiscrepancy, 0, len(run.Discrepancies))
+		for _, d := range run.Discrepancies {
+			result.Discrepancies = append(result.Discrepancies, reconciliationDiscrepancyToProto(d))
+		}
+	}
+
+	return result
+}
+
+func reconciliationDiscrepancyToProto(d model.ReconciliationDiscrepancy) *pb.ReconciliationDiscrepancy {
+	result := &pb.ReconciliationDiscrepancy{
+		Type:       string(d.Type),
+		ExternalId: d.ExternalID,
+	}
+
+	if d.OperationID != 0 {
+		pbStatus := convert.OperationStatusToProto(d.Status)
+
+		result.OperationId = &d.OperationID
+		result.Currency = &d.Currency
+		result.Amount = &d.Amount
+		result.Status = &pbStatus
+	}
+
+	if d.RegistryStatus != "" {
+		pbRegistryStatus := convert.OperationExternalStatusToProto(d.RegistryStatus)
+
+		result.RegistryCurrency = &d.RegistryCurrency
+		result.RegistryAmount = &d.RegistryAmount
+		result.RegistryStatus = &pbRegistryStatus
+	}
+
+	return result
+}
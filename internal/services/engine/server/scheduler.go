@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Server) SchedulerTasks(ctx context.Context, _ *emptypb.Empty) (*pb.SchedulerTasksResponse, error) {
+	tasks, err := s.schedulerService.Tasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTasks := make([]*pb.SchedulerTask, 0, len(tasks))
+	for _, task := range tasks {
+		pbTasks = append(pbTasks, schedulerTaskToProto(task))
+	}
+
+	return &pb.SchedulerTasksResponse{
+		Tasks: pbTasks,
+	}, nil
+}
+
+func (s *Server) PauseSchedulerTask(ctx context.Context, request *pb.SchedulerTaskRequest) (*emptypb.Empty, error) {
+	if err := s.schedulerService.Pause(ctx, request.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ResumeSchedulerTask(ctx context.Context, request *pb.SchedulerTaskRequest) (*emptypb.Empty, error) {
+	if err := s.schedulerService.Resume(ctx, request.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) TriggerSchedulerTask(ctx context.Context, request *pb.SchedulerTaskRequest) (*emptypb.Empty, error) {
+	if err := s.schedulerService.Trigger(ctx, request.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) UpdateSchedulerTask(ctx context.Context, request *pb.UpdateSchedulerTaskRequest) (*emptypb.Empty, error) {
+	var interval *time.Duration
+	if request.Interval != nil {
+		d := time.Duration(request.GetInterval()) * time.Second
+		interval = &d
+	}
+
+	if err := s.schedulerService.Update(ctx, request.GetName(), interval, request.OperationBatchSize); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) SchedulerTaskRuns(ctx context.Context, request *pb.SchedulerTaskRunsRequest) (*pb.SchedulerTaskRunsResponse, error) {
+	runs, err := s.schedulerService.Runs(ctx, request.GetName(), request.GetMaxCount())
+	if err != nil {
+		return nil, err
+	}
+
+	pbRuns := make([]*pb.SchedulerTaskRun, 0, len(runs))
+	for _, run := range runs {
+		pbRuns = append(pbRuns, schedulerTaskRunToProto(run))
+	}
+
+	return &pb.SchedulerTaskRunsResponse{
+		Runs: pbRuns,
+	}, nil
+}
+
+func schedulerTaskToProto(task *model.SchedulerTask) *pb.SchedulerTask {
+	return &pb.SchedulerTask{
+		Name:                      task.Name,
+		IsPaused:                  task.IsPaused,
+		Interval:                  int64(task.Interval.Seconds()),
+		OperationBatchSize:        task.OperationBatchSize,
+		DefaultInterval:           int64(task.DefaultInterval.Seconds()),
+		DefaultOperationBatchSize: task.DefaultOperationBatchSize,
+	}
+}
+
+func schedulerTaskRunToProto(run *model.SchedulerTaskRun) *pb.SchedulerTaskRun {
+	return &pb.SchedulerTaskRun{
+		Id:         run.ID,
+		TaskName:   run.TaskName,
+		InstanceId: run.InstanceID,
+		Trigger:    string(run.Trigger),
+		Processed:  run.Processed,
+		Succeeded:  run.Succeeded,
+		Errored:    run.Errored,
+		StartedAt:  run.StartedAt.UTC().Unix(),
+		FinishedAt: run.FinishedAt.UTC().Unix(),
+		DurationMs: run.Duration().Milliseconds(),
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -11,8 +12,8 @@ import (
 )
 
 type MethodService interface {
-	All(ctx context.Context, opType model.OperationType, currency string) ([]model.Method, error)
-	GetOne(ctx context.Context, opType model.OperationType, currency, externalSystem, externalMethod string) (*model.Method, error)
+	All(ctx context.Context, opType model.OperationType, merchantID, currency string) ([]model.Method, error)
+	GetOne(ctx context.Context, opType model.OperationType, merchantID, currency, externalSystem, externalMethod string) (*model.Method, error)
 }
 
 type LimitService interface {
@@ -26,31 +27,75 @@ type PaymentService interface {
 }
 
 type ToolService interface {
-	All(ctx context.Context, userID string) ([]*model.Tool, error)
-	EditOne(ctx context.Context, id, userID, externalMethod, name string) (*model.Tool, error)
-	RemoveOne(ctx context.Context, id, userID, externalMethod string, source model.ActionSource) error
-	RecoverOne(ctx context.Context, id, userID, externalMethod string) error
-	GetOne(ctx context.Context, id, userID, externalMethod string) (*model.Tool, error)
+	All(ctx context.Context, merchantID, userID string) ([]*model.Tool, error)
+	EditOne(ctx context.Context, id, merchantID, userID, externalMethod, name string) (*model.Tool, error)
+	RemoveOne(ctx context.Context, id, merchantID, userID, externalMethod string, source model.ActionSource) error
+	RecoverOne(ctx context.Context, id, merchantID, userID, externalMethod string) error
+	GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error)
+	Conceal(tool *model.Tool)
 }
 
 type PayoutService interface {
 	Create(ctx context.Context, data model.CreatePayoutData) (model.CreatePayoutResult, error)
 	Confirm(ctx context.Context, data model.ConfirmPayoutData) error
-	ResendCode(ctx context.Context, opID int64, userID, langCode string) error
+	Cancel(ctx context.Context, opID int64, merchantID, userID string) error
+	ResendCode(ctx context.Context, opID int64, merchantID, userID, langCode string) error
 	Fail(ctx context.Context, data model.FailPayoutData) error
 	Success(ctx context.Context, data model.SuccessPayoutData) error
 }
 
 type OperationService interface {
 	AllForReport(ctx context.Context, criteria model.OperationCriteria) ([]model.ReportOperation, error)
+	Analytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error)
 	GetOne(ctx context.Context, criteria model.OperationCriteria) (*model.Operation, error)
-	ChangeStatus(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) (model.OperationChangeStatusResult, error)
+}
+
+type StatusService interface {
+	Change(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) (model.OperationChangeStatusResult, error)
 }
 
 type FavoritesService interface {
 	AddToFavorites(ctx context.Context, data model.FavoritesData) error
 	RemoveFromFavorites(ctx context.Context, data model.FavoritesData) error
-	FillForMethods(ctx context.Context, opType model.OperationType, userID string, methods []model.Method) error
+	FillForMethods(ctx context.Context, opType model.OperationType, merchantID, userID string, methods []model.Method) error
+}
+
+type DriftService interface {
+	All(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error)
+}
+
+type ReconciliationService interface {
+	Reconcile(ctx context.Context, data model.ReconcileData) (*model.ReconciliationRun, error)
+	All(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error)
+	GetOne(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error)
+}
+
+type SchedulerService interface {
+	Tasks(ctx context.Context) ([]*model.SchedulerTask, error)
+	Pause(ctx context.Context, name string) error
+	Resume(ctx context.Context, name string) error
+	Trigger(ctx context.Context, name string) error
+	Update(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error
+	Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error)
+}
+
+type DeadLetterService interface {
+	All(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error)
+	Retry(ctx context.Context, operationID int64, merchantID *string) error
+	Resolve(ctx context.Context, operationID int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error
+}
+
+type AuditService interface {
+	Record(ctx context.Context, entry *model.AuditEntry) error
+	All(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error)
+}
+
+type BlockListService interface {
+	All(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error)
+	GetOne(ctx context.Context, id int64, merchantID *string) (*model.BlockEntry, error)
+	Create(ctx context.Context, entry *model.BlockEntry) error
+	Update(ctx context.Context, entry *model.BlockEntry) error
+	Delete(ctx context.Context, id int64) error
 }
 
 type IntegrationClient interface {
@@ -58,30 +103,44 @@ type IntegrationClient interface {
 }
 
 type Server struct {
-	server            *grpc.Server
-	listener          net.Listener
-	methodService     MethodService
-	limitService      LimitService
-	paymentService    PaymentService
-	toolService       ToolService
-	payoutService     PayoutService
-	operationService  OperationService
-	favoritesService  FavoritesService
-	integrationClient IntegrationClient
+	server                *grpc.Server
+	listener              net.Listener
+	methodService         MethodService
+	limitService          LimitService
+	paymentService        PaymentService
+	toolService           ToolService
+	payoutService         PayoutService
+	operationService      OperationService
+	statusService         StatusService
+	driftService          DriftService
+	favoritesService      FavoritesService
+	reconciliationService ReconciliationService
+	schedulerService      SchedulerService
+	deadLetterService     DeadLetterService
+	auditService          AuditService
+	blockListService      BlockListService
+	integrationClient     IntegrationClient
 	pb.UnimplementedEngineServiceServer
 }
 
 type Options struct {
-	Server            *grpc.Server
-	Listener          net.Listener
-	MethodService     MethodService
-	LimitService      LimitService
-	PaymentService    PaymentService
-	ToolService       ToolService
-	PayoutService     PayoutService
-	OperationService  OperationService
-	FavoritesService  FavoritesService
-	IntegrationClient IntegrationClient
+	Server                *grpc.Server
+	Listener              net.Listener
+	MethodService         MethodService
+	LimitService          LimitService
+	PaymentService        PaymentService
+	ToolService           ToolService
+	PayoutService         PayoutService
+	OperationService      OperationService
+	StatusService         StatusService
+	DriftService          DriftService
+	FavoritesService      FavoritesService
+	ReconciliationService ReconciliationService
+	SchedulerService      SchedulerService
+	DeadLetterService     DeadLetterService
+	AuditService          AuditService
+	BlockListService      BlockListService
+	IntegrationClient     IntegrationClient
 }
 
 func NewServer(opts Options) *Server {
@@ -94,7 +153,14 @@ func NewServer(opts Options) *Server {
 	s.toolService = opts.ToolService
 	s.payoutService = opts.PayoutService
 	s.operationService = opts.OperationService
+	s.statusService = opts.StatusService
+	s.driftService = opts.DriftService
 	s.favoritesService = opts.FavoritesService
+	s.reconciliationService = opts.ReconciliationService
+	s.schedulerService = opts.SchedulerService
+	s.deadLetterService = opts.DeadLetterService
+	s.auditService = opts.AuditService
+	s.blockListService = opts.BlockListService
 	s.integrationClient = opts.IntegrationClient
 	return &s
 }
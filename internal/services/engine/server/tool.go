@@ -2,17 +2,20 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/shared"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
 func (s *Server) AvailableTools(ctx context.Context, request *pbEngine.AvailableToolsRequest) (*pbEngine.AvailableToolsResponse, error) {
-	tools, err := s.toolService.All(ctx, request.GetUserId())
+	tools, err := s.toolService.All(ctx, model.MerchantIDOrDefault(request.GetMerchantId()), request.GetUserId())
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +23,7 @@ func (s *Server) AvailableTools(ctx context.Context, request *pbEngine.Available
 	pbTools := make([]*pb.Tool, 0, len(tools))
 	for _, tool := range tools {
 		if tool != nil {
-			pbTools = append(pbTools, convert.ToolToProto(tool))
+			pbTools = append(pbTools, s.toolToProto(tool))
 		}
 	}
 
@@ -31,27 +34,43 @@ func (s *Server) AvailableTools(ctx context.Context, request *pbEngine.Available
 
 func (s *Server) EditTool(ctx context.Context, request *pbEngine.EditToolRequest) (*pbEngine.EditToolResponse, error) {
 	id := request.GetId()
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
 	userID := request.GetUserId()
 	externalMethod := request.GetExternalMethod()
 	name := request.GetName()
 
-	edited, err := s.toolService.EditOne(ctx, id, userID, externalMethod, name)
+	edited, err := s.toolService.EditOne(ctx, id, merchantID, userID, externalMethod, name)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pbEngine.EditToolResponse{
-		Tool: convert.ToolToProto(edited),
+		Tool: s.toolToProto(edited),
 	}, nil
 }
 
 func (s *Server) RemoveTool(ctx context.Context, request *pbEngine.RemoveToolRequest) (*emptypb.Empty, error) {
 	id := request.GetId()
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
 	userID := request.GetUserId()
 	externalMethod := request.GetExternalMethod()
 	actionSource := actionSourceFromProto(request.GetActionSource())
 
-	if err := s.toolService.RemoveOne(ctx, id, userID, externalMethod, actionSource); err != nil {
+	if actionSource != model.ActionSourceAdministrator {
+		if err := s.toolService.RemoveOne(ctx, id, merchantID, userID, externalMethod, actionSource); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	// удаление от имени администратора доступно только административному интерфейсу и фиксируется в журнале аудита
+	if err := pkggrpc.Authorize(ctx, pkggrpc.CallerReport); err != nil {
+		return nil, err
+	}
+
+	if err := s.auditedToolAction(ctx, request.GetAudit(), model.AuditActionToolRemove, id, merchantID, userID, externalMethod, func() error {
+		return s.toolService.RemoveOne(ctx, id, merchantID, userID, externalMethod, actionSource)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -60,16 +79,59 @@ func (s *Server) RemoveTool(ctx context.Context, request *pbEngine.RemoveToolReq
 
 func (s *Server) RecoverTool(ctx context.Context, request *pbEngine.RecoverToolRequest) (*emptypb.Empty, error) {
 	id := request.GetId()
+	merchantID := model.MerchantIDOrDefault(request.GetMerchantId())
 	userID := request.GetUserId()
 	externalMethod := request.GetExternalMethod()
 
-	if err := s.toolService.RecoverOne(ctx, id, userID, externalMethod); err != nil {
+	if err := s.auditedToolAction(ctx, request.GetAudit(), model.AuditActionToolRecover, id, merchantID, userID, externalMethod, func() error {
+		return s.toolService.RecoverOne(ctx, id, merchantID, userID, externalMethod)
+	}); err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// auditedToolAction выполняет действие над платежным средством и записывает в журнал аудита его состояние до и после.
+func (s *Server) auditedToolAction(
+	ctx context.Context,
+	audit *pbEngine.Audit,
+	action model.AuditAction,
+	id, merchantID, userID, externalMethod string,
+	apply func() error,
+) error {
+	// об отсутствии платежного средства сообщит само действие ошибкой с кодом ObjectNotFound
+	before, err := s.toolService.GetOne(ctx, id, merchantID, userID, externalMethod)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if err = apply(); err != nil {
+		return err
+	}
+
+	after, err := s.toolService.GetOne(ctx, id, merchantID, userID, externalMethod)
+	if err != nil {
+		return err
+	}
+
+	return s.recordAudit(ctx, audit, &model.AuditEntry{
+		Action:     action,
+		TargetType: model.AuditTargetTypeTool,
+		TargetID:   id,
+		ClientID:   userID,
+		MerchantID: merchantID,
+		Before:     toolAuditState(before),
+		After:      toolAuditState(after),
+	})
+}
+
+// toolToProto готовит платежное средство для ответа gateway и report: чувствительные реквизиты в ответ не попадают.
+func (s *Server) toolToProto(tool *model.Tool) *pb.Tool {
+	s.toolService.Conceal(tool)
+	return convert.ToolToProto(tool)
+}
+
 func actionSourceFromProto(actionSource pbEngine.ActionSource) model.ActionSource {
 	switch actionSource {
 	case pbEngine.ActionSource_ACTION_SOURCE_DEFAULT:
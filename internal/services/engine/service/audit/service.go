@@ -0,0 +1,31 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type Repository interface {
+	Create(ctx context.Context, entry *model.AuditEntry) error
+	All(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error)
+}
+
+// Service ведет журнал аудита административных действий.
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+func (s *Service) Record(ctx context.Context, entry *model.AuditEntry) error {
+	return s.repository.Create(ctx, entry)
+}
+
+func (s *Service) All(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error) {
+	return s.repository.All(ctx, criteria)
+}
@@ -0,0 +1,101 @@
+package blocklist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type Repository interface {
+	Create(ctx context.Context, entry *model.BlockEntry) error
+	All(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error)
+	GetOne(ctx context.Context, criteria model.BlockEntryCriteria) (*model.BlockEntry, error)
+	Update(ctx context.Context, entry *model.BlockEntry) error
+	Delete(ctx context.Context, id int64) error
+	Match(ctx context.Context, data model.BlockCheckData, now time.Time) (*model.BlockEntry, error)
+}
+
+// Service ведет списки блокировок клиентов, платежных средств, адресов электронной почты и IP-адресов
+// и проверяет по ним операции.
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, entry *model.BlockEntry) error {
+	entry.MerchantID = model.MerchantIDOrDefault(entry.MerchantID)
+
+	if err := entry.Normalize(); err != nil {
+		return err
+	}
+
+	return s.repository.Create(ctx, entry)
+}
+
+func (s *Service) All(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error) {
+	return s.repository.All(ctx, criteria)
+}
+
+// GetOne возвращает запись по идентификатору; merchantID, если задан, ограничивает поиск записями магазина.
+func (s *Service) GetOne(ctx context.Context, id int64, merchantID *string) (*model.BlockEntry, error) {
+	entry, err := s.repository.GetOne(ctx, model.BlockEntryCriteria{ID: &id, MerchantID: merchantID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, perror.NewInternal().WithCode(
+				perror.CodeObjectNotFound,
+			).WithDescription(
+				fmt.Sprintf("block entry with id %v not found", id),
+			)
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (s *Service) Update(ctx context.Context, entry *model.BlockEntry) error {
+	return s.repository.Update(ctx, entry)
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return s.repository.Delete(ctx, id)
+}
+
+// Match возвращает действующую запись, под которую подпадают признаки data, или nil, если таких нет.
+func (s *Service) Match(ctx context.Context, data model.BlockCheckData) (*model.BlockEntry, error) {
+	entry, err := s.repository.Match(ctx, data, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("match block list: %w", err)
+	}
+	return entry, nil
+}
+
+// Check возвращает ошибку с кодом Blocked, если признаки data подпадают под действующую запись.
+// Причина блокировки клиенту не раскрывается.
+func (s *Service) Check(ctx context.Context, data model.BlockCheckData) error {
+	entry, err := s.Match(ctx, data)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+
+	return perror.NewInternal().WithCode(
+		perror.CodeBlocked,
+	).WithDescription(
+		fmt.Sprintf("operation is blocked by block list entry %v of type %v", entry.ID, entry.Type),
+	)
+}
@@ -0,0 +1,87 @@
+package deadletter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+const alertTimeout = 10 * time.Second
+
+type alertPayload struct {
+	OperationID     int64  `json:"operation_id"`
+	OperationType   string `json:"operation_type,omitempty"`
+	OperationStatus string `json:"operation_status,omitempty"`
+	ExternalSystem  string `json:"external_system,omitempty"`
+	ExternalMethod  string `json:"external_method,omitempty"`
+	TaskName        string `json:"task_name"`
+	Attempts        int    `json:"attempts"`
+	LastError       string `json:"last_error"`
+	DeadLetteredAt  int64  `json:"dead_lettered_at"`
+}
+
+// WebhookAlerter пишет в журнал о переводе операции в очередь недоставленных и,
+// если задан адрес, отправляет оповещение POST-запросом на webhook.
+type WebhookAlerter struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookAlerter(url string) *WebhookAlerter {
+	return &WebhookAlerter{
+		url:    url,
+		client: &http.Client{Timeout: alertTimeout},
+	}
+}
+
+func (a *WebhookAlerter) Alert(ctx context.Context, attempt *model.OperationAttempt) error {
+	slog.Default().Error(
+		"operation has been dead-lettered",
+		"operation_id", attempt.OperationID,
+		"task", attempt.TaskName,
+		"attempts", attempt.Attempts,
+		"last_error", attempt.LastError,
+	)
+
+	if a.url == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(alertPayload{
+		OperationID:     attempt.OperationID,
+		OperationType:   string(attempt.OperationType),
+		OperationStatus: string(attempt.OperationStatus),
+		ExternalSystem:  attempt.ExternalSystem,
+		ExternalMethod:  attempt.ExternalMethod,
+		TaskName:        attempt.TaskName,
+		Attempts:        attempt.Attempts,
+		LastError:       attempt.LastError,
+		DeadLetteredAt:  attempt.DeadLetteredAt.UTC().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected alert webhook response status: %v", resp.StatusCode)
+	}
+	return nil
+}
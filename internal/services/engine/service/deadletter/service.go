@@ -0,0 +1,155 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type Repository interface {
+	Fail(ctx context.Context, operationID int64, taskName, lastError string, budget int) (*model.OperationAttempt, error)
+	Reset(ctx context.Context, operationID int64) error
+	ResetDeadLettered(ctx context.Context, operationID int64) (bool, error)
+	All(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error)
+}
+
+type StatusService interface {
+	Change(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) (model.OperationChangeStatusResult, error)
+}
+
+type Alerter interface {
+	Alert(ctx context.Context, attempt *model.OperationAttempt) error
+}
+
+// Service учитывает неудачные попытки фоновой обработки операций и управляет очередью недоставленных операций.
+type Service struct {
+	repository    Repository
+	statusService StatusService
+	alerter       Alerter
+}
+
+func NewService(repository Repository, statusService StatusService, alerter Alerter) *Service {
+	return &Service{
+		repository:    repository,
+		statusService: statusService,
+		alerter:       alerter,
+	}
+}
+
+// Fail фиксирует неудачную попытку обработки операции и оповещает о её переводе в очередь недоставленных,
+// если лимит попыток budget исчерпан.
+func (s *Service) Fail(ctx context.Context, op *model.Operation, taskName string, cause error, budget int) error {
+	attempt, err := s.repository.Fail(ctx, op.ID, taskName, cause.Error(), budget)
+	if err != nil {
+		return err
+	}
+
+	if !attempt.DeadLettered() {
+		return nil
+	}
+
+	attempt.OperationType = op.Type
+	attempt.OperationStatus = op.Status
+	attempt.ExternalSystem = op.ExternalSystem
+	attempt.ExternalMethod = op.ExternalMethod
+
+	if err = s.alerter.Alert(ctx, attempt); err != nil {
+		slog.Default().Error(
+			"failed to send dead-lettered operation alert",
+			"operation_id", op.ID,
+			"error", err,
+		)
+	}
+	return nil
+}
+
+// Succeed сбрасывает счетчик неудачных попыток обработки операции.
+func (s *Service) Succeed(ctx context.Context, operationID int64) error {
+	return s.repository.Reset(ctx, operationID)
+}
+
+func (s *Service) All(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error) {
+	return s.repository.All(ctx, criteria)
+}
+
+// Retry возвращает недоставленную операцию в обработку планировщиком с обнуленным счетчиком попыток.
+// Если задан магазин merchantID, операция должна принадлежать ему.
+func (s *Service) Retry(ctx context.Context, operationID int64, merchantID *string) error {
+	if merchantID != nil {
+		if _, err := s.deadLettered(ctx, operationID, merchantID); err != nil {
+			return err
+		}
+	}
+
+	found, err := s.repository.ResetDeadLettered(ctx, operationID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return deadLetteredNotFoundError(operationID)
+	}
+	return nil
+}
+
+// Resolve вручную переводит недоставленную операцию в итоговый статус и убирает её из очереди недоставленных.
+// Если задан магазин merchantID, операция должна принадлежать ему.
+func (s *Service) Resolve(ctx context.Context, operationID int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error {
+	attempt, err := s.deadLettered(ctx, operationID, merchantID)
+	if err != nil {
+		return err
+	}
+
+	if newStatus != model.OperationStatusSuccess && newStatus != model.OperationStatusFailed {
+		return perror.NewInternal().WithCode(
+			perror.CodeUnresolvedStatusConflict,
+		).WithDescription(
+			fmt.Sprintf("dead-lettered operation cannot be resolved with status %q", newStatus),
+		)
+	}
+
+	result, err := s.statusService.Change(ctx, operationID, newStatus, newExternalStatus)
+	if err != nil {
+		return err
+	}
+
+	if result == "" {
+		return perror.NewInternal().WithCode(
+			perror.CodeUnresolvedStatusConflict,
+		).WithDescription(
+			fmt.Sprintf("cannot change status of operation %v from %q to %q", operationID, attempt.OperationStatus, newStatus),
+		)
+	}
+
+	return s.repository.Reset(ctx, operationID)
+}
+
+// deadLettered возвращает попытку обработки недоставленной операции магазина merchantID (nil - любого магазина).
+func (s *Service) deadLettered(ctx context.Context, operationID int64, merchantID *string) (*model.OperationAttempt, error) {
+	deadLettered := true
+	attempts, err := s.repository.All(ctx, model.OperationAttemptCriteria{
+		OperationID:  &operationID,
+		MerchantID:   merchantID,
+		DeadLettered: &deadLettered,
+		MaxCount:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attempts) == 0 {
+		return nil, deadLetteredNotFoundError(operationID)
+	}
+	return attempts[0], nil
+}
+
+func deadLetteredNotFoundError(operationID int64) error {
+	return perror.NewInternal().WithCode(
+		perror.CodeObjectNotFound,
+	).WithDescription(
+		fmt.Sprintf("dead-lettered operation with id %v not found", operationID),
+	)
+}
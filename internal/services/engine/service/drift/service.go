@@ -0,0 +1,30 @@
+package drift
+
+import (
+	"context"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type Repository interface {
+	Create(ctx context.Context, drift *model.OperationStatusDrift) error
+	All(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error)
+}
+
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+func (s *Service) Record(ctx context.Context, drift *model.OperationStatusDrift) error {
+	return s.repository.Create(ctx, drift)
+}
+
+func (s *Service) All(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error) {
+	return s.repository.All(ctx, criteria)
+}
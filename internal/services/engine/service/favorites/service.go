@@ -11,7 +11,7 @@ import (
 type Repository interface {
 	AddToFavorites(ctx context.Context, data model.FavoritesData) error
 	RemoveFromFavorites(ctx context.Context, data model.FavoritesData) error
-	GetFavorites(ctx context.Context, userID string) (model.UserFavorites, error)
+	GetFavorites(ctx context.Context, merchantID, userID string) (model.UserFavorites, error)
 }
 
 type Service struct {
@@ -32,8 +32,8 @@ func (s *Service) RemoveFromFavorites(ctx context.Context, data model.FavoritesD
 	return s.repository.RemoveFromFavorites(ctx, data)
 }
 
-func (s *Service) FillForMethods(ctx context.Context, opType model.OperationType, userID string, methods []model.Method) error {
-	favorites, err := s.repository.GetFavorites(ctx, userID)
+func (s *Service) FillForMethods(ctx context.Context, opType model.OperationType, merchantID, userID string, methods []model.Method) error {
+	favorites, err := s.repository.GetFavorites(ctx, merchantID, userID)
 	if err != nil {
 		return err
 	}
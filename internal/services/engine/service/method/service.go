@@ -10,8 +10,8 @@ import (
 )
 
 type IntegrationClient interface {
-	AllAvailableMethods(ctx context.Context, opType model.OperationType, currency string) ([]model.Method, error)
-	AvailableMethodsByExternalSystem(ctx context.Context, opType model.OperationType, currency, externalSystem string) ([]model.Method, error)
+	AllAvailableMethods(ctx context.Context, opType model.OperationType, merchantID, currency string) ([]model.Method, error)
+	AvailableMethodsByExternalSystem(ctx context.Context, opType model.OperationType, merchantID, currency, externalSystem string) ([]model.Method, error)
 }
 
 type Service struct {
@@ -24,8 +24,8 @@ func NewService(integrationClient IntegrationClient) *Service {
 	}
 }
 
-func (s *Service) All(ctx context.Context, opType model.OperationType, currency string) ([]model.Method, error) {
-	methods, err := s.integrationClient.AllAvailableMethods(ctx, opType, currency)
+func (s *Service) All(ctx context.Context, opType model.OperationType, merchantID, currency string) ([]model.Method, error) {
+	methods, err := s.integrationClient.AllAvailableMethods(ctx, opType, merchantID, currency)
 	if err != nil {
 		return nil, err
 	}
@@ -40,8 +40,8 @@ func (s *Service) All(ctx context.Context, opType model.OperationType, currency
 	return result, nil
 }
 
-func (s *Service) GetOne(ctx context.Context, opType model.OperationType, currency, externalSystem, externalMethod string) (*model.Method, error) {
-	methods, err := s.integrationClient.AvailableMethodsByExternalSystem(ctx, opType, currency, externalSystem)
+func (s *Service) GetOne(ctx context.Context, opType model.OperationType, merchantID, currency, externalSystem, externalMethod string) (*model.Method, error) {
+	methods, err := s.integrationClient.AvailableMethodsByExternalSystem(ctx, opType, merchantID, currency, externalSystem)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
@@ -12,9 +13,12 @@ import (
 
 type Repository interface {
 	All(ctx context.Context, criteria model.OperationCriteria) ([]*model.Operation, error)
+	Claim(ctx context.Context, criteria model.OperationCriteria, claimedBy string, lease time.Duration) ([]*model.Operation, error)
+	Release(ctx context.Context, ids []int64, claimedBy string) error
 	AllForReport(ctx context.Context, criteria model.OperationCriteria) ([]model.ReportOperation, error)
 	GetOneWithoutLock(ctx context.Context, criteria model.OperationCriteria) (*model.Operation, error)
 	AcquireOneLocked(ctx context.Context, criteria model.OperationCriteria, script model.ScriptAcquiredFor) error
+	Analytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error)
 }
 
 type Service struct {
@@ -31,10 +35,22 @@ func (s *Service) All(ctx context.Context, criteria model.OperationCriteria) ([]
 	return s.repository.All(ctx, criteria)
 }
 
+func (s *Service) Claim(ctx context.Context, criteria model.OperationCriteria, claimedBy string, lease time.Duration) ([]*model.Operation, error) {
+	return s.repository.Claim(ctx, criteria, claimedBy, lease)
+}
+
+func (s *Service) Release(ctx context.Context, ids []int64, claimedBy string) error {
+	return s.repository.Release(ctx, ids, claimedBy)
+}
+
 func (s *Service) AllForReport(ctx context.Context, criteria model.OperationCriteria) ([]model.ReportOperation, error) {
 	return s.repository.AllForReport(ctx, criteria)
 }
 
+func (s *Service) Analytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error) {
+	return s.repository.Analytics(ctx, criteria)
+}
+
 func (s *Service) GetOne(ctx context.Context, criteria model.OperationCriteria) (*model.Operation, error) {
 	operation, err := s.repository.GetOneWithoutLock(ctx, criteria)
 	if err != nil {
@@ -16,6 +16,7 @@ func (s *Service) Create(ctx context.Context, data model.CreatePaymentData) (mod
 	var result model.CreatePaymentResult
 
 	op := &model.Operation{
+		MerchantID:     data.MerchantID,
 		UserID:         data.UserID,
 		Type:           model.OperationTypePayment,
 		Currency:       data.Currency,
@@ -25,19 +26,20 @@ func (s *Service) Create(ctx context.Context, data model.CreatePaymentData) (mod
 		ExternalMethod: data.ExternalMethod,
 		ToolID:         data.ToolID,
 		Additional:     data.AdditionalData,
+		ClientIP:       data.ClientIP,
 	}
 
 	// если для операции используется сохраненное платежное средство, передаём его тоже
 	if data.ToolID != "" {
-		tool, err := s.toolRepository.GetOne(ctx, data.ToolID, data.UserID, data.ExternalMethod)
+		tool, err := s.toolRepository.GetOne(ctx, data.ToolID, data.MerchantID, data.UserID, data.ExternalMethod)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return result, perror.NewInternal().WithCode(
 					perror.CodeObjectNotFound,
 				).WithDescription(
 					fmt.Sprintf(
-						"tool with id %q, user id %v and external method %q not found",
-						data.ToolID, data.UserID, data.ExternalMethod,
+						"tool with id %q, merchant id %q, user id %v and external method %q not found",
+						data.ToolID, data.MerchantID, data.UserID, data.ExternalMethod,
 					),
 				)
 			}
@@ -51,9 +53,30 @@ func (s *Service) Create(ctx context.Context, data model.CreatePaymentData) (mod
 				fmt.Sprintf("cannot create payment for removed tool with id %v", tool.ID),
 			)
 		}
+
+		if tool.Expired() {
+			return result, perror.NewInternal().WithCode(
+				perror.CodeToolHasExpired,
+			).WithDescription(
+				fmt.Sprintf("cannot create payment for expired tool with id %v", tool.ID),
+			)
+		}
+
+		// клиент мог передать прежний идентификатор средства, в операции сохраняем актуальный
+		op.ToolID = tool.ID
+		data.ToolID = tool.ID
+
+		if err = s.toolCipher.Open(ctx, tool); err != nil {
+			return result, fmt.Errorf("decrypt tool details: %w", err)
+		}
 		data.Tool = tool
 	}
 
+	// новую карту клиент вводит на стороне платежной системы, её реквизиты проверяются при сохранении средства
+	if err := s.blockList.Check(ctx, model.NewBlockCheckData(data.MerchantID, data.UserID, data.ClientIP, data.AdditionalData, data.Tool)); err != nil {
+		return result, err
+	}
+
 	err := s.operationRepository.Create(ctx, op)
 	if err != nil {
 		return result, fmt.Errorf("create operation in db: %w", err)
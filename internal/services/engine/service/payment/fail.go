@@ -18,7 +18,8 @@ func (s *Service) Fail(ctx context.Context, data model.FailPaymentData) error {
 			}
 
 			if op.Status == model.OperationStatusFailed {
-				slog.Warn(
+				slog.WarnContext(
+					ctx,
 					"duplicate payment fail called",
 					"operation_id", op.ID,
 				)
@@ -30,7 +31,8 @@ func (s *Service) Fail(ctx context.Context, data model.FailPaymentData) error {
 			}
 
 			// TODO: осуществлять уведомление E-commerce системы
-			slog.Info(
+			slog.InfoContext(
+				ctx,
 				"ecommerce system has been notified successfully",
 				"operation_id", op.ID,
 			)
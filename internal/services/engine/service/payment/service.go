@@ -18,23 +18,41 @@ type IntegrationClient interface {
 type ToolRepository interface {
 	Update(ctx context.Context, tool *model.Tool) error
 	Create(ctx context.Context, tool *model.Tool) error
-	GetOne(ctx context.Context, id string, userID string, externalMethod string) (*model.Tool, error)
+	GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error)
+}
+
+// ToolCipher шифрует чувствительные реквизиты нового платежного средства и расшифровывает их для запроса в интеграцию.
+type ToolCipher interface {
+	Seal(ctx context.Context, tool *model.Tool) error
+	Open(ctx context.Context, tool *model.Tool) error
+}
+
+// BlockList проверяет клиента, операцию и платежное средство по списку блокировок.
+type BlockList interface {
+	Check(ctx context.Context, data model.BlockCheckData) error
+	Match(ctx context.Context, data model.BlockCheckData) (*model.BlockEntry, error)
 }
 
 type Service struct {
 	operationRepository OperationRepository
 	integrationClient   IntegrationClient
 	toolRepository      ToolRepository
+	toolCipher          ToolCipher
+	blockList           BlockList
 }
 
 func NewService(
 	operationRepository OperationRepository,
 	integrationClient IntegrationClient,
 	toolRepository ToolRepository,
+	toolCipher ToolCipher,
+	blockList BlockList,
 ) *Service {
 	return &Service{
 		operationRepository: operationRepository,
 		integrationClient:   integrationClient,
 		toolRepository:      toolRepository,
+		toolCipher:          toolCipher,
+		blockList:           blockList,
 	}
 }
@@ -19,7 +19,8 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPaymentData) er
 			}
 
 			if op.Status == model.OperationStatusSuccess {
-				slog.Warn(
+				slog.WarnContext(
+					ctx,
 					"duplicate payment success called",
 					"operation_id", op.ID,
 				)
@@ -27,32 +28,25 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPaymentData) er
 			}
 
 			if data.Tool != nil {
-				if op.ToolID != "" && data.Tool.ID != op.ToolID {
-					return fmt.Errorf("operation tool %q differs from payment tool %q", op.ToolID, data.Tool.ID)
+				if err := s.checkOperationTool(ctx, op, data.Tool); err != nil {
+					return err
 				}
 
-				tool, err := s.toolRepository.GetOne(ctx, data.Tool.ID, data.Tool.UserID, data.Tool.ExternalMethod)
-				switch {
-				case errors.Is(err, sql.ErrNoRows):
-					if err = s.toolRepository.Create(ctx, data.Tool); err != nil {
-						return fmt.Errorf("create tool into db: %w", err)
-					}
-					op.ToolID = data.Tool.ID
-				case err != nil:
-					return fmt.Errorf("get tool from db: %w", err)
-				case tool.CanBeRecovered():
-					tool.Status = model.ToolStatusActive
-
-					if err = s.toolRepository.Update(ctx, tool); err != nil {
-						return fmt.Errorf("update tool into db: %w", err)
-					}
-					op.ToolID = data.Tool.ID
-
-					slog.Info(
-						"payment tool has been recovered",
+				// деньги уже списаны, поэтому платеж проводится, но заблокированное средство не сохраняется
+				blockEntry, err := s.blockList.Match(ctx, model.NewBlockCheckData(op.MerchantID, op.UserID, op.ClientIP, op.Additional, data.Tool))
+				if err != nil {
+					return err
+				}
+				if blockEntry != nil {
+					slog.WarnContext(
+						ctx,
+						"payment tool has not been saved: blocked by block list",
 						"operation_id", op.ID,
-						"tool_id", op.ToolID,
+						"tool_id", data.Tool.ID,
+						"block_entry_id", blockEntry.ID,
 					)
+				} else if err = s.saveTool(ctx, op, data.Tool); err != nil {
+					return err
 				}
 			}
 
@@ -62,7 +56,8 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPaymentData) er
 			op.ExternalStatus = data.ExternalStatus
 
 			if data.NewAmount > 0 {
-				slog.Info(
+				slog.InfoContext(
+					ctx,
 					"payment amount changed",
 					"operation_id", op.ID,
 					"old_amount", op.Amount,
@@ -78,7 +73,8 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPaymentData) er
 			}
 
 			// TODO: осуществлять уведомление E-commerce системы
-			slog.Info(
+			slog.InfoContext(
+				ctx,
 				"ecommerce system has been notified successfully",
 				"operation_id", op.ID,
 			)
@@ -87,3 +83,59 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPaymentData) er
 		},
 	)
 }
+
+// checkOperationTool проверяет, что платежная система вернула то же платежное средство, которое было выбрано
+// при создании операции. Операция могла быть создана с идентификатором карты, который был у неё до перехода
+// на отпечатки: тогда сохраненная карта с отпечатком из ответа должна помнить этот идентификатор.
+func (s *Service) checkOperationTool(ctx context.Context, op *model.Operation, tool *model.Tool) error {
+	if op.ToolID == "" || tool.ID == op.ToolID {
+		return nil
+	}
+
+	saved, err := s.toolRepository.GetOne(ctx, tool.ID, op.MerchantID, op.UserID, op.ExternalMethod)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("get tool from db: %w", err)
+	}
+	if saved == nil || !saved.IdentifiedBy(op.ToolID) {
+		return fmt.Errorf("operation tool %q differs from payment tool %q", op.ToolID, tool.ID)
+	}
+
+	op.ToolID = saved.ID
+	return nil
+}
+
+// saveTool сохраняет платежное средство из успешного платежа или восстанавливает ранее удаленное клиентом.
+func (s *Service) saveTool(ctx context.Context, op *model.Operation, tool *model.Tool) error {
+	// средство сохраняется в магазине операции независимо от ответа платежной системы
+	tool.MerchantID = op.MerchantID
+
+	saved, err := s.toolRepository.GetOne(ctx, tool.ID, tool.MerchantID, tool.UserID, tool.ExternalMethod)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if err = s.toolCipher.Seal(ctx, tool); err != nil {
+			return fmt.Errorf("encrypt tool details: %w", err)
+		}
+		if err = s.toolRepository.Create(ctx, tool); err != nil {
+			return fmt.Errorf("create tool into db: %w", err)
+		}
+		op.ToolID = tool.ID
+	case err != nil:
+		return fmt.Errorf("get tool from db: %w", err)
+	case saved.CanBeRecovered():
+		saved.Status = model.ToolStatusActive
+
+		if err = s.toolRepository.Update(ctx, saved); err != nil {
+			return fmt.Errorf("update tool into db: %w", err)
+		}
+		op.ToolID = tool.ID
+
+		slog.InfoContext(
+			ctx,
+			"payment tool has been recovered",
+			"operation_id", op.ID,
+			"tool_id", op.ToolID,
+		)
+	}
+
+	return nil
+}
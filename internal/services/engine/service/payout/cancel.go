@@ -0,0 +1,52 @@
+package payout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (s *Service) Cancel(ctx context.Context, opID int64, merchantID, userID string) error {
+	if err := s.operationRepository.AcquireOneLocked(ctx, model.OperationCriteria{ID: &opID},
+		func(ctx context.Context, op *model.Operation) error {
+			if op.Type != model.OperationTypePayout {
+				return fmt.Errorf("payout cancel called for unresolved type: %q", op.Type)
+			}
+
+			if op.MerchantID != merchantID || op.UserID != userID {
+				return fmt.Errorf("invalid merchant id %q or user id %v", merchantID, userID)
+			}
+
+			if op.Status != model.OperationStatusNew {
+				if op.Status == model.OperationStatusFailed && op.FailReason == model.OperationFailReasonCanceled {
+					return nil
+				}
+
+				return perror.NewInternal().WithCode(
+					perror.CodeUnresolvedStatusConflict,
+				).WithDescription(
+					fmt.Sprintf("cannot cancel payout with status %q", op.Status),
+				)
+			}
+
+			op.Status = model.OperationStatusFailed
+			op.FailReason = model.OperationFailReasonCanceled
+
+			return nil
+		},
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return perror.NewInternal().WithCode(
+				perror.CodeObjectNotFound,
+			).WithDescription(
+				fmt.Sprintf("payout with id %v not found", opID),
+			)
+		}
+		return err
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
@@ -17,8 +18,8 @@ func (s *Service) Confirm(ctx context.Context, data model.ConfirmPayoutData) err
 				return fmt.Errorf("payout confirm called for unresolved type: %q", op.Type)
 			}
 
-			if op.UserID != data.UserID {
-				return fmt.Errorf("invalid user id: %v", data.UserID)
+			if op.MerchantID != data.MerchantID || op.UserID != data.UserID {
+				return fmt.Errorf("invalid merchant id %q or user id %v", data.MerchantID, data.UserID)
 			}
 
 			if op.Status != model.OperationStatusNew {
@@ -33,6 +34,17 @@ func (s *Service) Confirm(ctx context.Context, data model.ConfirmPayoutData) err
 				)
 			}
 
+			if time.Now().After(s.ConfirmationExpiresAt(op)) {
+				op.Status = model.OperationStatusFailed
+				op.FailReason = model.OperationFailReasonTimeout
+
+				return perror.NewInternal().WithCode(
+					perror.CodeUnresolvedStatusConflict,
+				).WithDescription(
+					fmt.Sprintf("payout confirmation has expired at %v", s.ConfirmationExpiresAt(op)),
+				)
+			}
+
 			if data.ConfirmationCode != op.ConfirmationCode {
 				op.ConfirmationAttempts++
 
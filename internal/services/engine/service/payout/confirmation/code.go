@@ -40,7 +40,7 @@ func (m *CodeManager) GenerateCode() string {
 	return strconv.Itoa(m.r.Intn(confirmationCodeRandMaxLimit) + testConfirmationCode)
 }
 
-func (m *CodeManager) SendCode(_ context.Context, opID int64, email, code, langCode string) error {
+func (m *CodeManager) SendCode(ctx context.Context, opID int64, email, code, langCode string) error {
 	if email == "" {
 		return errors.New("got empty email")
 	}
@@ -59,7 +59,7 @@ func (m *CodeManager) SendCode(_ context.Context, opID int64, email, code, langC
 		}
 	}
 
-	slog.Info("confirmation code send",
+	slog.InfoContext(ctx, "confirmation code send",
 		"email", email,
 		"code", code,
 		"message", message,
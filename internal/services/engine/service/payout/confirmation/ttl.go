@@ -0,0 +1,41 @@
+package confirmation
+
+import "time"
+
+const defaultTTL = time.Hour
+
+// TTL определяет время, в течение которого клиент может подтвердить выплату,
+// в зависимости от платежной системы и платежного метода.
+type TTL struct {
+	defaultTTL time.Duration
+	ttls       map[string]map[string]time.Duration
+}
+
+func NewTTL(defaultTTLMin int, ttlMin map[string]map[string]int) *TTL {
+	t := &TTL{
+		defaultTTL: time.Duration(defaultTTLMin) * time.Minute,
+		ttls:       make(map[string]map[string]time.Duration, len(ttlMin)),
+	}
+	if defaultTTLMin <= 0 {
+		t.defaultTTL = defaultTTL
+	}
+
+	for externalSystem, methods := range ttlMin {
+		t.ttls[externalSystem] = make(map[string]time.Duration, len(methods))
+		for externalMethod, minutes := range methods {
+			if minutes <= 0 {
+				continue
+			}
+			t.ttls[externalSystem][externalMethod] = time.Duration(minutes) * time.Minute
+		}
+	}
+
+	return t
+}
+
+func (t *TTL) Get(externalSystem, externalMethod string) time.Duration {
+	if ttl, ok := t.ttls[externalSystem][externalMethod]; ok {
+		return ttl
+	}
+	return t.defaultTTL
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -15,15 +16,15 @@ import (
 func (s *Service) Create(ctx context.Context, data model.CreatePayoutData) (model.CreatePayoutResult, error) {
 	var result model.CreatePayoutResult
 
-	tool, err := s.toolRepository.GetOne(ctx, data.ToolID, data.UserID, data.ExternalMethod)
+	tool, err := s.toolRepository.GetOne(ctx, data.ToolID, data.MerchantID, data.UserID, data.ExternalMethod)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return result, perror.NewInternal().WithCode(
 				perror.CodeObjectNotFound,
 			).WithDescription(
 				fmt.Sprintf(
-					"tool with id %q, user id %v and external method %q not found",
-					data.ToolID, data.UserID, data.ExternalMethod,
+					"tool with id %q, merchant id %q, user id %v and external method %q not found",
+					data.ToolID, data.MerchantID, data.UserID, data.ExternalMethod,
 				),
 			)
 		}
@@ -38,7 +39,24 @@ func (s *Service) Create(ctx context.Context, data model.CreatePayoutData) (mode
 		)
 	}
 
+	if tool.Expired() {
+		return result, perror.NewInternal().WithCode(
+			perror.CodeToolHasExpired,
+		).WithDescription(
+			fmt.Sprintf("cannot create payout for expired tool with id %v", tool.ID),
+		)
+	}
+
+	if err = s.blockList.Check(ctx, model.NewBlockCheckData(data.MerchantID, data.UserID, data.ClientIP, data.AdditionalData, tool)); err != nil {
+		return result, err
+	}
+
+	// клиент мог передать прежний идентификатор средства, в операции сохраняем актуальный
+	data.ToolID = tool.ID
+
 	op := &model.Operation{
+		CreatedAt:        time.Now().UTC(),
+		MerchantID:       data.MerchantID,
 		UserID:           data.UserID,
 		Type:             model.OperationTypePayout,
 		Currency:         data.Currency,
@@ -48,6 +66,7 @@ func (s *Service) Create(ctx context.Context, data model.CreatePayoutData) (mode
 		ExternalMethod:   data.ExternalMethod,
 		ToolID:           data.ToolID,
 		Additional:       data.AdditionalData,
+		ClientIP:         data.ClientIP,
 		ConfirmationCode: s.codeManager.GenerateCode(),
 	}
 
@@ -72,6 +91,7 @@ func (s *Service) Create(ctx context.Context, data model.CreatePayoutData) (mode
 
 	result.OperationID = op.ID
 	result.Status = op.Status
+	result.ConfirmationExpiresAt = s.ConfirmationExpiresAt(op)
 
 	return result, nil
 }
@@ -13,15 +13,15 @@ import (
 )
 
 func (s *Service) RequestPayout(ctx context.Context, data model.CreatePayoutData) error {
-	tool, err := s.toolRepository.GetOne(ctx, data.ToolID, data.UserID, data.ExternalMethod)
+	tool, err := s.toolRepository.GetOne(ctx, data.ToolID, data.MerchantID, data.UserID, data.ExternalMethod)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = perror.NewInternal().WithCode(
 				perror.CodeObjectNotFound,
 			).WithDescription(
 				fmt.Sprintf(
-					"tool with id %q, user id %v and external method %q not found",
-					data.ToolID, data.UserID, data.ExternalMethod,
+					"tool with id %q, merchant id %q, user id %v and external method %q not found",
+					data.ToolID, data.MerchantID, data.UserID, data.ExternalMethod,
 				),
 			)
 			if saveErr := s.operationRepository.AcquireOneLocked(ctx, model.OperationCriteria{ID: &data.OperationID},
@@ -38,12 +38,29 @@ func (s *Service) RequestPayout(ctx context.Context, data model.CreatePayoutData
 		return fmt.Errorf("get tool from db: %w", err)
 	}
 
-	if tool.Removed() {
+	switch {
+	case tool.Removed():
 		err = perror.NewInternal().WithCode(
 			perror.CodeToolHasBeenRemoved,
 		).WithDescription(
 			fmt.Sprintf("cannot create payout for removed tool with id %v", tool.ID),
 		)
+	case tool.Expired():
+		err = perror.NewInternal().WithCode(
+			perror.CodeToolHasExpired,
+		).WithDescription(
+			fmt.Sprintf("cannot create payout for expired tool with id %v", tool.ID),
+		)
+	default:
+		// запись в списке блокировок могла появиться, пока выплата ожидала подтверждения
+		err = s.blockList.Check(ctx, model.NewBlockCheckData(data.MerchantID, data.UserID, data.ClientIP, data.AdditionalData, tool))
+		var perr *perror.Error
+		if err != nil && !(errors.As(err, &perr) && perr.Code == perror.CodeBlocked) {
+			return fmt.Errorf("check block list: %w", err)
+		}
+	}
+
+	if err != nil {
 		if saveErr := s.operationRepository.AcquireOneLocked(ctx, model.OperationCriteria{ID: &data.OperationID},
 			func(ctx context.Context, op *model.Operation) error {
 				op.Status = model.OperationStatusFailed
@@ -56,20 +73,19 @@ func (s *Service) RequestPayout(ctx context.Context, data model.CreatePayoutData
 		return err
 	}
 
-	data.Tool = tool
+	// копия нужна, чтобы расшифрованные реквизиты не попали в данные успешной выплаты
+	integrationTool := *tool
+	if err = s.toolCipher.Open(ctx, &integrationTool); err != nil {
+		return fmt.Errorf("decrypt tool details: %w", err)
+	}
+	data.Tool = &integrationTool
 
+	// при ошибке интеграции выплата остается подтвержденной: планировщик повторит запрос,
+	// пока не будет исчерпан лимит попыток. Ключ идемпотентности выплаты во внешней системе
+	// зависит только от операции, поэтому повторный запрос не создаст вторую выплату
 	result, err := s.integrationClient.CreatePayout(ctx, data)
 	if err != nil {
-		if saveErr := s.operationRepository.AcquireOneLocked(ctx, model.OperationCriteria{ID: &data.OperationID},
-			func(ctx context.Context, op *model.Operation) error {
-				op.Status = model.OperationStatusFailed
-				op.FailReason = err.Error()
-				return nil
-			},
-		); saveErr != nil {
-			err = multierror.Append(err, saveErr)
-		}
-		return err
+		return fmt.Errorf("request payout from integration: %w", err)
 	}
 
 	switch result.ExternalStatus {
@@ -12,7 +12,7 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
-func (s *Service) ResendCode(ctx context.Context, opID int64, userID string, langCode string) error {
+func (s *Service) ResendCode(ctx context.Context, opID int64, merchantID, userID, langCode string) error {
 	confirmationCode := s.codeManager.GenerateCode()
 
 	var email string
@@ -22,8 +22,8 @@ func (s *Service) ResendCode(ctx context.Context, opID int64, userID string, lan
 				return fmt.Errorf("resend code called for unresolved type: %q", op.Type)
 			}
 
-			if op.UserID != userID {
-				return fmt.Errorf("invalid user id: %v", userID)
+			if op.MerchantID != merchantID || op.UserID != userID {
+				return fmt.Errorf("invalid merchant id %q or user id %v", merchantID, userID)
 			}
 
 			if op.Status != model.OperationStatusNew {
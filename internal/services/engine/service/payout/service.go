@@ -2,6 +2,7 @@ package payout
 
 import (
 	"context"
+	"time"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/engine/service/payout/confirmation"
@@ -18,7 +19,17 @@ type IntegrationClient interface {
 
 type ToolRepository interface {
 	Update(ctx context.Context, tool *model.Tool) error
-	GetOne(ctx context.Context, id string, userID string, externalMethod string) (*model.Tool, error)
+	GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error)
+}
+
+// ToolCipher расшифровывает чувствительные реквизиты платежного средства для запроса в интеграцию.
+type ToolCipher interface {
+	Open(ctx context.Context, tool *model.Tool) error
+}
+
+// BlockList проверяет клиента, операцию и платежное средство по списку блокировок.
+type BlockList interface {
+	Check(ctx context.Context, data model.BlockCheckData) error
 }
 
 type ConfirmationCodeManager interface {
@@ -30,7 +41,10 @@ type Service struct {
 	operationRepository OperationRepository
 	integrationClient   IntegrationClient
 	toolRepository      ToolRepository
+	toolCipher          ToolCipher
+	blockList           BlockList
 	codeManager         ConfirmationCodeManager
+	confirmationTTL     *confirmation.TTL
 	wrongCodeLimit      int
 }
 
@@ -38,7 +52,10 @@ func NewService(
 	operationRepository OperationRepository,
 	integrationClient IntegrationClient,
 	toolRepository ToolRepository,
+	toolCipher ToolCipher,
+	blockList BlockList,
 	smtpClient confirmation.SMTPClient,
+	confirmationTTL *confirmation.TTL,
 	wrongCodeLimit int,
 	isTest bool,
 ) *Service {
@@ -46,7 +63,15 @@ func NewService(
 		operationRepository: operationRepository,
 		integrationClient:   integrationClient,
 		toolRepository:      toolRepository,
+		toolCipher:          toolCipher,
+		blockList:           blockList,
 		codeManager:         confirmation.NewCodeManager(smtpClient, isTest),
+		confirmationTTL:     confirmationTTL,
 		wrongCodeLimit:      wrongCodeLimit,
 	}
 }
+
+// ConfirmationExpiresAt возвращает время, до которого клиент может подтвердить выплату.
+func (s *Service) ConfirmationExpiresAt(op *model.Operation) time.Time {
+	return op.CreatedAt.Add(s.confirmationTTL.Get(op.ExternalSystem, op.ExternalMethod))
+}
@@ -18,7 +18,8 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPayoutData) err
 			}
 
 			if op.Status == model.OperationStatusSuccess {
-				slog.Warn(
+				slog.WarnContext(
+					ctx,
 					"duplicate payout success called",
 					"operation_id", op.ID,
 				)
@@ -33,11 +34,12 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPayoutData) err
 				return errors.New("got nil payout tool for SUCCESS operation")
 			}
 
-			if data.Tool.ID != op.ToolID {
+			// операция могла быть создана с идентификатором карты, который был у неё до перехода на отпечатки
+			if !data.Tool.IdentifiedBy(op.ToolID) {
 				return fmt.Errorf("operation tool %q differs from payout tool %q", op.ToolID, data.Tool.ID)
 			}
 
-			tool, err := s.toolRepository.GetOne(ctx, data.Tool.ID, data.Tool.UserID, data.Tool.ExternalMethod)
+			tool, err := s.toolRepository.GetOne(ctx, data.Tool.ID, data.Tool.MerchantID, data.Tool.UserID, data.Tool.ExternalMethod)
 			if err != nil {
 				return fmt.Errorf("get tool from db: %w", err)
 			}
@@ -58,7 +60,8 @@ func (s *Service) Success(ctx context.Context, data model.SuccessPayoutData) err
 			}
 
 			// TODO: осуществлять уведомление E-commerce системы
-			slog.Info(
+			slog.InfoContext(
+				ctx,
 				"ecommerce system has been notified successfully",
 				"operation_id", op.ID,
 			)
@@ -0,0 +1,187 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+const (
+	// externalIDsChunkSize ограничивает количество идентификаторов в одном запросе к БД
+	externalIDsChunkSize = 1000
+	// periodOperationMaxCount ограничивает количество успешных операций, сверяемых за один запуск
+	periodOperationMaxCount = 100000
+)
+
+type Repository interface {
+	Create(ctx context.Context, run *model.ReconciliationRun) error
+	All(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error)
+	GetOne(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error)
+}
+
+type OperationRepository interface {
+	All(ctx context.Context, criteria model.OperationCriteria) ([]*model.Operation, error)
+}
+
+type Service struct {
+	repository          Repository
+	operationRepository OperationRepository
+}
+
+func NewService(repository Repository, operationRepository OperationRepository) *Service {
+	return &Service{
+		repository:          repository,
+		operationRepository: operationRepository,
+	}
+}
+
+// Reconcile сверяет операции магазина data.MerchantID с реестром платежной системы. Операции других
+// магазинов в сверке не участвуют: реестр выгружается из кабинета конкретного магазина.
+func (s *Service) Reconcile(ctx context.Context, data model.ReconcileData) (*model.ReconciliationRun, error) {
+	externalSystems := []string{data.ExternalSystem}
+
+	registry := make(map[string]model.RegistryItem, len(data.Items))
+	externalIDs := make([]string, 0, len(data.Items))
+	for _, item := range data.Items {
+		if _, ok := registry[item.ExternalID]; !ok {
+			externalIDs = append(externalIDs, item.ExternalID)
+		}
+		registry[item.ExternalID] = item
+	}
+
+	// операции, упомянутые в реестре, ищем вне зависимости от периода сверки
+	operations := make(map[string]*model.Operation, len(externalIDs))
+	for start := 0; start < len(externalIDs); start += externalIDsChunkSize {
+		chunk := externalIDs[start:min(start+externalIDsChunkSize, len(externalIDs))]
+
+		ops, err := s.operationRepository.All(ctx, model.OperationCriteria{
+			MerchantID:      &data.MerchantID,
+			ExternalSystems: &externalSystems,
+			ExternalIDs:     &chunk,
+			MaxCount:        int64(len(chunk)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get operations by external ids: %w", err)
+		}
+
+		for _, op := range ops {
+			operations[op.ExternalID] = op
+		}
+	}
+
+	successOps, err := s.operationRepository.All(ctx, model.OperationCriteria{
+		MerchantID:      &data.MerchantID,
+		ExternalSystems: &externalSystems,
+		Statuses:        &[]model.OperationStatus{model.OperationStatusSuccess},
+		CreatedAtFrom:   data.PeriodFrom,
+		CreatedAtTo:     data.PeriodTo,
+		MaxCount:        periodOperationMaxCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get successful operations for period: %w", err)
+	}
+
+	run := &model.ReconciliationRun{
+		MerchantID:     data.MerchantID,
+		ExternalSystem: data.ExternalSystem,
+		PeriodFrom:     data.PeriodFrom,
+		PeriodTo:       data.PeriodTo,
+		RegistryCount:  int64(len(externalIDs)),
+	}
+
+	for _, op := range successOps {
+		if _, ok := registry[op.ExternalID]; ok {
+			continue
+		}
+		run.Discrepancies = append(run.Discrepancies, model.ReconciliationDiscrepancy{
+			Type:        model.ReconciliationDiscrepancyTypeMissing,
+			ExternalID:  op.ExternalID,
+			OperationID: op.ID,
+			Currency:    op.Currency,
+			Amount:      op.Amount,
+			Status:      op.Status,
+		})
+	}
+
+	for _, externalID := range externalIDs {
+		item := registry[externalID]
+
+		op, ok := operations[externalID]
+		if !ok {
+			run.Discrepancies = append(run.Discrepancies, model.ReconciliationDiscrepancy{
+				Type:             model.ReconciliationDiscrepancyTypeExtra,
+				ExternalID:       externalID,
+				RegistryCurrency: item.Currency,
+				RegistryAmount:   item.Amount,
+				RegistryStatus:   item.Status,
+			})
+			continue
+		}
+
+		run.MatchedCount++
+
+		discrepancy := model.ReconciliationDiscrepancy{
+			ExternalID:       externalID,
+			OperationID:      op.ID,
+			Currency:         op.Currency,
+			Amount:           op.Amount,
+			Status:           op.Status,
+			RegistryCurrency: item.Currency,
+			RegistryAmount:   item.Amount,
+			RegistryStatus:   item.Status,
+		}
+
+		if op.Amount != item.Amount || op.Currency != item.Currency {
+			discrepancy.Type = model.ReconciliationDiscrepancyTypeAmountMismatch
+			run.Discrepancies = append(run.Discrepancies, discrepancy)
+		}
+
+		if !statusMatches(op.Status, item.Status) {
+			discrepancy.Type = model.ReconciliationDiscrepancyTypeStatusMismatch
+			run.Discrepancies = append(run.Discrepancies, discrepancy)
+		}
+	}
+
+	run.DiscrepancyCount = int64(len(run.Discrepancies))
+
+	if err = s.repository.Create(ctx, run); err != nil {
+		return nil, fmt.Errorf("save reconciliation run: %w", err)
+	}
+
+	return run, nil
+}
+
+func (s *Service) All(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error) {
+	return s.repository.All(ctx, externalSystem, merchantID)
+}
+
+func (s *Service) GetOne(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error) {
+	run, err := s.repository.GetOne(ctx, id, merchantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, perror.NewInternal().WithCode(
+				perror.CodeObjectNotFound,
+			).WithDescription(
+				fmt.Sprintf("reconciliation run with id %v not found", id),
+			)
+		}
+		return nil, err
+	}
+	return run, nil
+}
+
+// statusMatches проверяет, соответствует ли внутренний статус операции её статусу в реестре ПС.
+func statusMatches(status model.OperationStatus, registryStatus model.OperationExternalStatus) bool {
+	switch registryStatus {
+	case model.OperationExternalStatusSuccess:
+		return status == model.OperationStatusSuccess
+	case model.OperationExternalStatusFailed:
+		return status == model.OperationStatusFailed
+	default:
+		return status != model.OperationStatusSuccess && status != model.OperationStatusFailed
+	}
+}
@@ -0,0 +1,107 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type OperationService interface {
+	GetOne(ctx context.Context, criteria model.OperationCriteria) (*model.Operation, error)
+	ChangeStatus(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) (model.OperationChangeStatusResult, error)
+}
+
+type PaymentService interface {
+	Success(ctx context.Context, data model.SuccessPaymentData) error
+	Fail(ctx context.Context, data model.FailPaymentData) error
+}
+
+type PayoutService interface {
+	Success(ctx context.Context, data model.SuccessPayoutData) error
+	Fail(ctx context.Context, data model.FailPayoutData) error
+}
+
+type ToolService interface {
+	GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error)
+}
+
+// Service переводит операцию в новое состояние, используя сценарии завершения платежей и выплат.
+type Service struct {
+	operationService OperationService
+	paymentService   PaymentService
+	payoutService    PayoutService
+	toolService      ToolService
+}
+
+func NewService(
+	operationService OperationService,
+	paymentService PaymentService,
+	payoutService PayoutService,
+	toolService ToolService,
+) *Service {
+	return &Service{
+		operationService: operationService,
+		paymentService:   paymentService,
+		payoutService:    payoutService,
+		toolService:      toolService,
+	}
+}
+
+// Change возвращает результат перехода: пустое значение означает, что переход не предусмотрен.
+func (s *Service) Change(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) (model.OperationChangeStatusResult, error) {
+	operation, err := s.operationService.GetOne(ctx, model.OperationCriteria{ID: &id})
+	if err != nil {
+		return "", err
+	}
+
+	result, err := s.operationService.ChangeStatus(ctx, id, newStatus, newExternalStatus)
+	if err != nil {
+		return "", err
+	}
+
+	switch result {
+	case model.OperationChangeStatusResultFailPayment:
+		err = s.paymentService.Fail(ctx, model.FailPaymentData{
+			ExternalID:     operation.ExternalID,
+			ExternalStatus: newExternalStatus,
+			FailReason:     model.OperationFailReasonManual,
+			OperationID:    operation.ID,
+		})
+	case model.OperationChangeStatusResultFailPayout:
+		err = s.payoutService.Fail(ctx, model.FailPayoutData{
+			ExternalID:     operation.ExternalID,
+			ExternalStatus: newExternalStatus,
+			FailReason:     model.OperationFailReasonManual,
+			OperationID:    operation.ID,
+		})
+	case model.OperationChangeStatusResultSuccessPayment:
+		err = s.paymentService.Success(ctx, model.SuccessPaymentData{
+			ProcessedAt:    time.Now(),
+			ExternalID:     operation.ExternalID,
+			ExternalStatus: operation.ExternalStatus,
+			OperationID:    operation.ID,
+			NewAmount:      operation.Amount,
+		})
+	case model.OperationChangeStatusResultSuccessPayout:
+		var tool *model.Tool
+		tool, err = s.toolService.GetOne(ctx, operation.ToolID, operation.MerchantID, operation.UserID, operation.ExternalMethod)
+		if err != nil {
+			return "", fmt.Errorf("get tool from db: %w", err)
+		}
+
+		err = s.payoutService.Success(ctx, model.SuccessPayoutData{
+			ProcessedAt:    time.Now(),
+			ExternalID:     operation.ExternalID,
+			ExternalStatus: newExternalStatus,
+			OperationID:    operation.ID,
+			Tool:           tool,
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
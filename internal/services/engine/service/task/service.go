@@ -0,0 +1,46 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type Repository interface {
+	Settings(ctx context.Context, name string) (model.SchedulerTaskSettings, error)
+	SetPaused(ctx context.Context, name string, isPaused bool) error
+	SetSchedule(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error
+	CreateRun(ctx context.Context, run *model.SchedulerTaskRun) error
+	Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error)
+}
+
+type Service struct {
+	repository Repository
+}
+
+func NewService(repository Repository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
+
+func (s *Service) Settings(ctx context.Context, name string) (model.SchedulerTaskSettings, error) {
+	return s.repository.Settings(ctx, name)
+}
+
+func (s *Service) SetPaused(ctx context.Context, name string, isPaused bool) error {
+	return s.repository.SetPaused(ctx, name, isPaused)
+}
+
+func (s *Service) SetSchedule(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error {
+	return s.repository.SetSchedule(ctx, name, interval, operationBatchSize)
+}
+
+func (s *Service) RecordRun(ctx context.Context, run *model.SchedulerTaskRun) error {
+	return s.repository.CreateRun(ctx, run)
+}
+
+func (s *Service) Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error) {
+	return s.repository.Runs(ctx, name, maxCount)
+}
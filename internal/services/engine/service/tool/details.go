@@ -0,0 +1,157 @@
+package tool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/encryption"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+// encryptedDetailsKey - ключ реквизитов, под которым хранится конверт с зашифрованными значениями.
+const encryptedDetailsKey = "encrypted"
+
+// defaultSensitiveDetails - реквизиты, которые шифруются, если в конфигурации не задан другой список
+var defaultSensitiveDetails = []string{"token"}
+
+// DetailsCipher шифрует чувствительные реквизиты платежного средства перед сохранением и расшифровывает их
+// только для запросов в интеграцию. Конверт привязан к магазину и пользователю: перенесенные в чужую строку
+// реквизиты не расшифруются.
+type DetailsCipher struct {
+	encrypter     *encryption.Encrypter
+	sensitiveKeys []string
+}
+
+// NewDetailsCipher создает шифрование реквизитов. Без encrypter реквизиты сохраняются открытыми,
+// но по-прежнему скрываются из ответов клиентам и специалистам.
+func NewDetailsCipher(encrypter *encryption.Encrypter, sensitiveKeys []string) *DetailsCipher {
+	if len(sensitiveKeys) == 0 {
+		sensitiveKeys = defaultSensitiveDetails
+	}
+	return &DetailsCipher{
+		encrypter:     encrypter,
+		sensitiveKeys: sensitiveKeys,
+	}
+}
+
+func (c *DetailsCipher) enabled() bool {
+	return c.encrypter != nil
+}
+
+// Seal переносит чувствительные реквизиты платежного средства в зашифрованный конверт.
+func (c *DetailsCipher) Seal(ctx context.Context, tool *model.Tool) error {
+	if c.encrypter == nil || tool == nil || len(tool.Details) == 0 {
+		return nil
+	}
+
+	secrets := make(map[string]any, len(c.sensitiveKeys))
+	for _, key := range c.sensitiveKeys {
+		if value, ok := tool.Details[key]; ok {
+			secrets[key] = value
+		}
+	}
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("marshalling tool secrets: %w", err)
+	}
+
+	envelope, err := c.encrypter.Seal(ctx, plaintext, detailsAAD(tool))
+	if err != nil {
+		return fmt.Errorf("encrypting tool secrets: %w", err)
+	}
+
+	details := make(map[string]any, len(tool.Details))
+	for key, value := range tool.Details {
+		if _, ok := secrets[key]; !ok {
+			details[key] = value
+		}
+	}
+	details[encryptedDetailsKey] = map[string]any{
+		"key_version":   envelope.KeyVersion,
+		"encrypted_key": envelope.EncryptedKey,
+		"ciphertext":    envelope.Ciphertext,
+	}
+	tool.Details = details
+
+	return nil
+}
+
+// Open возвращает в реквизиты платежного средства расшифрованные значения из конверта.
+// Платежные средства, сохраненные до включения шифрования, возвращаются без изменений.
+func (c *DetailsCipher) Open(ctx context.Context, tool *model.Tool) error {
+	if tool == nil {
+		return nil
+	}
+
+	raw, ok := tool.Details[encryptedDetailsKey].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if c.encrypter == nil {
+		return errors.New("tool details are encrypted, but encryption is disabled")
+	}
+
+	keyVersion, _ := raw["key_version"].(string)
+	encryptedKey, _ := raw["encrypted_key"].(string)
+	ciphertext, _ := raw["ciphertext"].(string)
+
+	plaintext, err := c.encrypter.Open(ctx, encryption.Envelope{
+		KeyVersion:   keyVersion,
+		EncryptedKey: encryptedKey,
+		Ciphertext:   ciphertext,
+	}, detailsAAD(tool))
+	if err != nil {
+		return fmt.Errorf("decrypting tool secrets: %w", err)
+	}
+
+	var secrets map[string]any
+	if err = json.Unmarshal(plaintext, &secrets); err != nil {
+		return fmt.Errorf("unmarshalling tool secrets: %w", err)
+	}
+
+	details := make(map[string]any, len(tool.Details)+len(secrets))
+	for key, value := range tool.Details {
+		if key != encryptedDetailsKey {
+			details[key] = value
+		}
+	}
+	for key, value := range secrets {
+		details[key] = value
+	}
+	tool.Details = details
+
+	return nil
+}
+
+// Conceal убирает из реквизитов конверт и чувствительные значения, в том числе открытые значения
+// платежных средств, сохраненных до включения шифрования.
+func (c *DetailsCipher) Conceal(tool *model.Tool) {
+	if tool == nil || len(tool.Details) == 0 {
+		return
+	}
+
+	details := make(map[string]any, len(tool.Details))
+	for key, value := range tool.Details {
+		details[key] = value
+	}
+	delete(details, encryptedDetailsKey)
+	for _, key := range c.sensitiveKeys {
+		delete(details, key)
+	}
+
+	tool.Details = nil
+	if len(details) > 0 {
+		tool.Details = details
+	}
+}
+
+func detailsAAD(tool *model.Tool) []byte {
+	return []byte(tool.MerchantID + "/" + tool.UserID)
+}
@@ -0,0 +1,166 @@
+package tool
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/encryption"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type staticKeyProvider struct{}
+
+func (staticKeyProvider) Current(ctx context.Context) (encryption.Key, error) {
+	return staticKeyProvider{}.Get(ctx, "1")
+}
+
+func (staticKeyProvider) Get(_ context.Context, version string) (encryption.Key, error) {
+	return encryption.Key{Version: version, Material: bytes.Repeat([]byte{1}, encryption.KeySize)}, nil
+}
+
+func TestDetailsCipherSealOpen(t *testing.T) {
+	tests := []struct {
+		name          string
+		sensitiveKeys []string
+		details       map[string]any
+		wantSealed    bool
+	}{
+		{
+			name:       "default sensitive keys",
+			details:    map[string]any{"token": "tok_1", "last4": "4242"},
+			wantSealed: true,
+		},
+		{
+			name:          "configured sensitive keys",
+			sensitiveKeys: []string{"token", "holder"},
+			details:       map[string]any{"token": "tok_1", "holder": "IVAN IVANOV", "last4": "4242"},
+			wantSealed:    true,
+		},
+		{
+			name:       "no sensitive details",
+			details:    map[string]any{"last4": "4242"},
+			wantSealed: false,
+		},
+		{
+			name:       "empty details",
+			details:    map[string]any{},
+			wantSealed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewDetailsCipher(encryption.NewEncrypter(staticKeyProvider{}), tt.sensitiveKeys)
+			tool := &model.Tool{MerchantID: "shop", UserID: "1", Details: maps.Clone(tt.details)}
+
+			if err := c.Seal(context.Background(), tool); err != nil {
+				t.Fatalf("Seal() error = %v", err)
+			}
+
+			_, sealed := tool.Details[encryptedDetailsKey]
+			if sealed != tt.wantSealed {
+				t.Fatalf("Seal() sealed = %v, want %v", sealed, tt.wantSealed)
+			}
+			for _, key := range c.sensitiveKeys {
+				if _, ok := tool.Details[key]; ok {
+					t.Errorf("Seal() left sensitive detail %q in plain text", key)
+				}
+			}
+
+			if err := c.Open(context.Background(), tool); err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+			if !maps.Equal(tool.Details, tt.details) {
+				t.Errorf("Open() details = %v, want %v", tool.Details, tt.details)
+			}
+		})
+	}
+}
+
+func TestDetailsCipherOpen(t *testing.T) {
+	enabled := NewDetailsCipher(encryption.NewEncrypter(staticKeyProvider{}), nil)
+	disabled := NewDetailsCipher(nil, nil)
+
+	sealed := func(merchantID, userID string) *model.Tool {
+		tool := &model.Tool{MerchantID: merchantID, UserID: userID, Details: map[string]any{"token": "tok_1"}}
+		if err := enabled.Seal(context.Background(), tool); err != nil {
+			t.Fatalf("Seal() error = %v", err)
+		}
+		return tool
+	}
+
+	tests := []struct {
+		name        string
+		cipher      *DetailsCipher
+		tool        func() *model.Tool
+		wantErr     bool
+		wantDetails map[string]any
+	}{
+		{
+			name:        "same owner",
+			cipher:      enabled,
+			tool:        func() *model.Tool { return sealed("shop", "1") },
+			wantDetails: map[string]any{"token": "tok_1"},
+		},
+		{
+			name:   "moved to another user",
+			cipher: enabled,
+			tool: func() *model.Tool {
+				tool := sealed("shop", "1")
+				tool.UserID = "2"
+				return tool
+			},
+			wantErr: true,
+		},
+		{
+			name:   "moved to another merchant",
+			cipher: enabled,
+			tool: func() *model.Tool {
+				tool := sealed("shop", "1")
+				tool.MerchantID = "other"
+				return tool
+			},
+			wantErr: true,
+		},
+		{
+			name:        "saved before encryption",
+			cipher:      enabled,
+			tool:        func() *model.Tool { return &model.Tool{Details: map[string]any{"token": "tok_1"}} },
+			wantDetails: map[string]any{"token": "tok_1"},
+		},
+		{
+			name:    "encrypted but encryption disabled",
+			cipher:  disabled,
+			tool:    func() *model.Tool { return sealed("shop", "1") },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool := tt.tool()
+
+			err := tt.cipher.Open(context.Background(), tool)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Open() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !maps.Equal(tool.Details, tt.wantDetails) {
+				t.Errorf("Open() details = %v, want %v", tool.Details, tt.wantDetails)
+			}
+		})
+	}
+}
+
+func TestDetailsCipherSealDisabled(t *testing.T) {
+	c := NewDetailsCipher(nil, nil)
+	tool := &model.Tool{Details: map[string]any{"token": "tok_1"}}
+
+	if err := c.Seal(context.Background(), tool); err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if !maps.Equal(tool.Details, map[string]any{"token": "tok_1"}) {
+		t.Errorf("Seal() details = %v, want unchanged", tool.Details)
+	}
+}
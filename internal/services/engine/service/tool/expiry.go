@@ -0,0 +1,66 @@
+package tool
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+// ExpireOverdue переводит в статус EXPIRED платежные средства с истекшим сроком действия.
+func (s *Service) ExpireOverdue(ctx context.Context, now time.Time) (int64, error) {
+	return s.repository.ExpireOverdue(ctx, now)
+}
+
+// ClaimExpiring возвращает платежные средства, о скором окончании срока действия которых клиент еще не уведомлен.
+func (s *Service) ClaimExpiring(ctx context.Context, before time.Time, maxCount int64) ([]*model.Tool, error) {
+	return s.repository.ClaimExpiring(ctx, before, maxCount)
+}
+
+// NotifyExpiry отправляет клиенту письмо о скором окончании срока действия платежного средства на адрес,
+// указанный им в последней операции. Если письмо не отправлено, уведомление будет повторено при следующем запуске.
+func (s *Service) NotifyExpiry(ctx context.Context, tool *model.Tool) error {
+	email, err := s.operationRepository.LastEmail(ctx, tool.MerchantID, tool.UserID)
+	if err != nil {
+		return s.resetExpiryNotification(ctx, tool, fmt.Errorf("get user email from db: %w", err))
+	}
+
+	if email == "" {
+		slog.InfoContext(ctx, "user has no email to notify about tool expiry",
+			"tool_id", tool.ID,
+			"merchant_id", tool.MerchantID,
+			"user_id", tool.UserID,
+		)
+		return nil
+	}
+
+	expiry := tool.ExpiresAt.AddDate(0, 0, -1).Format("01/2006")
+
+	subject := "Срок действия карты заканчивается / Your card is expiring"
+	message := fmt.Sprintf(
+		"Срок действия сохраненной карты %v заканчивается %v. Добавьте новую карту, чтобы продолжить оплачивать покупки.<br><br>"+
+			"Your saved card %v expires in %v. Please add a new card to keep paying without interruptions.",
+		tool.Displayed, expiry, tool.Displayed, expiry,
+	)
+
+	if err = s.smtpClient.SendEmail(email, subject, message); err != nil {
+		return s.resetExpiryNotification(ctx, tool, fmt.Errorf("send tool expiry email: %w", err))
+	}
+
+	slog.InfoContext(ctx, "tool expiry notification sent",
+		"tool_id", tool.ID,
+		"merchant_id", tool.MerchantID,
+		"user_id", tool.UserID,
+	)
+
+	return nil
+}
+
+func (s *Service) resetExpiryNotification(ctx context.Context, tool *model.Tool, cause error) error {
+	if err := s.repository.ResetExpiryNotification(ctx, tool); err != nil {
+		return fmt.Errorf("%w; reset expiry notification: %v", cause, err)
+	}
+	return cause
+}
@@ -5,30 +5,58 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sort"
+	"time"
 
 	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
 type Repository interface {
-	All(ctx context.Context, userID string) ([]*model.Tool, error)
-	GetOne(ctx context.Context, id string, userID string, externalMethod string) (*model.Tool, error)
+	All(ctx context.Context, merchantID, userID string) ([]*model.Tool, error)
+	GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error)
 	Update(ctx context.Context, tool *model.Tool) error
+	ExpireOverdue(ctx context.Context, now time.Time) (int64, error)
+	ClaimExpiring(ctx context.Context, before time.Time, maxCount int64) ([]*model.Tool, error)
+	ResetExpiryNotification(ctx context.Context, tool *model.Tool) error
+	Unsealed(ctx context.Context, sensitiveKeys []string, maxCount int64) ([]*model.Tool, error)
+	UpdateDetails(ctx context.Context, tool *model.Tool, sensitiveKeys []string) error
+	PendingFingerprint(ctx context.Context) ([]*model.Tool, error)
+	SetFingerprint(ctx context.Context, tool *model.Tool, fingerprint string) error
+}
+
+type OperationRepository interface {
+	LastEmail(ctx context.Context, merchantID, userID string) (string, error)
+}
+
+type SMTPClient interface {
+	SendEmail(to, subject, body string) error
 }
 
 type Service struct {
-	repository Repository
+	repository          Repository
+	detailsCipher       *DetailsCipher
+	operationRepository OperationRepository
+	smtpClient          SMTPClient
 }
 
-func NewService(repository Repository) *Service {
+func NewService(
+	repository Repository,
+	detailsCipher *DetailsCipher,
+	operationRepository OperationRepository,
+	smtpClient SMTPClient,
+) *Service {
 	return &Service{
-		repository: repository,
+		repository:          repository,
+		detailsCipher:       detailsCipher,
+		operationRepository: operationRepository,
+		smtpClient:          smtpClient,
 	}
 }
 
-func (s *Service) All(ctx context.Context, userID string) ([]*model.Tool, error) {
-	tools, err := s.repository.All(ctx, userID)
+func (s *Service) All(ctx context.Context, merchantID, userID string) ([]*model.Tool, error) {
+	tools, err := s.repository.All(ctx, merchantID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -42,16 +70,16 @@ func (s *Service) All(ctx context.Context, userID string) ([]*model.Tool, error)
 	return tools, nil
 }
 
-func (s *Service) EditOne(ctx context.Context, id string, userID string, externalMethod, name string) (*model.Tool, error) {
-	tool, err := s.repository.GetOne(ctx, id, userID, externalMethod)
+func (s *Service) EditOne(ctx context.Context, id, merchantID, userID, externalMethod, name string) (*model.Tool, error) {
+	tool, err := s.repository.GetOne(ctx, id, merchantID, userID, externalMethod)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, perror.NewInternal().WithCode(
 				perror.CodeObjectNotFound,
 			).WithDescription(
 				fmt.Sprintf(
-					"tool with id %q, user id %v and external method %q not found",
-					id, userID, externalMethod,
+					"tool with id %q, merchant id %q, user id %v and external method %q not found",
+					id, merchantID, userID, externalMethod,
 				),
 			)
 		}
@@ -66,29 +94,27 @@ func (s *Service) EditOne(ctx context.Context, id string, userID string, externa
 		)
 	}
 
-	if tool.Name == name {
-		return tool, nil
-	}
+	if tool.Name != name {
+		tool.Name = name
 
-	tool.Name = name
-
-	if err = s.repository.Update(ctx, tool); err != nil {
-		return nil, err
+		if err = s.repository.Update(ctx, tool); err != nil {
+			return nil, err
+		}
 	}
 
 	return tool, nil
 }
 
-func (s *Service) RemoveOne(ctx context.Context, id string, userID string, externalMethod string, actionSource model.ActionSource) error {
-	tool, err := s.repository.GetOne(ctx, id, userID, externalMethod)
+func (s *Service) RemoveOne(ctx context.Context, id, merchantID, userID, externalMethod string, actionSource model.ActionSource) error {
+	tool, err := s.repository.GetOne(ctx, id, merchantID, userID, externalMethod)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return perror.NewInternal().WithCode(
 				perror.CodeObjectNotFound,
 			).WithDescription(
 				fmt.Sprintf(
-					"tool with id %q, user id %v and external method %q not found",
-					id, userID, externalMethod,
+					"tool with id %q, merchant id %q, user id %v and external method %q not found",
+					id, merchantID, userID, externalMethod,
 				),
 			)
 		}
@@ -115,16 +141,16 @@ func (s *Service) RemoveOne(ctx context.Context, id string, userID string, exter
 	return s.repository.Update(ctx, tool)
 }
 
-func (s *Service) RecoverOne(ctx context.Context, id string, userID string, externalMethod string) error {
-	tool, err := s.repository.GetOne(ctx, id, userID, externalMethod)
+func (s *Service) RecoverOne(ctx context.Context, id, merchantID, userID, externalMethod string) error {
+	tool, err := s.repository.GetOne(ctx, id, merchantID, userID, externalMethod)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return perror.NewInternal().WithCode(
 				perror.CodeObjectNotFound,
 			).WithDescription(
 				fmt.Sprintf(
-					"tool with id %q, user id %v and external method %q not found",
-					id, userID, externalMethod,
+					"tool with id %q, merchant id %q, user id %v and external method %q not found",
+					id, merchantID, userID, externalMethod,
 				),
 			)
 		}
@@ -148,6 +174,68 @@ func (s *Service) RecoverOne(ctx context.Context, id string, userID string, exte
 	return s.repository.Update(ctx, tool)
 }
 
-func (s *Service) GetOne(ctx context.Context, id string, userID string, externalMethod string) (*model.Tool, error) {
-	return s.repository.GetOne(ctx, id, userID, externalMethod)
+// GetOne возвращает платежное средство вместе с чувствительными реквизитами; перед ответом клиентам
+// и специалистам их нужно скрыть методом Conceal.
+func (s *Service) GetOne(ctx context.Context, id, merchantID, userID, externalMethod string) (*model.Tool, error) {
+	return s.repository.GetOne(ctx, id, merchantID, userID, externalMethod)
+}
+
+// Conceal убирает из реквизитов платежного средства чувствительные значения перед ответом клиентам и специалистам.
+func (s *Service) Conceal(tool *model.Tool) {
+	s.detailsCipher.Conceal(tool)
+}
+
+// Unsealed возвращает не больше maxCount платежных средств, реквизиты которых еще хранятся открытыми.
+// Если шифрование выключено, шифровать нечего.
+func (s *Service) Unsealed(ctx context.Context, maxCount int64) ([]*model.Tool, error) {
+	if !s.detailsCipher.enabled() {
+		return nil, nil
+	}
+	return s.repository.Unsealed(ctx, s.detailsCipher.sensitiveKeys, maxCount)
+}
+
+// SealOne шифрует открытые реквизиты платежного средства. Уже зашифрованные значения предварительно
+// расшифровываются, чтобы попасть в новый конверт вместе с открытыми.
+func (s *Service) SealOne(ctx context.Context, tool *model.Tool) error {
+	if err := s.detailsCipher.Open(ctx, tool); err != nil {
+		return err
+	}
+	if err := s.detailsCipher.Seal(ctx, tool); err != nil {
+		return err
+	}
+	return s.repository.UpdateDetails(ctx, tool, s.detailsCipher.sensitiveKeys)
+}
+
+// MigrateFingerprints вычисляет отпечатки банковских карт, реквизиты которых были зашифрованы до перехода
+// на отпечатки, и заменяет ими прежние идентификаторы. Без этого успешные операции с такими картами
+// не сопоставляются с сохраненными картами, поэтому сервис не должен начинать работу при ошибке.
+func (s *Service) MigrateFingerprints(ctx context.Context) error {
+	tools, err := s.repository.PendingFingerprint(ctx)
+	if err != nil {
+		return fmt.Errorf("get tools pending fingerprint: %w", err)
+	}
+
+	for _, tool := range tools {
+		if err = s.detailsCipher.Open(ctx, tool); err != nil {
+			return fmt.Errorf("decrypt details of tool %q: %w", tool.ID, err)
+		}
+
+		token, _ := tool.Details["token"].(string)
+		if token == "" {
+			return fmt.Errorf("tool %q has no token to compute fingerprint", tool.ID)
+		}
+
+		if err = s.repository.SetFingerprint(ctx, tool, model.CardFingerprint(token)); err != nil {
+			return fmt.Errorf("set fingerprint of tool %q: %w", tool.ID, err)
+		}
+	}
+
+	if len(tools) > 0 {
+		slog.Default().InfoContext(
+			ctx,
+			"tool fingerprints have been migrated",
+			"count", len(tools),
+		)
+	}
+	return nil
 }
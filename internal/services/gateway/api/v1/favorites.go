@@ -42,7 +42,7 @@ type favoritesResponse struct {
 //	@Failure	default			{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/favorites/{operation_type} [post]
 func (h *Handler) favoritesAdd(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req favoritesRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -58,6 +58,7 @@ func (h *Handler) favoritesAdd(c *fiber.Ctx) error {
 		Currency:       req.Currency,
 		ExternalSystem: req.ExternalSystem,
 		ExternalMethod: req.ExternalMethod,
+		MerchantID:     merchantID(c),
 		UserID:         req.UserID,
 	}
 
@@ -89,7 +90,7 @@ func (h *Handler) favoritesAdd(c *fiber.Ctx) error {
 //	@Failure	default			{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/favorites/{operation_type} [delete]
 func (h *Handler) favoritesRemove(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req favoritesRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -105,6 +106,7 @@ func (h *Handler) favoritesRemove(c *fiber.Ctx) error {
 		Currency:       req.Currency,
 		ExternalSystem: req.ExternalSystem,
 		ExternalMethod: req.ExternalMethod,
+		MerchantID:     merchantID(c),
 		UserID:         req.UserID,
 	}
 
@@ -11,12 +11,24 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/recover"
 
 	_ "github.com/tmrrwnxtsn/ecomway/api/swagger/gateway/v1" // generated by Swag CLI, you have to import it
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/middleware"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/ratelimit"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+)
+
+// Области доступа ключей клиентов.
+const (
+	scopePayment   = "payment"
+	scopePayout    = "payout"
+	scopeTool      = "tool"
+	scopeFavorites = "favorites"
+	scopeOperation = "operation"
 )
 
 type MethodService interface {
-	AvailableMethods(ctx context.Context, opType model.OperationType, userID string, currency string) ([]model.Method, error)
+	AvailableMethods(ctx context.Context, opType model.OperationType, merchantID, userID string, currency string) ([]model.Method, error)
 }
 
 type PaymentService interface {
@@ -24,16 +36,17 @@ type PaymentService interface {
 }
 
 type ToolService interface {
-	AvailableTools(ctx context.Context, userID string) ([]*model.Tool, error)
-	AvailableToolsGroupedByMethod(ctx context.Context, userID string) (map[string][]*model.Tool, error)
-	EditTool(ctx context.Context, id string, userID string, externalMethod, name string) (*model.Tool, error)
-	RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error
+	AvailableTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error)
+	AvailableToolsGroupedByMethod(ctx context.Context, merchantID, userID string) (map[string][]*model.Tool, error)
+	EditTool(ctx context.Context, id string, merchantID, userID string, externalMethod, name string) (*model.Tool, error)
+	RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string) error
 }
 
 type PayoutService interface {
 	Create(ctx context.Context, data model.CreatePayoutData) (model.CreatePayoutResult, error)
 	Confirm(ctx context.Context, data model.ConfirmPayoutData) error
-	ResendCode(ctx context.Context, opID int64, userID string, langCode string) error
+	ResendCode(ctx context.Context, opID int64, merchantID, userID string, langCode string) error
+	Cancel(ctx context.Context, opID int64, merchantID, userID string) error
 }
 
 type FavoritesService interface {
@@ -53,6 +66,18 @@ type SummaryService interface {
 	CalculateReportOperationsSummary(items []model.ReportOperation) (totalAmount float64, totalCount int64)
 }
 
+type RequestVerifier interface {
+	Verify(ctx context.Context, request auth.Request) (auth.Principal, error)
+}
+
+type SessionVerifier interface {
+	Verify(ctx context.Context, sessionID string) (session.Session, error)
+}
+
+type RateLimiter interface {
+	Take(ctx context.Context, requests ...ratelimit.Request) (ratelimit.Result, error)
+}
+
 type Translator interface {
 	Translate(lang, key string, args ...any) string
 }
@@ -68,7 +93,10 @@ type Handler struct {
 	summaryService   SummaryService
 	translator       Translator
 	validate         *validator.Validate
-	apiKey           string
+	requestVerifier  RequestVerifier
+	sessionVerifier  SessionVerifier
+	rateLimiter      RateLimiter
+	rateLimits       ratelimit.Config
 }
 
 type HandlerOptions struct {
@@ -81,7 +109,11 @@ type HandlerOptions struct {
 	SortingService   SortingService
 	SummaryService   SummaryService
 	Translator       Translator
-	APIKey           string
+	RequestVerifier  RequestVerifier
+	SessionVerifier  SessionVerifier
+	RateLimiter      RateLimiter
+	// RateLimits - ограничения частоты запросов по группам маршрутов
+	RateLimits ratelimit.Config
 }
 
 // NewHandler godoc
@@ -98,7 +130,7 @@ type HandlerOptions struct {
 //	@securityDefinitions.apikey	ApiKeyAuth
 //	@in							header
 //	@name						Authorization
-//	@description				Секретный ключ
+//	@description				Статический секретный ключ (режим совместимости). Основной способ аутентификации - подпись запроса: заголовки X-Api-Key-Id (идентификатор ключа), X-Api-Timestamp (время отправки в формате UNIX Timestamp) и X-Api-Signature (HMAC-SHA256 в шестнадцатеричном виде от строки "<timestamp>\n<method>\n<uri>\n<body>")
 func NewHandler(opts HandlerOptions) *Handler {
 	validate := validator.New()
 	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
@@ -126,7 +158,10 @@ func NewHandler(opts HandlerOptions) *Handler {
 		summaryService:   opts.SummaryService,
 		translator:       opts.Translator,
 		validate:         validate,
-		apiKey:           opts.APIKey,
+		requestVerifier:  opts.RequestVerifier,
+		sessionVerifier:  opts.SessionVerifier,
+		rateLimiter:      opts.RateLimiter,
+		rateLimits:       opts.RateLimits,
 	}
 }
 
@@ -139,37 +174,39 @@ func (h *Handler) Init(router fiber.Router) {
 		apiV1.Use(middleware.NewAccessLog())
 		apiV1.Use(recover.New())
 		apiV1.Use(h.authorizationMiddleware())
+		apiV1.Use(h.sessionMiddleware())
 	}
 
 	{
-		payment := apiV1.Group("/payment")
+		payment := apiV1.Group("/payment", h.scopeMiddleware(scopePayment), h.rateLimitMiddleware(scopePayment))
 		{
 			payment.Get("/methods", h.paymentMethods)
-			payment.Post("/create", h.paymentCreate)
+			payment.Post("/create", h.rateLimitMiddleware(rateLimitGroupPaymentCreate), h.paymentCreate)
 		}
 
-		payout := apiV1.Group("/payout")
+		payout := apiV1.Group("/payout", h.scopeMiddleware(scopePayout), h.rateLimitMiddleware(scopePayout))
 		{
 			payout.Get("/methods", h.payoutMethods)
-			payout.Post("/create", h.payoutCreate)
+			payout.Post("/create", h.rateLimitMiddleware(rateLimitGroupPayoutCreate), h.payoutCreate)
 			payout.Put("/:id/confirm", h.payoutConfirm)
-			payout.Put("/:id/resend-code", h.payoutResendCode)
+			payout.Put("/:id/resend-code", h.rateLimitMiddleware(rateLimitGroupPayoutResendCode), h.payoutResendCode)
+			payout.Put("/:id/cancel", h.payoutCancel)
 		}
 
-		tools := apiV1.Group("/tool")
+		tools := apiV1.Group("/tool", h.scopeMiddleware(scopeTool), h.rateLimitMiddleware(scopeTool))
 		{
 			tools.Get("", h.toolList)
 			tools.Put("/edit", h.toolEdit)
 			tools.Delete("/remove", h.toolRemove)
 		}
 
-		favorites := apiV1.Group("/favorites/:operation_type")
+		favorites := apiV1.Group("/favorites/:operation_type", h.scopeMiddleware(scopeFavorites), h.rateLimitMiddleware(scopeFavorites))
 		{
 			favorites.Post("", h.favoritesAdd)
 			favorites.Delete("", h.favoritesRemove)
 		}
 
-		operations := apiV1.Group("/operation")
+		operations := apiV1.Group("/operation", h.scopeMiddleware(scopeOperation), h.rateLimitMiddleware(scopeOperation))
 		{
 			operations.Get("", h.operationList)
 		}
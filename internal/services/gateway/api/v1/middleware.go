@@ -1,29 +1,167 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
+	"net/netip"
 
 	"github.com/gofiber/fiber/v2"
 
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/translate"
 )
 
+const (
+	principalLocalsKey = "principal"
+	sessionLocalsKey   = "session"
+	langCodeLocalsKey  = "lang_code"
+)
+
 func (h *Handler) authorizationMiddleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		if c.Get(fiber.HeaderAuthorization) != h.apiKey {
-			return h.authorizationFailedErrorResponse(c)
+		principal, err := h.requestVerifier.Verify(c.UserContext(), auth.Request{
+			KeyID:         c.Get(auth.HeaderKeyID),
+			Timestamp:     c.Get(auth.HeaderTimestamp),
+			Signature:     c.Get(auth.HeaderSignature),
+			Authorization: c.Get(fiber.HeaderAuthorization),
+			Method:        c.Method(),
+			URI:           c.OriginalURL(),
+			Body:          c.Body(),
+		})
+		if err != nil {
+			if errors.Is(err, auth.ErrUnauthenticated) || errors.Is(err, auth.ErrReplayed) {
+				return h.authorizationFailedErrorResponse(c, err)
+			}
+			return h.internalErrorResponse(c, "", err)
 		}
+
+		c.Locals(principalLocalsKey, principal)
+
 		return c.Next()
 	}
 }
 
-func (h *Handler) authorizationFailedErrorResponse(c *fiber.Ctx) error {
+// sessionParams - параметры клиента, общие для всех запросов к API.
+type sessionParams struct {
+	UserID    string `json:"user_id" query:"user_id"`
+	SessionID string `json:"session_id" query:"session_id"`
+	LangCode  string `json:"lang_code" query:"lang_code"`
+}
+
+// sessionMiddleware проверяет сессию клиента и её принадлежность пользователю user_id до обращения к движку.
+// Параметры разбираются так же, как в обработчиках, чтобы формат тела запроса не позволял обойти проверку.
+// Запросы без user_id пропускаются дальше: их отклонит валидация обработчика.
+func (h *Handler) sessionMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params sessionParams
+		if c.Method() == fiber.MethodGet {
+			if err := c.QueryParser(&params); err != nil {
+				return h.requestValidationErrorResponse(c, params.LangCode, err)
+			}
+		} else {
+			if err := c.BodyParser(&params); err != nil {
+				return h.requestValidationErrorResponse(c, params.LangCode, err)
+			}
+		}
+
+		c.Locals(langCodeLocalsKey, params.LangCode)
+
+		if params.UserID == "" {
+			return c.Next()
+		}
+
+		if params.SessionID == "" {
+			return h.invalidSessionErrorResponse(c, params.LangCode, errors.New("session_id is required"))
+		}
+
+		verified, err := h.sessionVerifier.Verify(c.UserContext(), params.SessionID)
+		if err != nil {
+			if errors.Is(err, session.ErrInvalidSession) {
+				return h.invalidSessionErrorResponse(c, params.LangCode, err)
+			}
+			return h.internalErrorResponse(c, params.LangCode, err)
+		}
+
+		if verified.Subject != params.UserID {
+			return h.sessionUserMismatchErrorResponse(c, params.LangCode)
+		}
+
+		c.Locals(sessionLocalsKey, verified)
+
+		return c.Next()
+	}
+}
+
+// merchantID возвращает магазин, от имени которого действует клиент; клиенту без привязки
+// к магазину соответствует магазин по умолчанию.
+func merchantID(c *fiber.Ctx) string {
+	principal, _ := c.Locals(principalLocalsKey).(auth.Principal)
+	return model.MerchantIDOrDefault(principal.MerchantID)
+}
+
+// clientIP возвращает IP-адрес клиента. За балансировщиком адрес берется из его заголовка, только если запрос
+// пришел с доверенного адреса (см. настройки proxy); некорректное значение заменяется пустым.
+func clientIP(c *fiber.Ctx) string {
+	addr, err := netip.ParseAddr(c.IP())
+	if err != nil {
+		return ""
+	}
+	return addr.Unmap().String()
+}
+
+// scopeMiddleware пропускает запрос, только если ключу клиента разрешена область доступа scope.
+func (h *Handler) scopeMiddleware(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		principal, _ := c.Locals(principalLocalsKey).(auth.Principal)
+		if !principal.HasScope(scope) {
+			return h.insufficientScopeErrorResponse(c, scope)
+		}
+		return c.Next()
+	}
+}
+
+func (h *Handler) authorizationFailedErrorResponse(c *fiber.Ctx, err error) error {
 	return c.Status(http.StatusUnauthorized).JSON(&errorResponse{
 		Success: false,
 		Error: errorContent{
 			Code:        errorCodeInvalidAPIKey,
-			Description: "Authorization header does not contain valid API Key",
+			Description: err.Error(),
 			Message:     h.translator.Translate("", translate.KeyInvalidAPIKey),
 		},
 	})
 }
+
+func (h *Handler) insufficientScopeErrorResponse(c *fiber.Ctx, scope string) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInsufficientScope,
+			Description: "API key does not have required scope: " + scope,
+			Message:     h.translator.Translate("", translate.KeyInsufficientScope),
+		},
+	})
+}
+
+func (h *Handler) invalidSessionErrorResponse(c *fiber.Ctx, langCode string, err error) error {
+	return c.Status(http.StatusUnauthorized).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInvalidSession,
+			Description: err.Error(),
+			Message:     h.translator.Translate(langCode, translate.KeyInvalidSession),
+		},
+	})
+}
+
+func (h *Handler) sessionUserMismatchErrorResponse(c *fiber.Ctx, langCode string) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeSessionUserMismatch,
+			Description: "session does not belong to user_id",
+			Message:     h.translator.Translate(langCode, translate.KeySessionUserMismatch),
+		},
+	})
+}
@@ -84,7 +84,7 @@ type operationListResponse struct {
 //	@Failure	default			{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/operation [get]
 func (h *Handler) operationList(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req operationListRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -95,7 +95,7 @@ func (h *Handler) operationList(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	criteria, err := operationListCriteriaFromRequest(req)
+	criteria, err := operationListCriteriaFromRequest(merchantID(c), req)
 	if err != nil {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
@@ -150,9 +150,10 @@ func (h *Handler) operations(items []model.ReportOperation) []operation {
 	return operations
 }
 
-func operationListCriteriaFromRequest(req operationListRequest) (model.OperationCriteria, error) {
+func operationListCriteriaFromRequest(merchantID string, req operationListRequest) (model.OperationCriteria, error) {
 	criteria := model.OperationCriteria{
-		UserID: &req.UserID,
+		MerchantID: &merchantID,
+		UserID:     &req.UserID,
 	}
 
 	if req.ID > 0 {
@@ -43,7 +43,7 @@ type paymentMethodsResponse struct {
 //	@Failure	default		{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/payment/methods [get]
 func (h *Handler) paymentMethods(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req paymentMethodsRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -54,12 +54,12 @@ func (h *Handler) paymentMethods(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	methods, err := h.methodService.AvailableMethods(ctx, model.OperationTypePayment, req.UserID, req.Currency)
+	methods, err := h.methodService.AvailableMethods(ctx, model.OperationTypePayment, merchantID(c), req.UserID, req.Currency)
 	if err != nil {
 		return h.internalErrorResponse(c, req.LangCode, err)
 	}
 
-	toolsGrouped, err := h.toolService.AvailableToolsGroupedByMethod(ctx, req.UserID)
+	toolsGrouped, err := h.toolService.AvailableToolsGroupedByMethod(ctx, merchantID(c), req.UserID)
 	if err != nil {
 		return h.internalErrorResponse(c, req.LangCode, err)
 	}
@@ -136,7 +136,7 @@ type paymentCreateResponse struct {
 //	@Failure	default	{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/payment/create [post]
 func (h *Handler) paymentCreate(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req paymentCreateRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -154,8 +154,10 @@ func (h *Handler) paymentCreate(c *fiber.Ctx) error {
 		ExternalMethod: req.ExternalMethod,
 		Currency:       req.Currency,
 		LangCode:       req.LangCode,
+		MerchantID:     merchantID(c),
 		UserID:         req.UserID,
 		ToolID:         req.ToolID,
+		ClientIP:       clientIP(c),
 		Amount:         req.Amount,
 	}
 
@@ -169,6 +171,10 @@ func (h *Handler) paymentCreate(c *fiber.Ctx) error {
 					return h.objectNotFoundErrorResponse(c, req.LangCode, perr)
 				case perror.CodeToolHasBeenRemoved:
 					return h.forbiddenOnRemovedToolErrorResponse(c, req.LangCode, perr)
+				case perror.CodeToolHasExpired:
+					return h.forbiddenOnExpiredToolErrorResponse(c, req.LangCode, perr)
+				case perror.CodeBlocked:
+					return h.blockedErrorResponse(c, req.LangCode, perr)
 				}
 			}
 		}
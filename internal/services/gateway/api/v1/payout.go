@@ -44,7 +44,7 @@ type payoutMethodsResponse struct {
 //	@Failure	default		{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/payout/methods [get]
 func (h *Handler) payoutMethods(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req payoutMethodsRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -55,12 +55,12 @@ func (h *Handler) payoutMethods(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	methods, err := h.methodService.AvailableMethods(ctx, model.OperationTypePayout, req.UserID, req.Currency)
+	methods, err := h.methodService.AvailableMethods(ctx, model.OperationTypePayout, merchantID(c), req.UserID, req.Currency)
 	if err != nil {
 		return h.internalErrorResponse(c, req.LangCode, err)
 	}
 
-	toolsGrouped, err := h.toolService.AvailableToolsGroupedByMethod(ctx, req.UserID)
+	toolsGrouped, err := h.toolService.AvailableToolsGroupedByMethod(ctx, merchantID(c), req.UserID)
 	if err != nil {
 		return h.internalErrorResponse(c, req.LangCode, err)
 	}
@@ -99,6 +99,8 @@ type payoutCreateResponse struct {
 	Success bool `json:"success" example:"true" validate:"required"`
 	// Идентификатор созданной выплаты
 	OperationID int64 `json:"operation_id" example:"1" validate:"required"`
+	// Время в формате UNIX Timestamp, до которого необходимо подтвердить выплату
+	ConfirmationExpiresAt int64 `json:"confirmation_expires_at" example:"1716974447" validate:"required"`
 }
 
 // payoutCreate godoc
@@ -113,7 +115,7 @@ type payoutCreateResponse struct {
 //	@Failure	default	{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/payout/create [post]
 func (h *Handler) payoutCreate(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req payoutCreateRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -130,8 +132,10 @@ func (h *Handler) payoutCreate(c *fiber.Ctx) error {
 		ExternalMethod: req.ExternalMethod,
 		Currency:       req.Currency,
 		LangCode:       req.LangCode,
+		MerchantID:     merchantID(c),
 		UserID:         req.UserID,
 		ToolID:         req.ToolID,
+		ClientIP:       clientIP(c),
 		Amount:         req.Amount,
 	}
 
@@ -145,6 +149,10 @@ func (h *Handler) payoutCreate(c *fiber.Ctx) error {
 					return h.objectNotFoundErrorResponse(c, req.LangCode, perr)
 				case perror.CodeToolHasBeenRemoved:
 					return h.forbiddenOnRemovedToolErrorResponse(c, req.LangCode, perr)
+				case perror.CodeToolHasExpired:
+					return h.forbiddenOnExpiredToolErrorResponse(c, req.LangCode, perr)
+				case perror.CodeBlocked:
+					return h.blockedErrorResponse(c, req.LangCode, perr)
 				}
 			}
 		}
@@ -152,8 +160,9 @@ func (h *Handler) payoutCreate(c *fiber.Ctx) error {
 	}
 
 	resp := &payoutCreateResponse{
-		Success:     true,
-		OperationID: result.OperationID,
+		Success:               true,
+		OperationID:           result.OperationID,
+		ConfirmationExpiresAt: result.ConfirmationExpiresAt.Unix(),
 	}
 
 	return c.JSON(resp)
@@ -190,7 +199,7 @@ type payoutConfirmResponse struct {
 //	@Failure	default	{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/payout/{id}/confirm [put]
 func (h *Handler) payoutConfirm(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req payoutConfirmRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -210,6 +219,7 @@ func (h *Handler) payoutConfirm(c *fiber.Ctx) error {
 	confirmData := model.ConfirmPayoutData{
 		ConfirmationCode: req.ConfirmationCode,
 		LangCode:         req.LangCode,
+		MerchantID:       merchantID(c),
 		UserID:           req.UserID,
 		OperationID:      opID,
 	}
@@ -223,10 +233,14 @@ func (h *Handler) payoutConfirm(c *fiber.Ctx) error {
 					return h.objectNotFoundErrorResponse(c, req.LangCode, perr)
 				case perror.CodeToolHasBeenRemoved:
 					return h.forbiddenOnRemovedToolErrorResponse(c, req.LangCode, perr)
+				case perror.CodeToolHasExpired:
+					return h.forbiddenOnExpiredToolErrorResponse(c, req.LangCode, perr)
 				case perror.CodeWrongConfirmationCode:
 					return h.wrongConfirmationCodeErrorResponse(c, req.LangCode, perr)
 				case perror.CodeConfirmationAttemptsExceeded:
 					return h.wrongCodeLimitExceededErrorResponse(c, req.LangCode, perr)
+				case perror.CodeUnresolvedStatusConflict:
+					return h.unresolvedObjectStatusErrorResponse(c, req.LangCode, perr)
 				}
 			}
 		}
@@ -268,7 +282,7 @@ type payoutResendCodeResponse struct {
 //	@Failure	default	{object}	errorResponse				"Ответ с ошибкой"
 //	@Router		/payout/{id}/resend-code [put]
 func (h *Handler) payoutResendCode(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req payoutResendCodeRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -285,7 +299,7 @@ func (h *Handler) payoutResendCode(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	if err = h.payoutService.ResendCode(ctx, opID, req.UserID, req.LangCode); err != nil {
+	if err = h.payoutService.ResendCode(ctx, opID, merchantID(c), req.UserID, req.LangCode); err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
 			if perr.Group == perror.GroupInternal && perr.Code == perror.CodeObjectNotFound {
@@ -301,3 +315,69 @@ func (h *Handler) payoutResendCode(c *fiber.Ctx) error {
 
 	return c.JSON(resp)
 }
+
+type payoutCancelRequest struct {
+	// Идентификатор клиента
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии клиента
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+}
+
+type payoutCancelResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+}
+
+// payoutCancel godoc
+//
+//	@Summary	Отменить неподтвержденный запрос на вывод средств
+//	@Tags		Выплаты
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		int						true	"Идентификатор операции"
+//	@Param		input	body		payoutCancelRequest		true	"Тело запроса"
+//	@Success	200		{object}	payoutCancelResponse	"Успешный ответ"
+//	@Failure	default	{object}	errorResponse			"Ответ с ошибкой"
+//	@Router		/payout/{id}/cancel [put]
+func (h *Handler) payoutCancel(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req payoutCancelRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	opID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		err = fmt.Errorf("failed to parse id as int: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err = h.payoutService.Cancel(ctx, opID, merchantID(c), req.UserID); err != nil {
+		var perr *perror.Error
+		if errors.As(err, &perr) {
+			if perr.Group == perror.GroupInternal {
+				switch perr.Code {
+				case perror.CodeObjectNotFound:
+					return h.objectNotFoundErrorResponse(c, req.LangCode, perr)
+				case perror.CodeUnresolvedStatusConflict:
+					return h.unresolvedObjectStatusErrorResponse(c, req.LangCode, perr)
+				}
+			}
+		}
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	resp := &payoutCancelResponse{
+		Success: true,
+	}
+
+	return c.JSON(resp)
+}
@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/ratelimit"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/translate"
+)
+
+// Группы маршрутов с отдельными ограничениями частоты запросов, помимо групп областей доступа.
+const (
+	rateLimitGroupPaymentCreate    = "payment_create"
+	rateLimitGroupPayoutCreate     = "payout_create"
+	rateLimitGroupPayoutResendCode = "payout_resend_code"
+)
+
+// rateLimitMiddleware ограничивает частоту запросов к группе маршрутов отдельно по API ключу клиента,
+// пользователю и IP адресу. Токены берутся из всех корзин сразу, поэтому запрос, отклоненный по одному ключу,
+// не расходует лимиты остальных. Ошибка хранилища не блокирует запрос, чтобы его недоступность не останавливала платежи.
+func (h *Handler) rateLimitMiddleware(group string) fiber.Handler {
+	limits := h.rateLimits.Group(group)
+
+	return func(c *fiber.Ctx) error {
+		principal, _ := c.Locals(principalLocalsKey).(auth.Principal)
+		verified, _ := c.Locals(sessionLocalsKey).(session.Session)
+
+		apiKey := principal.KeyID
+		if apiKey == "" {
+			apiKey = principal.ClientID
+		}
+
+		requests := []ratelimit.Request{
+			{Key: group + ":key:" + apiKey, Limit: limits.PerAPIKey.Limit()},
+			{Key: group + ":ip:" + clientIP(c), Limit: limits.PerIP.Limit()},
+		}
+		if verified.Subject != "" {
+			requests = append(requests, ratelimit.Request{
+				Key:   group + ":user:" + merchantID(c) + "/" + verified.Subject,
+				Limit: limits.PerUser.Limit(),
+			})
+		}
+
+		result, err := h.rateLimiter.Take(c.UserContext(), requests...)
+		if err != nil {
+			slog.WarnContext(c.UserContext(), "rate limiter is unavailable",
+				"group", group,
+				"error", err,
+			)
+			return c.Next()
+		}
+
+		if !result.Allowed {
+			langCode, _ := c.Locals(langCodeLocalsKey).(string)
+			return h.tooManyRequestsErrorResponse(c, langCode, result.RetryAfter)
+		}
+
+		return c.Next()
+	}
+}
+
+func (h *Handler) tooManyRequestsErrorResponse(c *fiber.Ctx, langCode string, retryAfter time.Duration) error {
+	retryAfterSec := int64(math.Ceil(retryAfter.Seconds()))
+	if retryAfterSec < 1 {
+		retryAfterSec = 1
+	}
+	c.Set(fiber.HeaderRetryAfter, strconv.FormatInt(retryAfterSec, 10))
+
+	return c.Status(http.StatusTooManyRequests).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeTooManyRequests,
+			Description: "rate limit exceeded, retry after " + strconv.FormatInt(retryAfterSec, 10) + " seconds",
+			Message:     h.translator.Translate(langCode, translate.KeyTooManyRequests),
+		},
+	})
+}
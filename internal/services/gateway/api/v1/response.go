@@ -12,11 +12,16 @@ import (
 const (
 	errorCodeInvalidRequest         = "InvalidRequest"
 	errorCodeInvalidAPIKey          = "InvalidAPIKey"
+	errorCodeInsufficientScope      = "InsufficientScope"
+	errorCodeInvalidSession         = "InvalidSession"
+	errorCodeSessionUserMismatch    = "SessionUserMismatch"
+	errorCodeTooManyRequests        = "TooManyRequests"
 	errorCodeInternalError          = "InternalError"
 	errorCodeObjectNotFound         = "ObjectNotFound"
 	errorCodeUnresolvedObjectStatus = "UnresolvedObjectStatus"
 	errorCodeWrongConfirmationCode  = "WrongConfirmationCode"
 	errorCodeWrongCodeLimitExceeded = "WrongCodeLimitExceeded"
+	errorCodeBlocked                = "Blocked"
 )
 
 type errorContent struct {
@@ -68,6 +73,17 @@ func (h *Handler) objectNotFoundErrorResponse(c *fiber.Ctx, langCode string, per
 	})
 }
 
+func (h *Handler) unresolvedObjectStatusErrorResponse(c *fiber.Ctx, langCode string, perr *perror.Error) error {
+	return c.Status(http.StatusConflict).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeUnresolvedObjectStatus,
+			Description: perr.Description,
+			Message:     h.translator.Translate(langCode, translate.KeyUnresolvedStatusConflict),
+		},
+	})
+}
+
 func (h *Handler) forbiddenOnRemovedToolErrorResponse(c *fiber.Ctx, langCode string, perr *perror.Error) error {
 	return c.Status(http.StatusConflict).JSON(&errorResponse{
 		Success: false,
@@ -79,6 +95,28 @@ func (h *Handler) forbiddenOnRemovedToolErrorResponse(c *fiber.Ctx, langCode str
 	})
 }
 
+func (h *Handler) forbiddenOnExpiredToolErrorResponse(c *fiber.Ctx, langCode string, perr *perror.Error) error {
+	return c.Status(http.StatusConflict).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeUnresolvedObjectStatus,
+			Description: perr.Description,
+			Message:     h.translator.Translate(langCode, translate.KeyForbiddenOnExpiredTool),
+		},
+	})
+}
+
+func (h *Handler) blockedErrorResponse(c *fiber.Ctx, langCode string, perr *perror.Error) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeBlocked,
+			Description: perr.Description,
+			Message:     h.translator.Translate(langCode, translate.KeyOperationBlocked),
+		},
+	})
+}
+
 func (h *Handler) wrongConfirmationCodeErrorResponse(c *fiber.Ctx, langCode string, perr *perror.Error) error {
 	return c.Status(http.StatusBadRequest).JSON(&errorResponse{
 		Success: false,
@@ -27,6 +27,12 @@ type toolDetails struct {
 	ExpiryYear int64 `json:"expiry_year,omitempty" example:"2023"`
 	// Название банка, выпустившего банковскую карту
 	BankName string `json:"bank_name,omitempty" example:"Sberbank"`
+	// Платежная система банковской карты по справочнику BIN
+	CardBrand string `json:"card_brand,omitempty" example:"VISA"`
+	// Тип банковской карты по источнику средств: "debit", "credit" или "prepaid"
+	CardFunding string `json:"card_funding,omitempty" example:"debit"`
+	// Страна банка, выпустившего банковскую карту, код по ISO 3166-1 alpha-2
+	IssuerCountry string `json:"issuer_country,omitempty" example:"RU"`
 	// Номер электронного кошелька
 	WalletNumber string `json:"wallet_number,omitempty" example:"410011758831136"`
 }
@@ -50,6 +56,10 @@ type tool struct {
 	Caption string `json:"caption" example:"444444******4444" validate:"required"`
 	// Дополнительная информация о платежном средстве
 	Details *toolDetails `json:"details,omitempty"`
+	// Время окончания срока действия платежного средства в формате UNIX Timestamp
+	ExpiresAt int64 `json:"expires_at,omitempty" example:"1730419200"`
+	// Флаг, что срок действия платежного средства истек и его нельзя использовать для операций
+	Expired bool `json:"expired" example:"false"`
 }
 
 type toolListRequest struct {
@@ -81,7 +91,7 @@ type toolListResponse struct {
 //	@Failure	default		{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/tool [get]
 func (h *Handler) toolList(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req toolListRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -92,7 +102,7 @@ func (h *Handler) toolList(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	tools, err := h.toolService.AvailableTools(ctx, req.UserID)
+	tools, err := h.toolService.AvailableTools(ctx, merchantID(c), req.UserID)
 	if err != nil {
 		return h.internalErrorResponse(c, req.LangCode, err)
 	}
@@ -139,7 +149,7 @@ type toolEditResponse struct {
 //	@Failure	default	{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/tool/edit [put]
 func (h *Handler) toolEdit(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req toolEditRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -150,7 +160,7 @@ func (h *Handler) toolEdit(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	edited, err := h.toolService.EditTool(ctx, req.ID, req.UserID, req.ExternalMethod, req.Name)
+	edited, err := h.toolService.EditTool(ctx, req.ID, merchantID(c), req.UserID, req.ExternalMethod, req.Name)
 	if err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
@@ -201,7 +211,7 @@ type toolRemoveResponse struct {
 //	@Failure	default	{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/tool/remove [delete]
 func (h *Handler) toolRemove(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req toolRemoveRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -212,7 +222,7 @@ func (h *Handler) toolRemove(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	if err := h.toolService.RemoveTool(ctx, req.ID, req.UserID, req.ExternalMethod); err != nil {
+	if err := h.toolService.RemoveTool(ctx, req.ID, merchantID(c), req.UserID, req.ExternalMethod); err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
 			if perr.Group == perror.GroupInternal {
@@ -239,6 +249,11 @@ func (h *Handler) tool(item *model.Tool) tool {
 		ExternalMethod: item.ExternalMethod,
 		Caption:        item.Displayed,
 		Name:           item.Name,
+		Expired:        item.Expired(),
+	}
+
+	if !item.ExpiresAt.IsZero() {
+		t.ExpiresAt = item.ExpiresAt.Unix()
 	}
 
 	switch item.Type {
@@ -250,8 +265,11 @@ func (h *Handler) tool(item *model.Tool) tool {
 		bankName, _ := item.Details["bank_name"].(string)
 		expiryMonthStr, _ := item.Details["expiry_month"].(string)
 		expiryYearStr, _ := item.Details["expiry_year"].(string)
+		cardBrand, _ := item.Details["card_brand"].(string)
+		cardFunding, _ := item.Details["card_funding"].(string)
+		issuerCountry, _ := item.Details["issuer_country"].(string)
 
-		if cardType+cardHolder+bankName+expiryMonthStr+expiryYearStr == "" {
+		if cardType+cardHolder+bankName+expiryMonthStr+expiryYearStr+cardBrand+cardFunding+issuerCountry == "" {
 			break
 		}
 
@@ -259,11 +277,14 @@ func (h *Handler) tool(item *model.Tool) tool {
 		expiryYear, _ := strconv.ParseInt(expiryYearStr, 10, 64)
 
 		t.Details = &toolDetails{
-			CardType:    cardType,
-			CardHolder:  cardHolder,
-			ExpiryMonth: expiryMonth,
-			ExpiryYear:  expiryYear,
-			BankName:    bankName,
+			CardType:      cardType,
+			CardHolder:    cardHolder,
+			ExpiryMonth:   expiryMonth,
+			ExpiryYear:    expiryYear,
+			BankName:      bankName,
+			CardBrand:     cardBrand,
+			CardFunding:   cardFunding,
+			IssuerCountry: issuerCountry,
 		}
 	case model.ToolTypeWallet:
 		t.Type = toolTypeWallet
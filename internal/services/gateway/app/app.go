@@ -2,19 +2,26 @@ package app
 
 import (
 	"context"
-	"io"
 	"log"
 	"log/slog"
 	"os"
 
 	"github.com/gofiber/fiber/v2"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/health"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/lifecycle"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/middleware"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/ratelimit"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/service/sorting"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/service/summary"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/translate"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/gateway/api"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/gateway/api/v1"
@@ -29,13 +36,14 @@ import (
 )
 
 type App struct {
-	app     *fiber.App
-	config  config.GatewayConfig
-	closers []io.Closer
+	app           *fiber.App
+	metricsServer *metrics.Server
+	config        config.GatewayConfig
+	lifecycle     *lifecycle.Manager
 }
 
 func New(configPath string) *App {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})))
 	slog.SetDefault(logger)
 
 	cfg, err := config.Load(configPath)
@@ -43,7 +51,14 @@ func New(configPath string) *App {
 		log.Fatalf("loading config: %v", err)
 	}
 
-	engineConn, err := grpc.Dial(cfg.Services.Engine.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	redact.SetDefault(redact.New(cfg.Gateway.Redaction))
+
+	shutdownTracing, err := tracing.Init(context.Background(), "gateway", cfg.Gateway.Tracing)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+
+	engineConn, err := pkggrpc.Dial(cfg.Services.Engine.GRPCAddress, cfg.Services.Engine.TLS)
 	if err != nil {
 		log.Fatalf("connecting engine service: %v", err)
 	}
@@ -60,6 +75,23 @@ func New(configPath string) *App {
 	summaryService := summary.NewService()
 	translator := translate.NewTranslator("en", "ru")
 
+	apiKeys, err := cfg.Gateway.Auth.Keys()
+	if err != nil {
+		log.Fatalf("loading API keys: %v", err)
+	}
+	requestVerifier := auth.NewVerifier(
+		auth.NewMemoryStore(apiKeys...),
+		// подписи хранятся в памяти: защита от повторной отправки действует в пределах одного экземпляра
+		auth.NewMemoryReplayCache(),
+		cfg.Gateway.Auth.ReplayWindowDuration(),
+		cfg.Gateway.APIKey,
+	)
+
+	sessionVerifier, err := session.NewVerifier(cfg.Gateway.Session)
+	if err != nil {
+		log.Fatalf("creating session verifier: %v", err)
+	}
+
 	apiHandlerV1 := v1.NewHandler(v1.HandlerOptions{
 		MethodService:    methodService,
 		PaymentService:   paymentService,
@@ -70,17 +102,44 @@ func New(configPath string) *App {
 		SortingService:   sortingService,
 		SummaryService:   summaryService,
 		Translator:       translator,
-		APIKey:           cfg.Gateway.APIKey,
+		RequestVerifier:  requestVerifier,
+		SessionVerifier:  sessionVerifier,
+		RateLimiter:      ratelimit.NewMemoryStore(),
+		RateLimits:       cfg.Gateway.RateLimit,
 	})
 	apiServer := api.NewServer(apiHandlerV1)
 
-	app := fiber.New()
+	healthChecker := health.NewChecker(0)
+	healthChecker.Add("engine", health.GRPCCheck(engineConn))
+
+	// IP-адрес клиента берется из заголовка балансировщика, только если запрос пришел с его адреса:
+	// иначе клиент мог бы подставить произвольный адрес и обойти ограничения по IP
+	app := fiber.New(fiber.Config{
+		ProxyHeader:             cfg.Gateway.Proxy.Header,
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          cfg.Gateway.Proxy.TrustedProxies,
+		EnableIPValidation:      true,
+	})
+	app.Use(middleware.NewMetrics())
+	app.Get("/healthz", health.LivenessHandler())
+	app.Get("/readyz", healthChecker.ReadinessHandler())
+	app.Use(middleware.NewTracing())
 	apiServer.Init(app)
 
+	// метрики отдаются на отдельном внутреннем адресе, чтобы не публиковать их вместе с API
+	metricsServer := metrics.NewServer(cfg.Gateway.MetricsAddress)
+
+	lifecycleManager := lifecycle.NewManager()
+	lifecycleManager.Add("http server", app.ShutdownWithContext)
+	lifecycleManager.Add("metrics server", metricsServer.Shutdown)
+	lifecycleManager.AddCloser("engine connection", engineConn)
+	lifecycleManager.Add("tracing", shutdownTracing)
+
 	return &App{
-		app:     app,
-		config:  cfg.Gateway,
-		closers: []io.Closer{engineConn},
+		app:           app,
+		metricsServer: metricsServer,
+		config:        cfg.Gateway,
+		lifecycle:     lifecycleManager,
 	}
 }
 
@@ -91,19 +150,15 @@ func (a *App) Start() {
 		return a.app.Listen(a.config.HTTPAddress)
 	})
 
+	group.Go(func() error {
+		return a.metricsServer.Serve()
+	})
+
 	if err := group.Wait(); err != nil {
 		log.Fatalf("app: %v", err)
 	}
 }
 
-func (a *App) Stop(_ context.Context) {
-	if err := a.app.Shutdown(); err != nil {
-		log.Printf("failed to shutdown the app: %v", err)
-	}
-
-	for _, c := range a.closers {
-		if err := c.Close(); err != nil {
-			log.Printf("failed to close resource: %v", err)
-		}
-	}
+func (a *App) Stop(ctx context.Context) {
+	a.lifecycle.Stop(ctx)
 }
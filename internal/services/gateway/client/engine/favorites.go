@@ -12,6 +12,7 @@ import (
 func (c *Client) AddToFavorites(ctx context.Context, data model.FavoritesData) error {
 	request := &pb.FavoritesRequest{
 		Type:           convert.OperationTypeToProto(data.OperationType),
+		MerchantId:     data.MerchantID,
 		UserId:         data.UserID,
 		Currency:       data.Currency,
 		ExternalSystem: data.ExternalSystem,
@@ -32,6 +33,7 @@ func (c *Client) AddToFavorites(ctx context.Context, data model.FavoritesData) e
 func (c *Client) RemoveFromFavorites(ctx context.Context, data model.FavoritesData) error {
 	request := &pb.FavoritesRequest{
 		Type:           convert.OperationTypeToProto(data.OperationType),
+		MerchantId:     data.MerchantID,
 		UserId:         data.UserID,
 		Currency:       data.Currency,
 		ExternalSystem: data.ExternalSystem,
@@ -8,10 +8,11 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
-func (c *Client) AvailableMethods(ctx context.Context, opType model.OperationType, userID string, currency string) ([]model.Method, error) {
+func (c *Client) AvailableMethods(ctx context.Context, opType model.OperationType, merchantID, userID string, currency string) ([]model.Method, error) {
 	request := &pb.AvailableMethodsRequest{
 		OperationType: convert.OperationTypeToProto(opType),
 		Currency:      currency,
+		MerchantId:    merchantID,
 		UserId:        userID,
 	}
 
@@ -28,6 +28,7 @@ func (c *Client) ReportOperations(ctx context.Context, criteria model.OperationC
 func reportOperationRequestFromCriteria(criteria model.OperationCriteria) *pbEngine.ReportOperationsRequest {
 	request := &pbEngine.ReportOperationsRequest{
 		Id:         criteria.ID,
+		MerchantId: criteria.MerchantID,
 		UserId:     criteria.UserID,
 		ExternalId: criteria.ExternalID,
 	}
@@ -59,6 +60,7 @@ func reportOperationRequestFromCriteria(criteria model.OperationCriteria) *pbEng
 func reportOperationFromProto(op *pbEngine.ReportOperation) model.ReportOperation {
 	result := model.ReportOperation{
 		ID:             op.GetId(),
+		MerchantID:     op.GetMerchantId(),
 		UserID:         op.GetUserId(),
 		Type:           convert.OperationTypeFromProto(op.GetType()),
 		Currency:       op.GetCurrency(),
@@ -20,6 +20,7 @@ func (c *Client) CreatePayment(ctx context.Context, data model.CreatePaymentData
 	}
 
 	request := &pb.CreatePaymentRequest{
+		MerchantId:     data.MerchantID,
 		UserId:         data.UserID,
 		LangCode:       data.LangCode,
 		ExternalSystem: data.ExternalSystem,
@@ -28,6 +29,7 @@ func (c *Client) CreatePayment(ctx context.Context, data model.CreatePaymentData
 		Currency:       data.Currency,
 		AdditionalData: pbAdditional,
 		ReturnUrls:     convert.ReturnURLsToProto(data.ReturnURLs),
+		ClientIp:       data.ClientIP,
 	}
 
 	if data.ToolID != "" {
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -19,6 +20,7 @@ func (c *Client) CreatePayout(ctx context.Context, data model.CreatePayoutData)
 	}
 
 	request := &pb.CreatePayoutRequest{
+		MerchantId:     data.MerchantID,
 		UserId:         data.UserID,
 		ToolId:         data.ToolID,
 		LangCode:       data.LangCode,
@@ -27,6 +29,7 @@ func (c *Client) CreatePayout(ctx context.Context, data model.CreatePayoutData)
 		Amount:         data.Amount,
 		Currency:       data.Currency,
 		AdditionalData: pbAdditional,
+		ClientIp:       data.ClientIP,
 	}
 
 	response, err := c.client.CreatePayout(ctx, request)
@@ -39,6 +42,7 @@ func (c *Client) CreatePayout(ctx context.Context, data model.CreatePayoutData)
 
 	result.OperationID = response.GetOperationId()
 	result.Status = model.OperationStatus(response.GetStatus())
+	result.ConfirmationExpiresAt = time.Unix(response.GetConfirmationExpiresAt(), 0).UTC()
 
 	return result, nil
 }
@@ -46,6 +50,7 @@ func (c *Client) CreatePayout(ctx context.Context, data model.CreatePayoutData)
 func (c *Client) ConfirmPayout(ctx context.Context, data model.ConfirmPayoutData) error {
 	request := &pb.ConfirmPayoutRequest{
 		OperationId:      data.OperationID,
+		MerchantId:       data.MerchantID,
 		UserId:           data.UserID,
 		ConfirmationCode: data.ConfirmationCode,
 		LangCode:         data.LangCode,
@@ -62,9 +67,10 @@ func (c *Client) ConfirmPayout(ctx context.Context, data model.ConfirmPayoutData
 	return nil
 }
 
-func (c *Client) ResendCode(ctx context.Context, opID int64, userID string, langCode string) error {
+func (c *Client) ResendCode(ctx context.Context, opID int64, merchantID, userID string, langCode string) error {
 	request := &pb.ResendConfirmationCodeRequest{
 		OperationId: opID,
+		MerchantId:  merchantID,
 		UserId:      userID,
 		LangCode:    langCode,
 	}
@@ -79,3 +85,21 @@ func (c *Client) ResendCode(ctx context.Context, opID int64, userID string, lang
 
 	return nil
 }
+
+func (c *Client) CancelPayout(ctx context.Context, opID int64, merchantID, userID string) error {
+	request := &pb.CancelPayoutRequest{
+		OperationId: opID,
+		MerchantId:  merchantID,
+		UserId:      userID,
+	}
+
+	_, err := c.client.CancelPayout(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return perr
+		}
+		return err
+	}
+
+	return nil
+}
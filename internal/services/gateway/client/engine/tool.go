@@ -9,9 +9,10 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
-func (c *Client) AvailableTools(ctx context.Context, userID string) ([]*model.Tool, error) {
+func (c *Client) AvailableTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error) {
 	request := &pb.AvailableToolsRequest{
-		UserId: userID,
+		MerchantId: merchantID,
+		UserId:     userID,
 	}
 
 	response, err := c.client.AvailableTools(ctx, request)
@@ -26,9 +27,10 @@ func (c *Client) AvailableTools(ctx context.Context, userID string) ([]*model.To
 	return tools, nil
 }
 
-func (c *Client) EditTool(ctx context.Context, id string, userID string, externalMethod, name string) (*model.Tool, error) {
+func (c *Client) EditTool(ctx context.Context, id string, merchantID, userID string, externalMethod, name string) (*model.Tool, error) {
 	request := &pb.EditToolRequest{
 		Id:             id,
+		MerchantId:     merchantID,
 		UserId:         userID,
 		ExternalMethod: externalMethod,
 		Name:           name,
@@ -47,9 +49,10 @@ func (c *Client) EditTool(ctx context.Context, id string, userID string, externa
 	return tool, nil
 }
 
-func (c *Client) RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error {
+func (c *Client) RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string) error {
 	request := &pb.RemoveToolRequest{
 		Id:             id,
+		MerchantId:     merchantID,
 		UserId:         userID,
 		ExternalMethod: externalMethod,
 		ActionSource:   pb.ActionSource_ACTION_SOURCE_DEFAULT,
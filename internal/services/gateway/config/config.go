@@ -5,6 +5,13 @@ import (
 	"os"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/ratelimit"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 const apiKeyEnvKey = "API_KEY"
@@ -16,7 +23,30 @@ type Config struct {
 
 type GatewayConfig struct {
 	HTTPAddress string `yaml:"http_address"`
-	APIKey      string
+	// MetricsAddress - адрес отдельного внутреннего HTTP-сервера, отдающего метрики в формате Prometheus
+	MetricsAddress string `yaml:"metrics_address"`
+	// Proxy - балансировщики нагрузки перед шлюзом, которым доверяется IP-адрес клиента
+	Proxy ProxyConfig `yaml:"proxy"`
+	// APIKey - статический ключ для режима совместимости; подгружается из переменной среды окружения
+	APIKey string
+	// Auth - клиенты API и их ключи подписи запросов
+	Auth auth.Config `yaml:"auth"`
+	// Session - способ проверки сессий пользователей
+	Session session.Config `yaml:"session"`
+	// RateLimit - ограничения частоты запросов по группам маршрутов
+	RateLimit ratelimit.Config `yaml:"rate_limit"`
+	// Tracing - настройки экспорта трассировок OpenTelemetry
+	Tracing tracing.Config `yaml:"tracing"`
+	// Redaction - правила скрытия чувствительных данных в журналах
+	Redaction redact.Config `yaml:"redaction"`
+}
+
+// ProxyConfig - настройки определения IP-адреса клиента за балансировщиком нагрузки.
+type ProxyConfig struct {
+	// Header - заголовок, в который балансировщик записывает IP-адрес клиента (например, X-Real-IP)
+	Header string `yaml:"header"`
+	// TrustedProxies - адреса и подсети балансировщиков; заголовок запросов с других адресов не учитывается
+	TrustedProxies []string `yaml:"trusted_proxies"`
 }
 
 type ServicesConfig struct {
@@ -25,6 +55,8 @@ type ServicesConfig struct {
 
 type ServiceConfig struct {
 	GRPCAddress string `yaml:"grpc_address"`
+	// TLS - настройки mTLS для соединения с сервисом
+	TLS pkggrpc.TLSConfig `yaml:"tls"`
 }
 
 func Load(configPath string) (Config, error) {
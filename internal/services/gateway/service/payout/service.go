@@ -9,7 +9,8 @@ import (
 type EngineClient interface {
 	CreatePayout(ctx context.Context, data model.CreatePayoutData) (model.CreatePayoutResult, error)
 	ConfirmPayout(ctx context.Context, data model.ConfirmPayoutData) error
-	ResendCode(ctx context.Context, opID int64, userID string, langCode string) error
+	ResendCode(ctx context.Context, opID int64, merchantID, userID string, langCode string) error
+	CancelPayout(ctx context.Context, opID int64, merchantID, userID string) error
 }
 
 type Service struct {
@@ -30,6 +31,10 @@ func (s *Service) Confirm(ctx context.Context, data model.ConfirmPayoutData) err
 	return s.engineClient.ConfirmPayout(ctx, data)
 }
 
-func (s *Service) ResendCode(ctx context.Context, opID int64, userID string, langCode string) error {
-	return s.engineClient.ResendCode(ctx, opID, userID, langCode)
+func (s *Service) ResendCode(ctx context.Context, opID int64, merchantID, userID string, langCode string) error {
+	return s.engineClient.ResendCode(ctx, opID, merchantID, userID, langCode)
+}
+
+func (s *Service) Cancel(ctx context.Context, opID int64, merchantID, userID string) error {
+	return s.engineClient.CancelPayout(ctx, opID, merchantID, userID)
 }
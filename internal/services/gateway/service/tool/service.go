@@ -8,9 +8,9 @@ import (
 )
 
 type EngineClient interface {
-	AvailableTools(ctx context.Context, userID string) ([]*model.Tool, error)
-	EditTool(ctx context.Context, id string, userID string, externalMethod, name string) (*model.Tool, error)
-	RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error
+	AvailableTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error)
+	EditTool(ctx context.Context, id string, merchantID, userID string, externalMethod, name string) (*model.Tool, error)
+	RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string) error
 }
 
 type Service struct {
@@ -23,8 +23,8 @@ func NewService(engineClient EngineClient) *Service {
 	}
 }
 
-func (s *Service) AvailableTools(ctx context.Context, userID string) ([]*model.Tool, error) {
-	tools, err := s.engineClient.AvailableTools(ctx, userID)
+func (s *Service) AvailableTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error) {
+	tools, err := s.engineClient.AvailableTools(ctx, merchantID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -36,23 +36,27 @@ func (s *Service) AvailableTools(ctx context.Context, userID string) ([]*model.T
 	return tools, nil
 }
 
-func (s *Service) AvailableToolsGroupedByMethod(ctx context.Context, userID string) (map[string][]*model.Tool, error) {
-	tools, err := s.AvailableTools(ctx, userID)
+func (s *Service) AvailableToolsGroupedByMethod(ctx context.Context, merchantID, userID string) (map[string][]*model.Tool, error) {
+	tools, err := s.AvailableTools(ctx, merchantID, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	toolsGrouped := make(map[string][]*model.Tool, len(tools))
 	for _, tool := range tools {
+		// карты с истекшим сроком действия показываются в списке средств клиента, но не предлагаются для оплаты
+		if tool.Expired() {
+			continue
+		}
 		toolsGrouped[tool.ExternalMethod] = append(toolsGrouped[tool.ExternalMethod], tool)
 	}
 	return toolsGrouped, nil
 }
 
-func (s *Service) EditTool(ctx context.Context, id string, userID string, externalMethod, name string) (*model.Tool, error) {
-	return s.engineClient.EditTool(ctx, id, userID, externalMethod, name)
+func (s *Service) EditTool(ctx context.Context, id string, merchantID, userID string, externalMethod, name string) (*model.Tool, error) {
+	return s.engineClient.EditTool(ctx, id, merchantID, userID, externalMethod, name)
 }
 
-func (s *Service) RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error {
-	return s.engineClient.RemoveTool(ctx, id, userID, externalMethod)
+func (s *Service) RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string) error {
+	return s.engineClient.RemoveTool(ctx, id, merchantID, userID, externalMethod)
 }
@@ -2,28 +2,37 @@ package app
 
 import (
 	"context"
-	"io"
 	"log"
 	"log/slog"
 	"net"
 	"os"
+	"time"
 
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	pbIntegration "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/bin"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/lifecycle"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/integration/config"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/integration/provider/yookassa"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/integration/server"
 )
 
 type App struct {
-	srv     *server.Server
-	closers []io.Closer
+	srv           *server.Server
+	metricsServer *metrics.Server
+	lifecycle     *lifecycle.Manager
 }
 
 func New(configPath string) *App {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})))
 	slog.SetDefault(logger)
 
 	cfg, err := config.Load(configPath)
@@ -31,16 +40,45 @@ func New(configPath string) *App {
 		log.Fatalf("loading config: %v", err)
 	}
 
+	redact.SetDefault(redact.New(cfg.Integration.Redaction))
+
+	shutdownTracing, err := tracing.Init(context.Background(), "integration", cfg.Integration.Tracing)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+
 	grpcListener, err := net.Listen("tcp", cfg.Integration.GRPCAddress)
 	if err != nil {
 		log.Fatalf("listening tcp on %v: %v", cfg.Integration.GRPCAddress, err)
 	}
 
-	integrations := map[string]server.Integration{
-		yookassa.ExternalSystem: yookassa.NewIntegration(cfg.Integration.YooKassa),
+	var binDatabase *bin.Database
+	if cfg.Integration.BINDatabase.File != "" {
+		binDatabase, err = bin.Open(cfg.Integration.BINDatabase.File)
+		if err != nil {
+			log.Fatalf("opening bin database: %v", err)
+		}
+	}
+
+	integrations := server.MerchantIntegrations{
+		model.DefaultMerchantID: {
+			yookassa.ExternalSystem: yookassa.NewIntegration(cfg.Integration.YooKassa, binDatabase),
+		},
+	}
+	for merchantID := range cfg.Integration.Merchants {
+		integrations[merchantID] = map[string]server.Integration{}
+		if yooKassaCfg := cfg.Integration.MerchantYooKassa(merchantID); yooKassaCfg != nil {
+			integrations[merchantID][yookassa.ExternalSystem] = yookassa.NewIntegration(yooKassaCfg, binDatabase)
+		}
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer, err := pkggrpc.NewServer(pkggrpc.ServerOptions{
+		TLS:    cfg.Integration.TLS,
+		Policy: server.CallerPolicy,
+	})
+	if err != nil {
+		log.Fatalf("creating grpc server: %v", err)
+	}
 	srv := server.NewServer(server.Options{
 		Server:       grpcServer,
 		Listener:     grpcListener,
@@ -48,9 +86,36 @@ func New(configPath string) *App {
 	})
 	pbIntegration.RegisterIntegrationServiceServer(grpcServer, srv)
 
+	// у сервиса интеграций нет собственных зависимостей, поэтому он готов обслуживать запросы сразу после запуска
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	if err = server.CallerPolicy.Validate(grpcServer); err != nil {
+		log.Fatalf("validating grpc access policy: %v", err)
+	}
+
+	metricsServer := metrics.NewServer(cfg.Integration.MetricsAddress)
+
+	lifecycleManager := lifecycle.NewManager()
+	if binDatabase != nil && cfg.Integration.BINDatabase.ReloadInterval > 0 {
+		watchCtx, stopWatch := context.WithCancel(context.Background())
+		go binDatabase.Watch(watchCtx, time.Duration(cfg.Integration.BINDatabase.ReloadInterval)*time.Second)
+		lifecycleManager.Add("bin database", func(context.Context) error {
+			stopWatch()
+			return nil
+		})
+	}
+	lifecycleManager.Add("health check", func(context.Context) error {
+		healthServer.Shutdown()
+		return nil
+	})
+	lifecycleManager.AddCloser("grpc server", srv)
+	lifecycleManager.Add("metrics server", metricsServer.Shutdown)
+	lifecycleManager.Add("tracing", shutdownTracing)
+
 	return &App{
-		srv:     srv,
-		closers: []io.Closer{srv},
+		srv:           srv,
+		metricsServer: metricsServer,
+		lifecycle:     lifecycleManager,
 	}
 }
 
@@ -61,15 +126,15 @@ func (a *App) Start() {
 		return a.srv.Serve()
 	})
 
+	group.Go(func() error {
+		return a.metricsServer.Serve()
+	})
+
 	if err := group.Wait(); err != nil {
 		log.Fatalf("app: %v", err)
 	}
 }
 
-func (a *App) Stop(_ context.Context) {
-	for _, c := range a.closers {
-		if err := c.Close(); err != nil {
-			log.Printf("failed to close resource: %v", err)
-		}
-	}
+func (a *App) Stop(ctx context.Context) {
+	a.lifecycle.Stop(ctx)
 }
@@ -5,6 +5,11 @@ import (
 	"os"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/bin"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 type Config struct {
@@ -13,8 +18,20 @@ type Config struct {
 }
 
 type IntegrationConfig struct {
-	GRPCAddress string          `yaml:"grpc_address"`
-	YooKassa    *YooKassaConfig `yaml:"yookassa"`
+	GRPCAddress string `yaml:"grpc_address"`
+	// TLS - настройки mTLS gRPC-сервера; при включении клиенты авторизуются по сертификатам
+	TLS pkggrpc.TLSConfig `yaml:"tls"`
+	// MetricsAddress - адрес HTTP-сервера, отдающего метрики в формате Prometheus
+	MetricsAddress string          `yaml:"metrics_address"`
+	YooKassa       *YooKassaConfig `yaml:"yookassa"`
+	// Merchants - настройки платежных систем отдельных магазинов; настройки выше относятся к магазину по умолчанию
+	Merchants map[string]MerchantConfig `yaml:"merchants"`
+	// BINDatabase - локальный справочник BIN для обогащения сведений о банковских картах
+	BINDatabase bin.Config `yaml:"bin_database"`
+	// Tracing - настройки экспорта трассировок OpenTelemetry
+	Tracing tracing.Config `yaml:"tracing"`
+	// Redaction - правила скрытия чувствительных данных в журналах
+	Redaction redact.Config `yaml:"redaction"`
 }
 
 type ServicesConfig struct {
@@ -53,4 +70,8 @@ func (c *Config) loadFromEnv() {
 	if key, exists := os.LookupEnv(yooKassaPayoutsSecretKeyEnvKey); exists {
 		c.Integration.YooKassa.API.Payouts.SecretKey = key
 	}
+	for id, merchant := range c.Integration.Merchants {
+		merchant.loadFromEnv()
+		c.Integration.Merchants[id] = merchant
+	}
 }
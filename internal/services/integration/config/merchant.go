@@ -0,0 +1,73 @@
+package config
+
+import "os"
+
+// MerchantConfig - настройки платежных систем отдельного магазина.
+type MerchantConfig struct {
+	YooKassa *YooKassaMerchantConfig `yaml:"yookassa"`
+}
+
+// YooKassaMerchantConfig - учетные данные и методы ЮKassa отдельного магазина. Адрес API и каналы
+// общие для всех магазинов; методы берутся из общих настроек, если не заданы для магазина.
+type YooKassaMerchantConfig struct {
+	Payments YooKassaMerchantPaymentsConfig `yaml:"payments"`
+	Payouts  YooKassaMerchantPayoutsConfig  `yaml:"payouts"`
+	Methods  *MethodsConfig                 `yaml:"methods"`
+}
+
+type YooKassaMerchantPaymentsConfig struct {
+	ShopID string `yaml:"shop_id"`
+	// SecretKeyEnv - имя переменной среды окружения, в которой хранится секретный ключ магазина
+	SecretKeyEnv string `yaml:"secret_key_env"`
+	SecretKey    string
+}
+
+type YooKassaMerchantPayoutsConfig struct {
+	AgentID string `yaml:"agent_id"`
+	// SecretKeyEnv - имя переменной среды окружения, в которой хранится секретный ключ шлюза выплат
+	SecretKeyEnv string `yaml:"secret_key_env"`
+	SecretKey    string
+}
+
+// MerchantYooKassa собирает настройки ЮKassa магазина merchantID поверх общих настроек.
+// Для магазина без собственных настроек ЮKassa возвращается nil.
+func (c IntegrationConfig) MerchantYooKassa(merchantID string) *YooKassaConfig {
+	merchant, found := c.Merchants[merchantID]
+	if !found || merchant.YooKassa == nil || c.YooKassa == nil {
+		return nil
+	}
+
+	cfg := &YooKassaConfig{
+		API: YooKassaAPIConfig{
+			BaseURL: c.YooKassa.API.BaseURL,
+			Payments: YooKassaAPIPaymentsConfig{
+				ShopID:    merchant.YooKassa.Payments.ShopID,
+				SecretKey: merchant.YooKassa.Payments.SecretKey,
+			},
+			Payouts: YooKassaAPIPayoutsConfig{
+				AgentID:   merchant.YooKassa.Payouts.AgentID,
+				SecretKey: merchant.YooKassa.Payouts.SecretKey,
+			},
+		},
+		Channels: c.YooKassa.Channels,
+		Methods:  c.YooKassa.Methods,
+	}
+
+	if merchant.YooKassa.Methods != nil {
+		cfg.Methods = *merchant.YooKassa.Methods
+	}
+
+	return cfg
+}
+
+func (c *MerchantConfig) loadFromEnv() {
+	if c.YooKassa == nil {
+		return
+	}
+	if key, exists := os.LookupEnv(c.YooKassa.Payments.SecretKeyEnv); exists {
+		c.YooKassa.Payments.SecretKey = key
+	}
+	if key, exists := os.LookupEnv(c.YooKassa.Payouts.SecretKeyEnv); exists {
+		c.YooKassa.Payouts.SecretKey = key
+	}
+}
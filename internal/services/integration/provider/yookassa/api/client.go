@@ -5,8 +5,11 @@ import (
 	"time"
 
 	httpclient "github.com/tmrrwnxtsn/ecomway/internal/pkg/http"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
 )
 
+const externalSystem = "yookassa"
+
 const requestTimeout = 30 * time.Second
 
 const amountPrecision = 2
@@ -44,3 +47,18 @@ func NewClient(opts ClientOptions) *Client {
 		payoutsSecretKey:  opts.PayoutsSecretKey,
 	}
 }
+
+// do выполняет запрос к API и учитывает его в метриках под названием endpoint.
+func (c *Client) do(request *http.Request, endpoint string) (*http.Response, error) {
+	start := time.Now()
+
+	response, err := c.httpClient.Do(request)
+
+	var status int
+	if response != nil {
+		status = response.StatusCode
+	}
+	metrics.ObserveExternalRequest(externalSystem, endpoint, status, err, time.Since(start))
+
+	return response, err
+}
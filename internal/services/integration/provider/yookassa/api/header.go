@@ -21,11 +21,16 @@ func (c *Client) setPaymentRequiredHeaders(req *http.Request) {
 	}
 }
 
-func (c *Client) setPayoutRequiredHeaders(req *http.Request) {
+// setPayoutRequiredHeaders устанавливает заголовки запроса выплаты. Если ключ идемпотентности
+// idempotenceKey не задан, для POST-запроса генерируется случайный.
+func (c *Client) setPayoutRequiredHeaders(req *http.Request, idempotenceKey string) {
 	req.SetBasicAuth(c.agentID, c.payoutsSecretKey)
 
 	if req.Method == http.MethodPost {
-		req.Header.Set(headerIdempotenceKey, generateXRequestID())
+		if idempotenceKey == "" {
+			idempotenceKey = generateXRequestID()
+		}
+		req.Header.Set(headerIdempotenceKey, idempotenceKey)
 		req.Header.Set(headerContentType, "application/json")
 	}
 }
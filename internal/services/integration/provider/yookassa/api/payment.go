@@ -116,7 +116,7 @@ func (c *Client) CreatePayment(ctx context.Context, request data.CreatePaymentRe
 
 	c.setPaymentRequiredHeaders(httpRequest)
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
+	httpResponse, err := c.do(httpRequest, "create_payment")
 	if err != nil {
 		return response, fmt.Errorf("making HTTP request: %w", err)
 	}
@@ -215,7 +215,7 @@ func (c *Client) GetPayment(ctx context.Context, paymentID string) (data.GetPaym
 
 	c.setPaymentRequiredHeaders(httpRequest)
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
+	httpResponse, err := c.do(httpRequest, "get_payment")
 	if err != nil {
 		return response, fmt.Errorf("making HTTP request: %w", err)
 	}
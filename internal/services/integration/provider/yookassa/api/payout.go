@@ -70,9 +70,9 @@ func (c *Client) CreatePayout(ctx context.Context, request data.CreatePayoutRequ
 		return response, fmt.Errorf("creating HTTP request with context: %w", err)
 	}
 
-	c.setPayoutRequiredHeaders(httpRequest)
+	c.setPayoutRequiredHeaders(httpRequest, request.IdempotenceKey)
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
+	httpResponse, err := c.do(httpRequest, "create_payout")
 	if err != nil {
 		return response, fmt.Errorf("making HTTP request: %w", err)
 	}
@@ -137,9 +137,9 @@ func (c *Client) GetPayout(ctx context.Context, payoutID string) (data.GetPayout
 		return response, fmt.Errorf("creating HTTP request with context: %w", err)
 	}
 
-	c.setPayoutRequiredHeaders(httpRequest)
+	c.setPayoutRequiredHeaders(httpRequest, "")
 
-	httpResponse, err := c.httpClient.Do(httpRequest)
+	httpResponse, err := c.do(httpRequest, "get_payout")
 	if err != nil {
 		return response, fmt.Errorf("making HTTP request: %w", err)
 	}
@@ -32,7 +32,7 @@ func closeResponseBody(resp *http.Response) {
 		return
 	}
 	if err := resp.Body.Close(); err != nil {
-		slog.Warn("failed to close HTTP response body", "error", err)
+		slog.WarnContext(resp.Request.Context(), "failed to close HTTP response body", "error", err)
 	}
 }
 
@@ -2,6 +2,8 @@ package channel
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/integration/config"
@@ -12,11 +14,13 @@ const channelCodeBankCard = "card"
 
 type bankCardChannel struct {
 	baseChannel
+	binLookup BINLookup
 }
 
-func newBankCardChannel(cfg config.YooKassaChannelConfig) bankCardChannel {
+func newBankCardChannel(cfg config.YooKassaChannelConfig, binLookup BINLookup) bankCardChannel {
 	return bankCardChannel{
 		baseChannel: newBaseChannel(cfg),
+		binLookup:   binLookup,
 	}
 }
 
@@ -27,21 +31,45 @@ func (c bankCardChannel) PaymentTool(userID string, externalMethod string, metho
 
 	displayed := fmt.Sprintf("%v******%v", method.Card.First6, method.Card.Last4)
 
+	var expiresAt time.Time
+	expiryYear, yearErr := strconv.Atoi(method.Card.ExpiryYear)
+	expiryMonth, monthErr := strconv.Atoi(method.Card.ExpiryMonth)
+	if yearErr == nil && monthErr == nil && expiryMonth >= 1 && expiryMonth <= 12 {
+		expiresAt = model.CardExpiresAt(expiryYear, expiryMonth)
+	}
+
+	details := map[string]any{
+		"token":        method.ID,
+		"first6":       method.Card.First6,
+		"last4":        method.Card.Last4,
+		"expiry_year":  method.Card.ExpiryYear,
+		"expiry_month": method.Card.ExpiryMonth,
+		"card_type":    method.Card.CardType,
+		"bank_name":    method.Card.IssuerName,
+	}
+
+	// справочник BIN дополняет то, чего YooKassa не сообщает: тип карты и страну банка-эмитента
+	if c.binLookup != nil {
+		if info, ok := c.binLookup.Lookup(method.Card.First6); ok {
+			details["card_brand"] = info.Brand
+			details["card_funding"] = info.Funding
+			details["issuer_country"] = info.Country
+			if method.Card.IssuerName == "" {
+				details["bank_name"] = info.Issuer
+			}
+		}
+	}
+
 	return &model.Tool{
-		ID:             displayed,
+		// маскированный номер карты идентификатором служить не может: у разных карт могут совпадать BIN
+		// и последние четыре цифры
+		ID:             model.CardFingerprint(method.ID),
 		UserID:         userID,
 		ExternalMethod: externalMethod,
 		Displayed:      displayed,
 		Name:           "Bank card",
 		Type:           model.ToolTypeBankCard,
-		Details: map[string]any{
-			"token":        method.ID,
-			"first6":       method.Card.First6,
-			"last4":        method.Card.Last4,
-			"expiry_year":  method.Card.ExpiryYear,
-			"expiry_month": method.Card.ExpiryMonth,
-			"card_type":    method.Card.CardType,
-			"bank_name":    method.Card.IssuerName,
-		},
+		ExpiresAt:      expiresAt,
+		Details:        details,
 	}
 }
@@ -92,6 +92,7 @@ func (c baseChannel) CreatePayoutRequest(d model.CreatePayoutData) data.CreatePa
 		},
 		Description:     description(model.OperationTypePayout, d.LangCode, d.OperationID),
 		PaymentMethodID: paymentMethodID,
+		IdempotenceKey:  payoutIdempotenceKey(d.OperationID),
 	}
 }
 
@@ -135,3 +136,9 @@ func locale(langCode string) string {
 	}
 	return loc
 }
+
+// payoutIdempotenceKey возвращает ключ идемпотентности выплаты. Ключ зависит только от операции, поэтому
+// повторный запрос после таймаута или неизвестного результата не создает во внешней системе вторую выплату.
+func payoutIdempotenceKey(operationID int64) string {
+	return fmt.Sprintf("payout-%d", operationID)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/bin"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/integration/config"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/integration/provider/yookassa/data"
@@ -16,24 +17,29 @@ type Channel interface {
 	PaymentTimeoutToFailed() time.Duration
 }
 
+// BINLookup ищет сведения о банковской карте по ее первым цифрам.
+type BINLookup interface {
+	Lookup(prefix string) (bin.Info, bool)
+}
+
 type Resolver struct {
 	channels map[string]Channel
 }
 
-func NewResolver(channelsConfigs map[string]config.YooKassaChannelConfig) Resolver {
+func NewResolver(channelsConfigs map[string]config.YooKassaChannelConfig, binLookup BINLookup) Resolver {
 	return Resolver{
-		channels: channels(channelsConfigs),
+		channels: channels(channelsConfigs, binLookup),
 	}
 }
 
-func channels(channelsConfigs map[string]config.YooKassaChannelConfig) map[string]Channel {
+func channels(channelsConfigs map[string]config.YooKassaChannelConfig, binLookup BINLookup) map[string]Channel {
 	var result map[string]Channel
 	if channelsNum := len(channelsConfigs); channelsNum > 0 {
 		result = make(map[string]Channel, channelsNum)
 		for externalMethod, channelCfg := range channelsConfigs {
 			switch channelCfg.Code {
 			case channelCodeBankCard:
-				result[externalMethod] = newBankCardChannel(channelCfg)
+				result[externalMethod] = newBankCardChannel(channelCfg, binLookup)
 			case channelCodeWallet:
 				result[externalMethod] = newWalletChannel(channelCfg)
 			default:
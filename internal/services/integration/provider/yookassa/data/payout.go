@@ -6,6 +6,8 @@ type CreatePayoutRequest struct {
 	Amount          Amount
 	Description     string
 	PaymentMethodID string
+	// IdempotenceKey - ключ идемпотентности, по которому внешняя система не создает выплату повторно
+	IdempotenceKey string
 }
 
 type CreatePayoutResponse struct {
@@ -31,7 +31,7 @@ type Integration struct {
 	payoutMethods   []model.Method
 }
 
-func NewIntegration(cfg *config.YooKassaConfig) *Integration {
+func NewIntegration(cfg *config.YooKassaConfig, binLookup channel.BINLookup) *Integration {
 	if cfg == nil {
 		return nil
 	}
@@ -44,7 +44,7 @@ func NewIntegration(cfg *config.YooKassaConfig) *Integration {
 		PayoutsSecretKey:  cfg.API.Payouts.SecretKey,
 	})
 
-	channelResolver := channel.NewResolver(cfg.Channels)
+	channelResolver := channel.NewResolver(cfg.Channels, binLookup)
 
 	return &Integration{
 		apiClient:       apiClient,
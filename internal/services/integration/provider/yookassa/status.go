@@ -14,7 +14,7 @@ func (i *Integration) GetOperationStatus(ctx context.Context, statusData model.G
 	var result model.GetOperationStatusResult
 
 	if statusData.ExternalID == "" {
-		slog.Warn("external id is empty", "operation_id", statusData.OperationID)
+		slog.WarnContext(ctx, "external id is empty", "operation_id", statusData.OperationID)
 
 		opExternalStatus, err := i.resolveExternalStatus(statusData.OperationType, statusData.ExternalMethod, statusData.CreatedAt, "", true)
 		if err != nil {
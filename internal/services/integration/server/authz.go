@@ -0,0 +1,22 @@
+package server
+
+import (
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+)
+
+var engineOnly = []string{pkggrpc.CallerEngine}
+
+// CallerPolicy - клиенты, которым разрешен вызов методов сервиса при включенном mTLS; в ней перечислены все методы сервиса.
+// С платежными системами работает только сервис engine.
+var CallerPolicy = pkggrpc.Policy{
+	healthpb.Health_Check_FullMethodName: engineOnly,
+	healthpb.Health_Watch_FullMethodName: nil,
+
+	pb.IntegrationService_AvailableMethods_FullMethodName:   engineOnly,
+	pb.IntegrationService_CreatePayment_FullMethodName:      engineOnly,
+	pb.IntegrationService_GetOperationStatus_FullMethodName: engineOnly,
+	pb.IntegrationService_CreatePayout_FullMethodName:       engineOnly,
+}
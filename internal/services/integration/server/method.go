@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"fmt"
 	"sync"
 
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
@@ -15,9 +14,9 @@ func (s *Server) AvailableMethods(ctx context.Context, request *pb.AvailableMeth
 	opType := convert.OperationTypeFromProto(request.GetOperationType())
 
 	if request.ExternalSystem != nil {
-		integration, ok := s.integrations[request.GetExternalSystem()]
-		if !ok || integration == nil {
-			return nil, fmt.Errorf("unknown external system: %q", request.GetExternalSystem())
+		integration, err := s.integration(request.GetMerchantId(), request.GetExternalSystem())
+		if err != nil {
+			return nil, err
 		}
 
 		methods, err := integration.AvailableMethods(ctx, opType, request.GetCurrency())
@@ -30,11 +29,16 @@ func (s *Server) AvailableMethods(ctx context.Context, request *pb.AvailableMeth
 		}, nil
 	}
 
+	integrations, err := s.merchantIntegrations(request.GetMerchantId())
+	if err != nil {
+		return nil, err
+	}
+
 	var wg sync.WaitGroup
 
 	methodsChan := make(chan []model.Method)
 
-	for _, integration := range s.integrations {
+	for _, integration := range integrations {
 		if integration != nil {
 			wg.Add(1)
 			go func(ctx context.Context, integration Integration, opType model.OperationType, currency string) {
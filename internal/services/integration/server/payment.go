@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"fmt"
 
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
@@ -10,9 +9,9 @@ import (
 )
 
 func (s *Server) CreatePayment(ctx context.Context, request *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
-	integration, ok := s.integrations[request.GetExternalSystem()]
-	if !ok || integration == nil {
-		return nil, fmt.Errorf("unknown external system: %q", request.GetExternalSystem())
+	integration, err := s.integration(request.GetMerchantId(), request.GetExternalSystem())
+	if err != nil {
+		return nil, err
 	}
 
 	data := model.CreatePaymentData{
@@ -22,6 +21,7 @@ func (s *Server) CreatePayment(ctx context.Context, request *pb.CreatePaymentReq
 		ExternalMethod: request.GetExternalMethod(),
 		Currency:       request.GetCurrency(),
 		LangCode:       request.GetLangCode(),
+		MerchantID:     model.MerchantIDOrDefault(request.GetMerchantId()),
 		UserID:         request.GetUserId(),
 		Amount:         request.GetAmount(),
 		OperationID:    request.GetOperationId(),
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"fmt"
 
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
@@ -10,9 +9,9 @@ import (
 )
 
 func (s *Server) CreatePayout(ctx context.Context, request *pb.CreatePayoutRequest) (*pb.CreatePayoutResponse, error) {
-	integration, ok := s.integrations[request.GetExternalSystem()]
-	if !ok || integration == nil {
-		return nil, fmt.Errorf("unknown external system: %q", request.GetExternalSystem())
+	integration, err := s.integration(request.GetMerchantId(), request.GetExternalSystem())
+	if err != nil {
+		return nil, err
 	}
 
 	tool := convert.ToolFromProto(request.GetTool())
@@ -24,6 +23,7 @@ func (s *Server) CreatePayout(ctx context.Context, request *pb.CreatePayoutReque
 		ExternalMethod: request.GetExternalMethod(),
 		Currency:       request.GetCurrency(),
 		LangCode:       request.GetLangCode(),
+		MerchantID:     model.MerchantIDOrDefault(request.GetMerchantId()),
 		UserID:         request.GetUserId(),
 		ToolID:         tool.ID,
 		Amount:         request.GetAmount(),
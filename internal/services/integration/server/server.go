@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	"google.golang.org/grpc"
@@ -17,17 +18,21 @@ type Integration interface {
 	CreatePayout(ctx context.Context, data model.CreatePayoutData) (model.CreatePayoutResult, error)
 }
 
+// MerchantIntegrations - интеграции с платежными системами в разрезе магазинов:
+// идентификатор магазина -> платежная система -> интеграция.
+type MerchantIntegrations map[string]map[string]Integration
+
 type Server struct {
 	server       *grpc.Server
 	listener     net.Listener
-	integrations map[string]Integration
+	integrations MerchantIntegrations
 	pb.UnimplementedIntegrationServiceServer
 }
 
 type Options struct {
 	Server       *grpc.Server
 	Listener     net.Listener
-	Integrations map[string]Integration
+	Integrations MerchantIntegrations
 }
 
 func NewServer(opts Options) *Server {
@@ -38,6 +43,30 @@ func NewServer(opts Options) *Server {
 	return &s
 }
 
+// merchantIntegrations возвращает интеграции магазина; пустой идентификатор соответствует магазину по умолчанию.
+func (s *Server) merchantIntegrations(merchantID string) (map[string]Integration, error) {
+	merchantID = model.MerchantIDOrDefault(merchantID)
+
+	integrations, ok := s.integrations[merchantID]
+	if !ok {
+		return nil, fmt.Errorf("unknown merchant: %q", merchantID)
+	}
+	return integrations, nil
+}
+
+func (s *Server) integration(merchantID, externalSystem string) (Integration, error) {
+	integrations, err := s.merchantIntegrations(merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	integration, ok := integrations[externalSystem]
+	if !ok || integration == nil {
+		return nil, fmt.Errorf("unknown external system %q for merchant %q", externalSystem, model.MerchantIDOrDefault(merchantID))
+	}
+	return integration, nil
+}
+
 func (s *Server) Serve() error {
 	return s.server.Serve(s.listener)
 }
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	pb "github.com/tmrrwnxtsn/ecomway/api/proto/integration"
@@ -11,9 +10,9 @@ import (
 )
 
 func (s *Server) GetOperationStatus(ctx context.Context, request *pb.GetOperationStatusRequest) (*pb.GetOperationStatusResponse, error) {
-	integration, ok := s.integrations[request.GetExternalSystem()]
-	if !ok || integration == nil {
-		return nil, fmt.Errorf("unknown external system: %q", request.GetExternalSystem())
+	integration, err := s.integration(request.GetMerchantId(), request.GetExternalSystem())
+	if err != nil {
+		return nil, err
 	}
 
 	data := model.GetOperationStatusData{
@@ -24,6 +23,7 @@ func (s *Server) GetOperationStatus(ctx context.Context, request *pb.GetOperatio
 		Currency:       request.GetCurrency(),
 		OperationType:  convert.OperationTypeFromProto(request.GetOperationType()),
 		OperationID:    request.GetOperationId(),
+		MerchantID:     model.MerchantIDOrDefault(request.GetMerchantId()),
 		UserID:         request.GetUserId(),
 		Amount:         request.GetAmount(),
 	}
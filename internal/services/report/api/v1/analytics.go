@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+const (
+	defaultAnalyticsGranularity = model.AnalyticsGranularityDay
+	defaultAnalyticsTimeZone    = "UTC"
+)
+
+type analyticsItem struct {
+	// Начало временного интервала в формате UNIX Timestamp
+	PeriodStart int64 `json:"period_start" example:"1715904000" validate:"required"`
+	// Начало временного интервала в формате RFC 3339 в запрошенном часовом поясе
+	Period string `json:"period" example:"2024-05-17T00:00:00+03:00" validate:"required"`
+	// Внутренний код платежной системы (передается при группировке по external_system)
+	ExternalSystem string `json:"external_system,omitempty" example:"yookassa"`
+	// Внутренний код платежного метода (передается при группировке по external_method)
+	ExternalMethod string `json:"external_method,omitempty" example:"yookassa_bank_card"`
+	// Валюта операций (объем всегда считается в разрезе валюты)
+	Currency string `json:"currency,omitempty" example:"RUB"`
+	// Тип операций (передается при группировке по type)
+	Type string `json:"type,omitempty" example:"payment"`
+	// Количество созданных операций
+	Created int64 `json:"created" example:"120" validate:"required"`
+	// Количество успешных операций
+	Succeeded int64 `json:"succeeded" example:"100" validate:"required"`
+	// Количество отклоненных операций
+	Failed int64 `json:"failed" example:"15" validate:"required"`
+	// Доля успешных операций среди созданных
+	ConversionRate float64 `json:"conversion_rate" example:"0.8333" validate:"required"`
+	// Медианное время от создания операции до её завершения на стороне платежной системы в секундах
+	MedianProcessingTime int64 `json:"median_processing_time" example:"42" validate:"required"`
+	// Сумма успешных операций
+	Volume float64 `json:"volume" example:"12100.50" validate:"required"`
+}
+
+type analyticsOperationsRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Размер временного интервала: "hour", "day" или "week" (по умолчанию - "day")
+	Granularity string `query:"granularity" example:"day"`
+	// Часовой пояс в формате базы IANA, в котором строятся интервалы (по умолчанию - "UTC")
+	TimeZone string `query:"time_zone" example:"Europe/Moscow"`
+	// Поля для группировки, перечисленные через запятую: "external_system", "external_method", "currency", "type"
+	GroupBy string `query:"group_by" example:"external_system,currency"`
+	// Тип операции
+	Type string `query:"type" example:"payment"`
+	// Внутренние коды платежных систем, перечисленные через запятую
+	ExternalSystems string `query:"external_systems" example:"yookassa"`
+	// Идентификатор магазина (по умолчанию - "default")
+	MerchantID string `query:"merchant_id" example:"default"`
+	// Время создания операции в формате UNIX Timestamp, с которого учитывать операции
+	CreatedAtFrom int64 `query:"created_at_from" example:"1715974447" validate:"required"`
+	// Время создания операции в формате UNIX Timestamp, до которого учитывать операции
+	CreatedAtTo int64 `query:"created_at_to" example:"1716974447" validate:"required"`
+}
+
+type analyticsOperationsResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив агрегированных показателей по временным интервалам
+	Items []analyticsItem `json:"items" validate:"required"`
+}
+
+// analyticsOperations godoc
+//
+//	@Summary	Получить агрегированные показатели по операциям в разрезе временных интервалов
+//	@Tags		Аналитика
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id				query		string						true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id			query		string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code			query		string						true	"Код языка, обозначение по RFC 5646"
+//	@Param		granularity			query		string						false	"Размер временного интервала: hour, day или week (по умолчанию - day)"
+//	@Param		time_zone			query		string						false	"Часовой пояс в формате базы IANA (по умолчанию - UTC)"
+//	@Param		group_by			query		string						false	"Поля для группировки, перечисленные через запятую: external_system, external_method, currency, type"
+//	@Param		type				query		string						false	"Тип операции"
+//	@Param		external_systems	query		string						false	"Внутренние коды платежных систем, перечисленные через запятую"
+//	@Param		merchant_id			query		string						false	"Идентификатор магазина (по умолчанию - default)"
+//	@Param		created_at_from		query		int							true	"Время создания операции в формате UNIX Timestamp, с которого учитывать операции"
+//	@Param		created_at_to		query		int							true	"Время создания операции в формате UNIX Timestamp, до которого учитывать операции"
+//	@Success	200					{object}	analyticsOperationsResponse	"Успешный ответ"
+//	@Failure	default				{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/analytics/operations [get]
+func (h *Handler) analyticsOperations(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req analyticsOperationsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	req.MerchantID = merchantFilter(c, req.MerchantID)
+
+	criteria, location, err := analyticsCriteriaFromRequest(req)
+	if err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	items, err := h.operationService.Analytics(ctx, criteria)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	resp := &analyticsOperationsResponse{
+		Success: true,
+		Items:   h.analyticsItems(items, location),
+	}
+
+	return c.JSON(resp)
+}
+
+func analyticsCriteriaFromRequest(req analyticsOperationsRequest) (model.OperationAnalyticsCriteria, *time.Location, error) {
+	criteria := model.OperationAnalyticsCriteria{
+		Granularity:   defaultAnalyticsGranularity,
+		TimeZone:      defaultAnalyticsTimeZone,
+		CreatedAtFrom: time.Unix(req.CreatedAtFrom, 0).UTC(),
+		CreatedAtTo:   time.Unix(req.CreatedAtTo, 0).UTC(),
+	}
+
+	if req.CreatedAtFrom > req.CreatedAtTo {
+		return model.OperationAnalyticsCriteria{}, nil, errors.New("created_at_from must not be greater than created_at_to")
+	}
+	if req.Granularity != "" {
+		switch g := model.AnalyticsGranularity(req.Granularity); g {
+		case model.AnalyticsGranularityHour, model.AnalyticsGranularityDay, model.AnalyticsGranularityWeek:
+			criteria.Granularity = g
+		default:
+			return model.OperationAnalyticsCriteria{}, nil, fmt.Errorf("unresolved granularity: %v", req.Granularity)
+		}
+	}
+	if req.TimeZone != "" {
+		criteria.TimeZone = req.TimeZone
+	}
+	location, err := time.LoadLocation(criteria.TimeZone)
+	if err != nil {
+		return model.OperationAnalyticsCriteria{}, nil, fmt.Errorf("unresolved time zone: %v", req.TimeZone)
+	}
+	if req.GroupBy != "" {
+		fields := strings.Split(req.GroupBy, ",")
+		for _, f := range fields {
+			switch field := model.AnalyticsGroupField(f); field {
+			case model.AnalyticsGroupFieldExternalSystem, model.AnalyticsGroupFieldExternalMethod,
+				model.AnalyticsGroupFieldCurrency, model.AnalyticsGroupFieldType:
+				criteria.GroupBy = append(criteria.GroupBy, field)
+			default:
+				return model.OperationAnalyticsCriteria{}, nil, fmt.Errorf("unresolved group field: %v", f)
+			}
+		}
+	}
+	if req.Type != "" {
+		switch t := model.OperationType(req.Type); t {
+		case model.OperationTypePayment, model.OperationTypePayout:
+			criteria.Types = &[]model.OperationType{t}
+		default:
+			return model.OperationAnalyticsCriteria{}, nil, fmt.Errorf("unresolved operation type: %v", req.Type)
+		}
+	}
+	if req.ExternalSystems != "" {
+		externalSystems := strings.Split(req.ExternalSystems, ",")
+		criteria.ExternalSystems = &externalSystems
+	}
+	if req.MerchantID != "" {
+		criteria.MerchantID = &req.MerchantID
+	}
+
+	return criteria, location, nil
+}
+
+func (h *Handler) analyticsItem(item model.OperationAnalyticsItem, location *time.Location) analyticsItem {
+	return analyticsItem{
+		PeriodStart:          item.PeriodStart.Unix(),
+		Period:               item.PeriodStart.In(location).Format(time.RFC3339),
+		ExternalSystem:       item.ExternalSystem,
+		ExternalMethod:       item.ExternalMethod,
+		Currency:             item.Currency,
+		Type:                 string(item.Type),
+		Created:              item.CreatedCount,
+		Succeeded:            item.SucceededCount,
+		Failed:               item.FailedCount,
+		ConversionRate:       item.ConversionRate(),
+		MedianProcessingTime: int64(item.MedianProcessingTime.Seconds()),
+		Volume:               convert.CentsToBase(item.SucceededAmount),
+	}
+}
+
+func (h *Handler) analyticsItems(items []model.OperationAnalyticsItem, location *time.Location) []analyticsItem {
+	result := make([]analyticsItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, h.analyticsItem(item, location))
+	}
+	return result
+}
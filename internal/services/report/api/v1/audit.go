@@ -0,0 +1,236 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gocarina/gocsv"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type auditEntry struct {
+	// Идентификатор записи журнала аудита
+	ID int64 `json:"id" csv:"id" example:"1" validate:"required"`
+	// Идентификатор специалиста, выполнившего действие
+	ActorID string `json:"actor_id" csv:"actor_id" example:"1" validate:"required"`
+	// Действие:
+	// * Восстановление платежного средства - "TOOL_RECOVER"
+	// * Удаление платежного средства - "TOOL_REMOVE"
+	// * Изменение статуса операции - "OPERATION_CHANGE_STATUS"
+	// * Добавление записи в список блокировок - "BLOCK_ENTRY_CREATE"
+	// * Изменение записи списка блокировок - "BLOCK_ENTRY_UPDATE"
+	// * Удаление записи из списка блокировок - "BLOCK_ENTRY_DELETE"
+	Action string `json:"action" csv:"action" example:"TOOL_REMOVE" validate:"required"`
+	// Тип объекта: "TOOL", "OPERATION" или "BLOCK_ENTRY"
+	TargetType string `json:"target_type" csv:"target_type" example:"TOOL" validate:"required"`
+	// Идентификатор объекта
+	TargetID string `json:"target_id" csv:"target_id" example:"2dc32aa0-000f-5000-8000-16d7bc6cd09f" validate:"required"`
+	// Идентификатор клиента, которому принадлежит объект
+	ClientID string `json:"client_id" csv:"client_id" example:"1" validate:"required"`
+	// Идентификатор магазина
+	MerchantID string `json:"merchant_id" csv:"merchant_id" example:"default" validate:"required"`
+	// Состояние объекта до действия
+	Before map[string]string `json:"before" csv:"-" validate:"required"`
+	// Состояние объекта после действия
+	After map[string]string `json:"after" csv:"-" validate:"required"`
+	// Причина действия
+	Reason string `json:"reason" csv:"reason" example:"Подозрение на мошенничество"`
+	// Время действия в формате UNIX Timestamp
+	CreatedAt int64 `json:"created_at" csv:"created_at" example:"1715974447" validate:"required"`
+
+	// BeforeCSV и AfterCSV - состояния объекта в виде "ключ=значение" через точку с запятой для выгрузки в CSV
+	BeforeCSV string `json:"-" csv:"before" swaggerignore:"true"`
+	AfterCSV  string `json:"-" csv:"after" swaggerignore:"true"`
+}
+
+type auditListRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Идентификатор специалиста, выполнившего действие
+	ActorID string `query:"actor_id" example:"1"`
+	// Действие
+	Action string `query:"action" example:"TOOL_REMOVE"`
+	// Тип объекта
+	TargetType string `query:"target_type" example:"TOOL"`
+	// Идентификатор объекта
+	TargetID string `query:"target_id" example:"2dc32aa0-000f-5000-8000-16d7bc6cd09f"`
+	// Идентификатор клиента
+	ClientID string `query:"client_id" example:"1"`
+	// Идентификатор магазина
+	MerchantID string `query:"merchant_id" example:"default"`
+	// Время действия в формате UNIX Timestamp, с которого возвращать результат
+	CreatedAtFrom int64 `query:"created_at_from" example:"1715974447"`
+	// Время действия в формате UNIX Timestamp, до которого возвращать результат
+	CreatedAtTo int64 `query:"created_at_to" example:"1715974447"`
+	// Флаг о необходимости вернуть результат в формате CSV (по умолчанию - false)
+	CSV bool `query:"csv" example:"true"`
+}
+
+type auditListResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив записей журнала аудита, подходящих под фильтры запроса
+	Entries []auditEntry `json:"entries" validate:"required"`
+}
+
+// auditList godoc
+//
+//	@Summary	Получить записи журнала аудита административных действий
+//	@Tags		Аудит
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id			query		string				true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id		query		string				true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code		query		string				true	"Код языка, обозначение по RFC 5646"
+//	@Param		actor_id		query		string				false	"Идентификатор специалиста, выполнившего действие"
+//	@Param		action			query		string				false	"Действие (TOOL_RECOVER, TOOL_REMOVE, OPERATION_CHANGE_STATUS, BLOCK_ENTRY_CREATE, BLOCK_ENTRY_UPDATE, BLOCK_ENTRY_DELETE)"
+//	@Param		target_type		query		string				false	"Тип объекта (TOOL, OPERATION, BLOCK_ENTRY)"
+//	@Param		target_id		query		string				false	"Идентификатор объекта"
+//	@Param		client_id		query		string				false	"Идентификатор клиента"
+//	@Param		merchant_id		query		string				false	"Идентификатор магазина"
+//	@Param		created_at_from	query		int					false	"Время действия в формате UNIX Timestamp, с которого возвращать результат"
+//	@Param		created_at_to	query		int					false	"Время действия в формате UNIX Timestamp, до которого возвращать результат"
+//	@Param		csv				query		boolean				false	"Флаг о необходимости вернуть результат в формате CSV (по умолчанию - false)"
+//	@Success	200				{object}	auditListResponse	"Успешный ответ"
+//	@Failure	default			{object}	errorResponse		"Ответ с ошибкой"
+//	@Router		/audit [get]
+func (h *Handler) auditList(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req auditListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	req.MerchantID = merchantFilter(c, req.MerchantID)
+
+	criteria, err := auditListCriteriaFromRequest(req)
+	if err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	entries, err := h.auditService.Entries(ctx, criteria)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	respEntries := h.auditEntries(entries)
+
+	if req.CSV {
+		resp := &bytes.Buffer{}
+
+		if err = gocsv.Marshal(&respEntries, resp); err != nil {
+			return h.internalErrorResponse(c, req.LangCode, err)
+		}
+
+		filename := fmt.Sprintf("audit-%v.csv", time.Now().UTC().Format(`02_01_2006`))
+
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%v", filename))
+		c.Set(fiber.HeaderContentType, "text/csv")
+
+		return c.Send(resp.Bytes())
+	}
+
+	return c.JSON(&auditListResponse{
+		Success: true,
+		Entries: respEntries,
+	})
+}
+
+func auditListCriteriaFromRequest(req auditListRequest) (model.AuditEntryCriteria, error) {
+	var criteria model.AuditEntryCriteria
+
+	if req.ActorID != "" {
+		criteria.ActorID = &req.ActorID
+	}
+	if req.Action != "" {
+		switch action := model.AuditAction(req.Action); action {
+		case model.AuditActionToolRecover, model.AuditActionToolRemove, model.AuditActionOperationChangeStatus,
+			model.AuditActionBlockEntryCreate, model.AuditActionBlockEntryUpdate, model.AuditActionBlockEntryDelete:
+			criteria.Action = &action
+		default:
+			return model.AuditEntryCriteria{}, fmt.Errorf("unresolved action: %v", req.Action)
+		}
+	}
+	if req.TargetType != "" {
+		switch targetType := model.AuditTargetType(req.TargetType); targetType {
+		case model.AuditTargetTypeTool, model.AuditTargetTypeOperation, model.AuditTargetTypeBlockEntry:
+			criteria.TargetType = &targetType
+		default:
+			return model.AuditEntryCriteria{}, fmt.Errorf("unresolved target type: %v", req.TargetType)
+		}
+	}
+	if req.TargetID != "" {
+		criteria.TargetID = &req.TargetID
+	}
+	if req.ClientID != "" {
+		criteria.ClientID = &req.ClientID
+	}
+	if req.MerchantID != "" {
+		criteria.MerchantID = &req.MerchantID
+	}
+	if req.CreatedAtFrom > 0 {
+		criteria.CreatedAtFrom = time.Unix(req.CreatedAtFrom, 0).UTC()
+	}
+	if req.CreatedAtTo > 0 {
+		criteria.CreatedAtTo = time.Unix(req.CreatedAtTo, 0).UTC()
+	}
+
+	return criteria, nil
+}
+
+func (h *Handler) auditEntry(entry *model.AuditEntry) auditEntry {
+	return auditEntry{
+		ID:         entry.ID,
+		ActorID:    entry.ActorID,
+		Action:     string(entry.Action),
+		TargetType: string(entry.TargetType),
+		TargetID:   entry.TargetID,
+		ClientID:   entry.ClientID,
+		MerchantID: entry.MerchantID,
+		Before:     entry.Before,
+		After:      entry.After,
+		Reason:     entry.Reason,
+		CreatedAt:  entry.CreatedAt.UTC().Unix(),
+		BeforeCSV:  auditStateCSV(entry.Before),
+		AfterCSV:   auditStateCSV(entry.After),
+	}
+}
+
+func (h *Handler) auditEntries(items []*model.AuditEntry) []auditEntry {
+	var entries []auditEntry
+	if itemNum := len(items); itemNum > 0 {
+		entries = make([]auditEntry, 0, itemNum)
+		for _, item := range items {
+			entries = append(entries, h.auditEntry(item))
+		}
+	}
+	return entries
+}
+
+func auditStateCSV(state map[string]string) string {
+	keys := make([]string, 0, len(state))
+	for key := range state {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+state[key])
+	}
+	return strings.Join(pairs, ";")
+}
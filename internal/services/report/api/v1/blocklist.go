@@ -0,0 +1,388 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type blockEntry struct {
+	// Идентификатор записи
+	ID int64 `json:"id" example:"1" validate:"required"`
+	// Идентификатор магазина
+	MerchantID string `json:"merchant_id" example:"default" validate:"required"`
+	// Признак блокировки:
+	// * Клиент - "USER"
+	// * Отпечаток банковской карты (идентификатор платежного средства) - "CARD"
+	// * Диапазон BIN банковских карт - "BIN_RANGE"
+	// * Номер электронного кошелька - "WALLET"
+	// * Адрес электронной почты - "EMAIL"
+	// * IP-адрес или подсеть в нотации CIDR - "IP"
+	Type string `json:"type" example:"USER" validate:"required"`
+	// Значение признака (для BIN_RANGE - нижняя граница диапазона)
+	Value string `json:"value" example:"1" validate:"required"`
+	// Верхняя граница диапазона BIN
+	ValueTo string `json:"value_to,omitempty" example:"220299"`
+	// Причина блокировки
+	Reason string `json:"reason" example:"Подозрение на мошенничество" validate:"required"`
+	// Идентификатор специалиста, добавившего запись
+	CreatedBy string `json:"created_by" example:"1" validate:"required"`
+	// Время окончания действия записи в формате UNIX Timestamp (отсутствует у бессрочных записей)
+	ExpiresAt int64 `json:"expires_at,omitempty" example:"1715974447"`
+	// Флаг, что запись действует
+	Active bool `json:"active" example:"true"`
+	// Время создания записи в формате UNIX Timestamp
+	CreatedAt int64 `json:"created_at" example:"1715974447" validate:"required"`
+	// Время последнего обновления записи в формате UNIX Timestamp
+	UpdatedAt int64 `json:"updated_at" example:"1715974447" validate:"required"`
+}
+
+type blockListRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Идентификатор магазина
+	MerchantID string `query:"merchant_id" example:"default"`
+	// Признак блокировки
+	Type string `query:"type" example:"USER" validate:"omitempty,oneof=USER CARD BIN_RANGE WALLET EMAIL IP"`
+	// Значение признака
+	Value string `query:"value" example:"1"`
+	// Флаг о необходимости вернуть только действующие записи (по умолчанию - false)
+	ActiveOnly bool `query:"active_only" example:"true"`
+}
+
+type blockListResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив записей списка блокировок, подходящих под фильтры запроса
+	Entries []blockEntry `json:"entries" validate:"required"`
+}
+
+// blockList godoc
+//
+//	@Summary	Получить записи списка блокировок
+//	@Tags		Список блокировок
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id		query		string				true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id	query		string				true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code	query		string				true	"Код языка, обозначение по RFC 5646"
+//	@Param		merchant_id	query		string				false	"Идентификатор магазина"
+//	@Param		type		query		string				false	"Признак блокировки (USER, CARD, BIN_RANGE, WALLET, EMAIL, IP)"
+//	@Param		value		query		string				false	"Значение признака"
+//	@Param		active_only	query		boolean				false	"Флаг о необходимости вернуть только действующие записи (по умолчанию - false)"
+//	@Success	200			{object}	blockListResponse	"Успешный ответ"
+//	@Failure	default		{object}	errorResponse		"Ответ с ошибкой"
+//	@Router		/block-list [get]
+func (h *Handler) blockList(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req blockListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	criteria := model.BlockEntryCriteria{
+		ActiveOnly: req.ActiveOnly,
+	}
+	if merchantID := merchantFilter(c, req.MerchantID); merchantID != "" {
+		criteria.MerchantID = &merchantID
+	}
+	if req.Type != "" {
+		entryType := model.BlockEntryType(req.Type)
+		criteria.Type = &entryType
+	}
+	if req.Value != "" {
+		criteria.Value = &req.Value
+	}
+
+	entries, err := h.blockListService.Entries(ctx, criteria)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&blockListResponse{
+		Success: true,
+		Entries: h.blockEntries(c, entries),
+	})
+}
+
+type blockEntryCreateRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста поддержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+	// Идентификатор магазина (по умолчанию - "default")
+	MerchantID string `json:"merchant_id" example:"default"`
+	// Признак блокировки: "USER", "CARD", "BIN_RANGE", "WALLET", "EMAIL" или "IP"
+	Type string `json:"type" example:"BIN_RANGE" validate:"required,oneof=USER CARD BIN_RANGE WALLET EMAIL IP"`
+	// Значение признака (для BIN_RANGE - нижняя граница диапазона из шести цифр)
+	Value string `json:"value" example:"220200" validate:"required"`
+	// Верхняя граница диапазона BIN (по умолчанию совпадает с нижней)
+	ValueTo string `json:"value_to" example:"220299"`
+	// Время окончания действия записи в формате UNIX Timestamp (по умолчанию - бессрочно)
+	ExpiresAt int64 `json:"expires_at" example:"1715974447" validate:"omitempty,gte=0"`
+	// Причина блокировки, сохраняется в записи и в журнале аудита
+	Reason string `json:"reason" example:"Подозрение на мошенничество" validate:"required"`
+}
+
+type blockEntryResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Запись списка блокировок
+	Entry blockEntry `json:"entry" validate:"required"`
+}
+
+// blockEntryCreate godoc
+//
+//	@Summary	Добавить запись в список блокировок
+//	@Tags		Список блокировок
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		input	body		blockEntryCreateRequest	true	"Тело запроса"
+//	@Success	200		{object}	blockEntryResponse		"Успешный ответ"
+//	@Failure	default	{object}	errorResponse			"Ответ с ошибкой"
+//	@Router		/block-list [post]
+func (h *Handler) blockEntryCreate(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req blockEntryCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	entry := &model.BlockEntry{
+		MerchantID: model.MerchantIDOrDefault(merchantFilter(c, req.MerchantID)),
+		Type:       model.BlockEntryType(req.Type),
+		Value:      req.Value,
+		ValueTo:    req.ValueTo,
+	}
+	if req.ExpiresAt > 0 {
+		entry.ExpiresAt = time.Unix(req.ExpiresAt, 0).UTC()
+	}
+
+	if err := entry.Normalize(); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	audit := model.AuditData{
+		ActorID: req.UserID,
+		Reason:  req.Reason,
+	}
+
+	created, err := h.blockListService.Create(ctx, entry, audit)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&blockEntryResponse{
+		Success: true,
+		Entry:   h.blockEntry(c, created),
+	})
+}
+
+type blockEntryUpdateRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста поддержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+	// Идентификатор магазина
+	MerchantID string `json:"merchant_id" example:"default"`
+	// Новое время окончания действия записи в формате UNIX Timestamp (0 - бессрочно)
+	ExpiresAt int64 `json:"expires_at" example:"1715974447" validate:"omitempty,gte=0"`
+	// Новая причина блокировки, сохраняется в записи и в журнале аудита
+	Reason string `json:"reason" example:"Клиент подтвердил личность, блокировка до конца проверки" validate:"required"`
+}
+
+// blockEntryUpdate godoc
+//
+//	@Summary	Изменить срок действия и причину записи списка блокировок
+//	@Tags		Список блокировок
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		int						true	"Идентификатор записи"
+//	@Param		input	body		blockEntryUpdateRequest	true	"Тело запроса"
+//	@Success	200		{object}	blockEntryResponse		"Успешный ответ"
+//	@Failure	default	{object}	errorResponse			"Ответ с ошибкой"
+//	@Router		/block-list/{id} [put]
+func (h *Handler) blockEntryUpdate(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req blockEntryUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	entryID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		err = fmt.Errorf("failed to parse id as int: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt > 0 {
+		expiresAt = time.Unix(req.ExpiresAt, 0).UTC()
+	}
+
+	audit := model.AuditData{
+		ActorID: req.UserID,
+		Reason:  req.Reason,
+	}
+
+	updated, err := h.blockListService.Update(ctx, entryID, blockEntryMerchantFilter(c, req.MerchantID), expiresAt, audit)
+	if err != nil {
+		return h.blockEntryErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&blockEntryResponse{
+		Success: true,
+		Entry:   h.blockEntry(c, updated),
+	})
+}
+
+type blockEntryDeleteRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста поддержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+	// Идентификатор магазина
+	MerchantID string `json:"merchant_id" example:"default"`
+	// Причина удаления, сохраняется в журнале аудита
+	Reason string `json:"reason" example:"Блокировка установлена ошибочно" validate:"required"`
+}
+
+type blockEntryDeleteResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+}
+
+// blockEntryDelete godoc
+//
+//	@Summary	Удалить запись из списка блокировок
+//	@Tags		Список блокировок
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		int							true	"Идентификатор записи"
+//	@Param		input	body		blockEntryDeleteRequest		true	"Тело запроса"
+//	@Success	200		{object}	blockEntryDeleteResponse	"Успешный ответ"
+//	@Failure	default	{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/block-list/{id} [delete]
+func (h *Handler) blockEntryDelete(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req blockEntryDeleteRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	entryID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		err = fmt.Errorf("failed to parse id as int: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	audit := model.AuditData{
+		ActorID: req.UserID,
+		Reason:  req.Reason,
+	}
+
+	if err = h.blockListService.Delete(ctx, entryID, blockEntryMerchantFilter(c, req.MerchantID), audit); err != nil {
+		return h.blockEntryErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&blockEntryDeleteResponse{
+		Success: true,
+	})
+}
+
+// blockEntryMerchantFilter возвращает магазин, записи которого доступны для изменения, или nil, если доступны записи всех магазинов.
+func blockEntryMerchantFilter(c *fiber.Ctx, requested string) *string {
+	if merchantID := merchantFilter(c, requested); merchantID != "" {
+		return &merchantID
+	}
+	return nil
+}
+
+func (h *Handler) blockEntryErrorResponse(c *fiber.Ctx, langCode string, err error) error {
+	var perr *perror.Error
+	if errors.As(err, &perr) {
+		if perr.Group == perror.GroupInternal && perr.Code == perror.CodeObjectNotFound {
+			return h.objectNotFoundErrorResponse(c, langCode, perr)
+		}
+	}
+	return h.internalErrorResponse(c, langCode, err)
+}
+
+// blockEntry конвертирует запись в ответ; адреса электронной почты и номера кошельков видны без маскирования
+// только специалистам с правом просмотра чувствительных данных.
+func (h *Handler) blockEntry(c *fiber.Ctx, item *model.BlockEntry) blockEntry {
+	e := blockEntry{
+		ID:         item.ID,
+		MerchantID: item.MerchantID,
+		Type:       string(item.Type),
+		Value:      item.Value,
+		ValueTo:    item.ValueTo,
+		Reason:     item.Reason,
+		CreatedBy:  item.CreatedBy,
+		Active:     item.Active(time.Now()),
+		CreatedAt:  item.CreatedAt.UTC().Unix(),
+		UpdatedAt:  item.UpdatedAt.UTC().Unix(),
+	}
+	if !item.ExpiresAt.IsZero() {
+		e.ExpiresAt = item.ExpiresAt.UTC().Unix()
+	}
+
+	switch item.Type {
+	case model.BlockEntryTypeEmail, model.BlockEntryTypeWallet:
+		if !hasPermission(c, permissionSensitiveView) {
+			e.Value = maskValue(e.Value)
+		}
+	}
+
+	return e
+}
+
+func (h *Handler) blockEntries(c *fiber.Ctx, items []*model.BlockEntry) []blockEntry {
+	var entries []blockEntry
+	if itemNum := len(items); itemNum > 0 {
+		entries = make([]blockEntry, 0, itemNum)
+		for _, item := range items {
+			entries = append(entries, h.blockEntry(c, item))
+		}
+	}
+	return entries
+}
@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type deadLetterOperation struct {
+	// Идентификатор операции
+	OperationID int64 `json:"operation_id" example:"1" validate:"required"`
+	// Тип операции
+	OperationType string `json:"operation_type" example:"payout" validate:"required"`
+	// Внутренний статус операции
+	OperationStatus string `json:"operation_status" example:"CONFIRMED" validate:"required"`
+	// Внутренний код платежной системы
+	ExternalSystem string `json:"external_system" example:"yookassa" validate:"required"`
+	// Внутренний код платежного метода платежной системы
+	ExternalMethod string `json:"external_method" example:"yookassa_bank_card" validate:"required"`
+	// Название фоновой задачи, обрабатывавшей операцию
+	TaskName string `json:"task_name" example:"request_payouts" validate:"required"`
+	// Количество неудачных попыток обработки подряд
+	Attempts int `json:"attempts" example:"5" validate:"required"`
+	// Ошибка последней попытки обработки
+	LastError string `json:"last_error" example:"request payout from integration: context deadline exceeded" validate:"required"`
+	// Время последней попытки обработки в формате UNIX Timestamp
+	LastAttemptAt int64 `json:"last_attempt_at" example:"1715974447" validate:"required"`
+	// Время перевода операции в очередь недоставленных в формате UNIX Timestamp
+	DeadLetteredAt int64 `json:"dead_lettered_at" example:"1715974447" validate:"required"`
+}
+
+type operationDeadLetterListRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Идентификатор операции
+	OperationID int64 `query:"operation_id" example:"1"`
+	// Идентификатор магазина
+	MerchantID string `query:"merchant_id" example:"default"`
+	// Максимальное количество операций в ответе (по умолчанию - 1000)
+	MaxCount int64 `query:"max_count" example:"100" validate:"omitempty,gte=1"`
+}
+
+type operationDeadLetterListResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив операций, исчерпавших лимит попыток обработки
+	Operations []deadLetterOperation `json:"operations" validate:"required"`
+}
+
+// operationDeadLetterList godoc
+//
+//	@Summary		Получить список операций, исчерпавших лимит попыток фоновой обработки
+//	@Description	Такие операции не обрабатываются планировщиком, пока их не вернут в обработку или не завершат вручную
+//	@Tags			Операции
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			user_id			query		string							true	"Идентификатор специалиста техподдержки"
+//	@Param			session_id		query		string							true	"Идентификатор сессии специалиста техподдержки"
+//	@Param			lang_code		query		string							true	"Код языка, обозначение по RFC 5646"
+//	@Param			operation_id	query		int								false	"Идентификатор операции"
+//	@Param			merchant_id		query		string							false	"Идентификатор магазина"
+//	@Param			max_count		query		int								false	"Максимальное количество операций в ответе (по умолчанию - 1000)"
+//	@Success		200				{object}	operationDeadLetterListResponse	"Успешный ответ"
+//	@Failure		default			{object}	errorResponse					"Ответ с ошибкой"
+//	@Router			/operation/dead-letter [get]
+func (h *Handler) operationDeadLetterList(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req operationDeadLetterListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	criteria := model.OperationAttemptCriteria{
+		MaxCount: req.MaxCount,
+	}
+	if req.OperationID > 0 {
+		criteria.OperationID = &req.OperationID
+	}
+	if merchantID := merchantFilter(c, req.MerchantID); merchantID != "" {
+		criteria.MerchantID = &merchantID
+	}
+
+	attempts, err := h.operationService.DeadLetters(ctx, criteria)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	respOperations := make([]deadLetterOperation, 0, len(attempts))
+	for _, attempt := range attempts {
+		respOperations = append(respOperations, deadLetterOperation{
+			OperationID:     attempt.OperationID,
+			OperationType:   string(attempt.OperationType),
+			OperationStatus: string(attempt.OperationStatus),
+			ExternalSystem:  attempt.ExternalSystem,
+			ExternalMethod:  attempt.ExternalMethod,
+			TaskName:        attempt.TaskName,
+			Attempts:        attempt.Attempts,
+			LastError:       attempt.LastError,
+			LastAttemptAt:   attempt.LastAttemptAt.Unix(),
+			DeadLetteredAt:  attempt.DeadLetteredAt.Unix(),
+		})
+	}
+
+	return c.JSON(&operationDeadLetterListResponse{
+		Success:    true,
+		Operations: respOperations,
+	})
+}
+
+type operationDeadLetterRetryRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+}
+
+type operationDeadLetterResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+}
+
+// operationDeadLetterRetry godoc
+//
+//	@Summary	Вернуть операцию, исчерпавшую лимит попыток, в фоновую обработку
+//	@Tags		Операции
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		int								true	"Идентификатор операции"
+//	@Param		input	body		operationDeadLetterRetryRequest	true	"Тело запроса"
+//	@Success	200		{object}	operationDeadLetterResponse		"Успешный ответ"
+//	@Failure	default	{object}	errorResponse					"Ответ с ошибкой"
+//	@Router		/operation/{id}/dead-letter/retry [put]
+func (h *Handler) operationDeadLetterRetry(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req operationDeadLetterRetryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	opID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		err = fmt.Errorf("failed to parse id as int: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err = h.operationService.RetryDeadLetter(ctx, opID, merchantScope(c)); err != nil {
+		return h.operationDeadLetterErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&operationDeadLetterResponse{
+		Success: true,
+	})
+}
+
+type operationDeadLetterResolveRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+	// Итоговый внутренний статус операции
+	NewStatus string `json:"new_status" example:"FAILED" validate:"required,oneof=SUCCESS FAILED"`
+	// Итоговый статус операции на стороне ПС
+	NewExternalStatus string `json:"new_external_status" example:"FAILED" validate:"required"`
+}
+
+// operationDeadLetterResolve godoc
+//
+//	@Summary	Вручную завершить операцию, исчерпавшую лимит попыток фоновой обработки
+//	@Tags		Операции
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id		path		int									true	"Идентификатор операции"
+//	@Param		input	body		operationDeadLetterResolveRequest	true	"Тело запроса"
+//	@Success	200		{object}	operationDeadLetterResponse			"Успешный ответ"
+//	@Failure	default	{object}	errorResponse						"Ответ с ошибкой"
+//	@Router		/operation/{id}/dead-letter/resolve [put]
+func (h *Handler) operationDeadLetterResolve(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req operationDeadLetterResolveRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	opID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		err = fmt.Errorf("failed to parse id as int: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	newStatus := model.OperationStatus(req.NewStatus)
+	newExternalStatus := model.OperationExternalStatus(req.NewExternalStatus)
+
+	if err = h.operationService.ResolveDeadLetter(ctx, opID, merchantScope(c), newStatus, newExternalStatus); err != nil {
+		return h.operationDeadLetterErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&operationDeadLetterResponse{
+		Success: true,
+	})
+}
+
+func (h *Handler) operationDeadLetterErrorResponse(c *fiber.Ctx, langCode string, err error) error {
+	var perr *perror.Error
+	if errors.As(err, &perr) && perr.Group == perror.GroupInternal {
+		switch perr.Code {
+		case perror.CodeObjectNotFound:
+			return h.objectNotFoundErrorResponse(c, langCode, perr)
+		case perror.CodeUnresolvedStatusConflict:
+			return h.unresolvedObjectStatusErrorResponse(c, langCode, perr)
+		}
+	}
+	return h.internalErrorResponse(c, langCode, err)
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type statusDrift struct {
+	// Идентификатор записи о расхождении
+	ID int64 `json:"id" example:"1" validate:"required"`
+	// Идентификатор операции
+	OperationID int64 `json:"operation_id" example:"1" validate:"required"`
+	// Тип операции
+	OperationType string `json:"operation_type" example:"payment" validate:"required"`
+	// Внутренний код платежной системы
+	ExternalSystem string `json:"external_system" example:"yookassa" validate:"required"`
+	// Внутренний код платежного метода платежной системы
+	ExternalMethod string `json:"external_method" example:"yookassa_bank_card" validate:"required"`
+	// Внутренний статус операции на момент обнаружения расхождения
+	Status string `json:"status" example:"SUCCESS" validate:"required"`
+	// Сохраненный статус операции на стороне платежной системы
+	ExternalStatus string `json:"external_status,omitempty" example:"SUCCESS"`
+	// Актуальный статус операции на стороне платежной системы
+	ProviderStatus string `json:"provider_status" example:"FAILED" validate:"required"`
+	// Флаг о том, что расхождение было исправлено автоматически
+	Fixed bool `json:"fixed" example:"false" validate:"required"`
+	// Причина, по которой расхождение не удалось исправить автоматически
+	FixError string `json:"fix_error,omitempty" example:"status transition is not supported"`
+	// Время обнаружения расхождения в формате UNIX Timestamp
+	DetectedAt int64 `json:"detected_at" example:"1715974447" validate:"required"`
+}
+
+type operationDriftListRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Идентификатор операции
+	OperationID int64 `query:"operation_id" example:"1"`
+	// Идентификатор магазина
+	MerchantID string `query:"merchant_id" example:"default"`
+	// Фильтр по признаку автоматического исправления
+	Fixed *bool `query:"fixed" example:"false"`
+	// Время обнаружения расхождения в формате UNIX Timestamp, с которого возвращать результат
+	DetectedAtFrom int64 `query:"detected_at_from" example:"1715974447"`
+	// Время обнаружения расхождения в формате UNIX Timestamp, до которого возвращать результат
+	DetectedAtTo int64 `query:"detected_at_to" example:"1715974447"`
+}
+
+type operationDriftListResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив обнаруженных расхождений статусов
+	Drifts []statusDrift `json:"drifts" validate:"required"`
+}
+
+// operationDriftList godoc
+//
+//	@Summary	Получить список расхождений статусов операций с платежными системами
+//	@Tags		Операции
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id				query		string						true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id			query		string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code			query		string						true	"Код языка, обозначение по RFC 5646"
+//	@Param		operation_id		query		int							false	"Идентификатор операции"
+//	@Param		merchant_id			query		string						false	"Идентификатор магазина"
+//	@Param		fixed				query		boolean						false	"Фильтр по признаку автоматического исправления"
+//	@Param		detected_at_from	query		int							false	"Время обнаружения расхождения в формате UNIX Timestamp, с которого возвращать результат"
+//	@Param		detected_at_to		query		int							false	"Время обнаружения расхождения в формате UNIX Timestamp, до которого возвращать результат"
+//	@Success	200					{object}	operationDriftListResponse	"Успешный ответ"
+//	@Failure	default				{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/operation/drift [get]
+func (h *Handler) operationDriftList(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req operationDriftListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	criteria := model.OperationStatusDriftCriteria{
+		Fixed: req.Fixed,
+	}
+	if req.OperationID > 0 {
+		criteria.OperationID = &req.OperationID
+	}
+	if merchantID := merchantFilter(c, req.MerchantID); merchantID != "" {
+		criteria.MerchantID = &merchantID
+	}
+	if req.DetectedAtFrom > 0 {
+		criteria.DetectedAtFrom = time.Unix(req.DetectedAtFrom, 0).UTC()
+	}
+	if req.DetectedAtTo > 0 {
+		criteria.DetectedAtTo = time.Unix(req.DetectedAtTo, 0).UTC()
+	}
+
+	drifts, err := h.operationService.StatusDrifts(ctx, criteria)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	respDrifts := make([]statusDrift, 0, len(drifts))
+	for _, drift := range drifts {
+		respDrifts = append(respDrifts, statusDrift{
+			ID:             drift.ID,
+			OperationID:    drift.OperationID,
+			OperationType:  string(drift.OperationType),
+			ExternalSystem: drift.ExternalSystem,
+			ExternalMethod: drift.ExternalMethod,
+			Status:         string(drift.Status),
+			ExternalStatus: string(drift.ExternalStatus),
+			ProviderStatus: string(drift.ProviderStatus),
+			Fixed:          drift.Fixed,
+			FixError:       drift.FixError,
+			DetectedAt:     drift.DetectedAt.Unix(),
+		})
+	}
+
+	return c.JSON(&operationDriftListResponse{
+		Success: true,
+		Drifts:  respDrifts,
+	})
+}
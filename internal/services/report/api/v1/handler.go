@@ -2,8 +2,10 @@ package v1
 
 import (
 	"context"
+	"io"
 	"reflect"
 	"strings"
+	"time"
 
 	swagger "github.com/arsmn/fiber-swagger/v2"
 	"github.com/go-playground/validator/v10"
@@ -11,14 +13,32 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/recover"
 
 	_ "github.com/tmrrwnxtsn/ecomway/api/swagger/report/v1" // generated by Swag CLI, you have to import it
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/middleware"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+)
+
+// Области доступа ключей клиентов.
+const (
+	scopeOperation      = "operation"
+	scopeAnalytics      = "analytics"
+	scopeReconciliation = "reconciliation"
+	scopeScheduler      = "scheduler"
+	scopeTool           = "tool"
+	scopeAudit          = "audit"
+	scopeBlockList      = "block_list"
 )
 
 type OperationService interface {
 	ReportOperations(ctx context.Context, criteria model.OperationCriteria) ([]model.ReportOperation, error)
-	GetExternalOperationStatus(ctx context.Context, id int64) (model.OperationExternalStatus, error)
-	ChangeStatus(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error
+	GetExternalOperationStatus(ctx context.Context, id int64, merchantID *string) (model.OperationExternalStatus, error)
+	ChangeStatus(ctx context.Context, id int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus, audit model.AuditData) error
+	Analytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error)
+	StatusDrifts(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error)
+	DeadLetters(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error)
+	RetryDeadLetter(ctx context.Context, id int64, merchantID *string) error
+	ResolveDeadLetter(ctx context.Context, id int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error
 }
 
 type SortingService interface {
@@ -30,9 +50,44 @@ type SummaryService interface {
 }
 
 type ToolService interface {
-	AllTools(ctx context.Context, userID string) ([]*model.Tool, error)
-	RecoverTool(ctx context.Context, id string, userID string, externalMethod string) error
-	RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error
+	AllTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error)
+	RecoverTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error
+	RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error
+}
+
+type ReconciliationService interface {
+	ParseRegistry(format string, r io.Reader) ([]model.RegistryItem, error)
+	Reconcile(ctx context.Context, data model.ReconcileData) (*model.ReconciliationRun, error)
+	Runs(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error)
+	GetRun(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error)
+}
+
+type SchedulerService interface {
+	Tasks(ctx context.Context) ([]*model.SchedulerTask, error)
+	Pause(ctx context.Context, name string) error
+	Resume(ctx context.Context, name string) error
+	Trigger(ctx context.Context, name string) error
+	Update(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error
+	Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error)
+}
+
+type AuditService interface {
+	Entries(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error)
+}
+
+type BlockListService interface {
+	Entries(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error)
+	Create(ctx context.Context, entry *model.BlockEntry, audit model.AuditData) (*model.BlockEntry, error)
+	Update(ctx context.Context, id int64, merchantID *string, expiresAt time.Time, audit model.AuditData) (*model.BlockEntry, error)
+	Delete(ctx context.Context, id int64, merchantID *string, audit model.AuditData) error
+}
+
+type RequestVerifier interface {
+	Verify(ctx context.Context, request auth.Request) (auth.Principal, error)
+}
+
+type SessionVerifier interface {
+	Verify(ctx context.Context, sessionID string) (session.Session, error)
 }
 
 type Translator interface {
@@ -40,22 +95,32 @@ type Translator interface {
 }
 
 type Handler struct {
-	operationService OperationService
-	sortingService   SortingService
-	summaryService   SummaryService
-	toolService      ToolService
-	translator       Translator
-	validate         *validator.Validate
-	apiKey           string
+	operationService      OperationService
+	sortingService        SortingService
+	summaryService        SummaryService
+	toolService           ToolService
+	reconciliationService ReconciliationService
+	schedulerService      SchedulerService
+	auditService          AuditService
+	blockListService      BlockListService
+	translator            Translator
+	validate              *validator.Validate
+	requestVerifier       RequestVerifier
+	sessionVerifier       SessionVerifier
 }
 
 type HandlerOptions struct {
-	OperationService OperationService
-	SortingService   SortingService
-	SummaryService   SummaryService
-	ToolService      ToolService
-	Translator       Translator
-	APIKey           string
+	OperationService      OperationService
+	SortingService        SortingService
+	SummaryService        SummaryService
+	ToolService           ToolService
+	ReconciliationService ReconciliationService
+	SchedulerService      SchedulerService
+	AuditService          AuditService
+	BlockListService      BlockListService
+	Translator            Translator
+	RequestVerifier       RequestVerifier
+	SessionVerifier       SessionVerifier
 }
 
 // NewHandler godoc
@@ -72,7 +137,7 @@ type HandlerOptions struct {
 //	@securityDefinitions.apikey	ApiKeyAuth
 //	@in							header
 //	@name						Authorization
-//	@description				Секретный ключ
+//	@description				Статический секретный ключ (режим совместимости). Основной способ аутентификации - подпись запроса: заголовки X-Api-Key-Id (идентификатор ключа), X-Api-Timestamp (время отправки в формате UNIX Timestamp) и X-Api-Signature (HMAC-SHA256 в шестнадцатеричном виде от строки "<timestamp>\n<method>\n<uri>\n<body>")
 func NewHandler(opts HandlerOptions) *Handler {
 	validate := validator.New()
 	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
@@ -86,17 +151,27 @@ func NewHandler(opts HandlerOptions) *Handler {
 			return name
 		}
 
+		name = strings.SplitN(fld.Tag.Get("form"), ",", 2)[0]
+		if name != "-" && name != "" {
+			return name
+		}
+
 		return ""
 	})
 
 	return &Handler{
-		operationService: opts.OperationService,
-		sortingService:   opts.SortingService,
-		summaryService:   opts.SummaryService,
-		toolService:      opts.ToolService,
-		translator:       opts.Translator,
-		validate:         validate,
-		apiKey:           opts.APIKey,
+		operationService:      opts.OperationService,
+		sortingService:        opts.SortingService,
+		summaryService:        opts.SummaryService,
+		toolService:           opts.ToolService,
+		reconciliationService: opts.ReconciliationService,
+		schedulerService:      opts.SchedulerService,
+		auditService:          opts.AuditService,
+		blockListService:      opts.BlockListService,
+		translator:            opts.Translator,
+		validate:              validate,
+		requestVerifier:       opts.RequestVerifier,
+		sessionVerifier:       opts.SessionVerifier,
 	}
 }
 
@@ -108,23 +183,73 @@ func (h *Handler) Init(router fiber.Router) {
 	{
 		apiV1.Use(recover.New())
 		apiV1.Use(h.authorizationMiddleware())
+		apiV1.Use(h.sessionMiddleware())
+	}
+
+	{
+		operations := apiV1.Group("/operation", h.scopeMiddleware(scopeOperation))
+		{
+			operations.Get("", h.permissionMiddleware(permissionOperationView), h.operationList)
+			operations.Get("/drift", h.permissionMiddleware(permissionDriftView), h.operationDriftList)
+			operations.Get("/dead-letter", h.permissionMiddleware(permissionDeadLetterView), h.operationDeadLetterList)
+			operations.Get("/:id/external-status", h.permissionMiddleware(permissionOperationExternalStatus), h.operationExternalStatus).Use(middleware.NewAccessLog())
+			operations.Put("/:id/change-status", h.permissionMiddleware(permissionOperationChangeStatus), h.operationChangeStatus).Use(middleware.NewAccessLog())
+			operations.Put("/:id/dead-letter/retry", h.permissionMiddleware(permissionDeadLetterRetry), h.operationDeadLetterRetry).Use(middleware.NewAccessLog())
+			operations.Put("/:id/dead-letter/resolve", h.permissionMiddleware(permissionDeadLetterResolve), h.operationDeadLetterResolve).Use(middleware.NewAccessLog())
+		}
+	}
+
+	{
+		analytics := apiV1.Group("/analytics", h.scopeMiddleware(scopeAnalytics))
+		{
+			analytics.Get("/operations", h.permissionMiddleware(permissionAnalyticsView), h.analyticsOperations)
+		}
+	}
+
+	{
+		reconciliation := apiV1.Group("/reconciliation", h.scopeMiddleware(scopeReconciliation))
+		{
+			reconciliation.Get("", h.permissionMiddleware(permissionReconciliationView), h.reconciliationList)
+			reconciliation.Post("", h.permissionMiddleware(permissionReconciliationCreate), h.reconciliationCreate)
+			reconciliation.Get("/:id", h.permissionMiddleware(permissionReconciliationView), h.reconciliationGet)
+		}
+	}
+
+	{
+		schedulerTasks := apiV1.Group("/scheduler/task", h.scopeMiddleware(scopeScheduler), h.merchantUnboundMiddleware())
+		{
+			schedulerTasks.Get("", h.permissionMiddleware(permissionSchedulerView), h.schedulerTaskList)
+			schedulerTasks.Put("/:name", h.permissionMiddleware(permissionSchedulerManage), h.schedulerTaskUpdate).Use(middleware.NewAccessLog())
+			schedulerTasks.Put("/:name/pause", h.permissionMiddleware(permissionSchedulerManage), h.schedulerTaskPause).Use(middleware.NewAccessLog())
+			schedulerTasks.Put("/:name/resume", h.permissionMiddleware(permissionSchedulerManage), h.schedulerTaskResume).Use(middleware.NewAccessLog())
+			schedulerTasks.Put("/:name/trigger", h.permissionMiddleware(permissionSchedulerManage), h.schedulerTaskTrigger).Use(middleware.NewAccessLog())
+			schedulerTasks.Get("/:name/runs", h.permissionMiddleware(permissionSchedulerView), h.schedulerTaskRuns)
+		}
+	}
+
+	{
+		tools := apiV1.Group("/tool", h.scopeMiddleware(scopeTool))
+		{
+			tools.Get("", h.permissionMiddleware(permissionToolView), h.toolList)
+			tools.Put("/recover", h.permissionMiddleware(permissionToolManage), h.toolRecover).Use(middleware.NewAccessLog())
+			tools.Delete("/delete", h.permissionMiddleware(permissionToolManage), h.toolDelete).Use(middleware.NewAccessLog())
+		}
 	}
 
 	{
-		operations := apiV1.Group("/operation")
+		audit := apiV1.Group("/audit", h.scopeMiddleware(scopeAudit))
 		{
-			operations.Get("", h.operationList)
-			operations.Get("/:id/external-status", h.operationExternalStatus).Use(middleware.NewAccessLog())
-			operations.Put("/:id/change-status", h.operationChangeStatus).Use(middleware.NewAccessLog())
+			audit.Get("", h.permissionMiddleware(permissionAuditView), h.auditList)
 		}
 	}
 
 	{
-		tools := apiV1.Group("/tool")
+		blockList := apiV1.Group("/block-list", h.scopeMiddleware(scopeBlockList))
 		{
-			tools.Get("", h.toolList)
-			tools.Put("/recover", h.toolRecover).Use(middleware.NewAccessLog())
-			tools.Delete("/delete", h.toolDelete).Use(middleware.NewAccessLog())
+			blockList.Get("", h.permissionMiddleware(permissionBlockListView), h.blockList)
+			blockList.Post("", h.permissionMiddleware(permissionBlockListManage), h.blockEntryCreate).Use(middleware.NewAccessLog())
+			blockList.Put("/:id", h.permissionMiddleware(permissionBlockListManage), h.blockEntryUpdate).Use(middleware.NewAccessLog())
+			blockList.Delete("/:id", h.permissionMiddleware(permissionBlockListManage), h.blockEntryDelete).Use(middleware.NewAccessLog())
 		}
 	}
 }
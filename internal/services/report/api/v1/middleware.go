@@ -1,29 +1,218 @@
 package v1
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/translate"
 )
 
+const (
+	principalLocalsKey = "principal"
+	sessionLocalsKey   = "session"
+)
+
 func (h *Handler) authorizationMiddleware() fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		if c.Get(fiber.HeaderAuthorization) != h.apiKey {
-			return h.authorizationFailedErrorResponse(c)
+		principal, err := h.requestVerifier.Verify(c.UserContext(), auth.Request{
+			KeyID:         c.Get(auth.HeaderKeyID),
+			Timestamp:     c.Get(auth.HeaderTimestamp),
+			Signature:     c.Get(auth.HeaderSignature),
+			Authorization: c.Get(fiber.HeaderAuthorization),
+			Method:        c.Method(),
+			URI:           c.OriginalURL(),
+			Body:          c.Body(),
+		})
+		if err != nil {
+			if errors.Is(err, auth.ErrUnauthenticated) || errors.Is(err, auth.ErrReplayed) {
+				return h.authorizationFailedErrorResponse(c, err)
+			}
+			return h.internalErrorResponse(c, "", err)
+		}
+
+		c.Locals(principalLocalsKey, principal)
+
+		return c.Next()
+	}
+}
+
+// specialistParams - параметры специалиста, общие для всех запросов к API.
+type specialistParams struct {
+	UserID    string
+	SessionID string
+	LangCode  string
+}
+
+// parseSpecialistParams извлекает параметры специалиста из строки запроса, формы или JSON тела
+// в зависимости от метода и типа содержимого запроса.
+func parseSpecialistParams(c *fiber.Ctx) specialistParams {
+	if c.Method() == fiber.MethodGet {
+		return specialistParams{
+			UserID:    c.Query("user_id"),
+			SessionID: c.Query("session_id"),
+			LangCode:  c.Query("lang_code"),
+		}
+	}
+
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		return specialistParams{
+			UserID:    c.FormValue("user_id"),
+			SessionID: c.FormValue("session_id"),
+			LangCode:  c.FormValue("lang_code"),
+		}
+	}
+
+	// в части запросов user_id передается числом, поэтому значение читается без учета типа
+	var body struct {
+		UserID    json.RawMessage `json:"user_id"`
+		SessionID string          `json:"session_id"`
+		LangCode  string          `json:"lang_code"`
+	}
+	_ = json.Unmarshal(c.Body(), &body)
+
+	return specialistParams{
+		UserID:    strings.Trim(string(body.UserID), `"`),
+		SessionID: body.SessionID,
+		LangCode:  body.LangCode,
+	}
+}
+
+// sessionMiddleware проверяет сессию специалиста и её принадлежность пользователю user_id.
+// Роли из проверенной сессии определяют доступные специалисту действия.
+func (h *Handler) sessionMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		params := parseSpecialistParams(c)
+		if params.UserID == "" || params.SessionID == "" {
+			return h.invalidSessionErrorResponse(c, params.LangCode, session.ErrInvalidSession)
+		}
+
+		specialist, err := h.sessionVerifier.Verify(c.UserContext(), params.SessionID)
+		if err != nil {
+			if errors.Is(err, session.ErrInvalidSession) {
+				return h.invalidSessionErrorResponse(c, params.LangCode, err)
+			}
+			return h.internalErrorResponse(c, params.LangCode, err)
+		}
+
+		if specialist.Subject != params.UserID {
+			return h.sessionUserMismatchErrorResponse(c, params.LangCode)
+		}
+
+		c.Locals(sessionLocalsKey, specialist)
+
+		return c.Next()
+	}
+}
+
+// merchantFilter возвращает магазин, которым ограничивается выборка. Клиенту, привязанному к магазину,
+// доступны только данные этого магазина; остальным - магазина из запроса (пустое значение - всех магазинов).
+func merchantFilter(c *fiber.Ctx, requested string) string {
+	principal, _ := c.Locals(principalLocalsKey).(auth.Principal)
+	if principal.MerchantID != "" {
+		return principal.MerchantID
+	}
+	return requested
+}
+
+// merchantScope возвращает магазин, к которому привязан клиент, или nil, если клиенту доступны данные всех магазинов.
+func merchantScope(c *fiber.Ctx) *string {
+	principal, _ := c.Locals(principalLocalsKey).(auth.Principal)
+	if principal.MerchantID != "" {
+		return &principal.MerchantID
+	}
+	return nil
+}
+
+// merchantUnboundMiddleware пропускает запрос, только если ключ клиента не привязан к магазину:
+// ресурс общий для всех магазинов и клиенту одного магазина недоступен.
+func (h *Handler) merchantUnboundMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if merchantScope(c) != nil {
+			return h.merchantBoundErrorResponse(c)
+		}
+		return c.Next()
+	}
+}
+
+// scopeMiddleware пропускает запрос, только если ключу клиента разрешена область доступа scope.
+func (h *Handler) scopeMiddleware(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		principal, _ := c.Locals(principalLocalsKey).(auth.Principal)
+		if !principal.HasScope(scope) {
+			return h.insufficientScopeErrorResponse(c, scope)
 		}
 		return c.Next()
 	}
 }
 
-func (h *Handler) authorizationFailedErrorResponse(c *fiber.Ctx) error {
+func (h *Handler) authorizationFailedErrorResponse(c *fiber.Ctx, err error) error {
 	return c.Status(http.StatusUnauthorized).JSON(&errorResponse{
 		Success: false,
 		Error: errorContent{
 			Code:        errorCodeInvalidAPIKey,
-			Description: "Authorization header does not contain valid API Key",
+			Description: err.Error(),
 			Message:     h.translator.Translate("", translate.KeyInvalidAPIKey),
 		},
 	})
 }
+
+func (h *Handler) insufficientScopeErrorResponse(c *fiber.Ctx, scope string) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInsufficientScope,
+			Description: "API key does not have required scope: " + scope,
+			Message:     h.translator.Translate("", translate.KeyInsufficientScope),
+		},
+	})
+}
+
+func (h *Handler) merchantBoundErrorResponse(c *fiber.Ctx) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInsufficientScope,
+			Description: "API key bound to a merchant cannot access resources shared by all merchants",
+			Message:     h.translator.Translate("", translate.KeyInsufficientScope),
+		},
+	})
+}
+
+func (h *Handler) invalidSessionErrorResponse(c *fiber.Ctx, langCode string, err error) error {
+	return c.Status(http.StatusUnauthorized).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInvalidSession,
+			Description: err.Error(),
+			Message:     h.translator.Translate(langCode, translate.KeyInvalidSession),
+		},
+	})
+}
+
+func (h *Handler) sessionUserMismatchErrorResponse(c *fiber.Ctx, langCode string) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeSessionUserMismatch,
+			Description: "session does not belong to user_id",
+			Message:     h.translator.Translate(langCode, translate.KeySessionUserMismatch),
+		},
+	})
+}
+
+func (h *Handler) insufficientPermissionsErrorResponse(c *fiber.Ctx, perm permission) error {
+	return c.Status(http.StatusForbidden).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInsufficientPermissions,
+			Description: "specialist roles do not grant permission: " + string(perm),
+			Message:     h.translator.Translate("", translate.KeyInsufficientPermissions),
+		},
+	})
+}
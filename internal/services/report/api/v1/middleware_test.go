@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+)
+
+type keyTranslator struct{}
+
+func (keyTranslator) Translate(_, key string, _ ...any) string {
+	return key
+}
+
+// serveWithPrincipal выполняет запрос к обработчику handler от имени клиента principal
+// и возвращает код и тело ответа.
+func serveWithPrincipal(t *testing.T, principal auth.Principal, handlers ...fiber.Handler) (int, string) {
+	t.Helper()
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals(principalLocalsKey, principal)
+		return c.Next()
+	})
+	app.Get("/", handlers...)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("serving request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %v", err)
+	}
+
+	return resp.StatusCode, string(body)
+}
+
+func TestMerchantFilter(t *testing.T) {
+	tests := []struct {
+		name               string
+		principal          auth.Principal
+		requested          string
+		wantFilter         string
+		wantScope          *string
+		wantBlockEntryNull bool
+	}{
+		{
+			name:               "unbound client without merchant in request",
+			principal:          auth.Principal{ClientID: "support"},
+			requested:          "",
+			wantFilter:         "",
+			wantBlockEntryNull: true,
+		},
+		{
+			name:       "unbound client with merchant in request",
+			principal:  auth.Principal{ClientID: "support"},
+			requested:  "shop",
+			wantFilter: "shop",
+		},
+		{
+			name:       "bound client without merchant in request",
+			principal:  auth.Principal{ClientID: "shop-support", MerchantID: "shop"},
+			requested:  "",
+			wantFilter: "shop",
+			wantScope:  ptr("shop"),
+		},
+		{
+			name:       "bound client requests another merchant",
+			principal:  auth.Principal{ClientID: "shop-support", MerchantID: "shop"},
+			requested:  "other",
+			wantFilter: "shop",
+			wantScope:  ptr("shop"),
+		},
+		{
+			name:               "legacy client",
+			principal:          auth.Principal{Scopes: []string{auth.ScopeAll}, Legacy: true},
+			requested:          "",
+			wantFilter:         "",
+			wantBlockEntryNull: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, _ := serveWithPrincipal(t, tt.principal, func(c *fiber.Ctx) error {
+				if got := merchantFilter(c, tt.requested); got != tt.wantFilter {
+					t.Errorf("merchantFilter() = %q, want %q", got, tt.wantFilter)
+				}
+
+				if got := merchantScope(c); !equalPtr(got, tt.wantScope) {
+					t.Errorf("merchantScope() = %v, want %v", deref(got), deref(tt.wantScope))
+				}
+
+				got := blockEntryMerchantFilter(c, tt.requested)
+				if (got == nil) != tt.wantBlockEntryNull || (got != nil && *got != tt.wantFilter) {
+					t.Errorf("blockEntryMerchantFilter() = %v, want %q", deref(got), tt.wantFilter)
+				}
+
+				return c.SendStatus(http.StatusOK)
+			})
+			if status != http.StatusOK {
+				t.Fatalf("status = %v, want %v", status, http.StatusOK)
+			}
+		})
+	}
+}
+
+func TestMerchantUnboundMiddleware(t *testing.T) {
+	h := &Handler{translator: keyTranslator{}}
+
+	tests := []struct {
+		name       string
+		principal  auth.Principal
+		wantStatus int
+	}{
+		{
+			name:       "unbound client",
+			principal:  auth.Principal{ClientID: "support"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "legacy client",
+			principal:  auth.Principal{Scopes: []string{auth.ScopeAll}, Legacy: true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "bound client",
+			principal:  auth.Principal{ClientID: "shop-support", MerchantID: "shop"},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := serveWithPrincipal(t, tt.principal, h.merchantUnboundMiddleware(), func(c *fiber.Ctx) error {
+				return c.SendStatus(http.StatusOK)
+			})
+			if status != tt.wantStatus {
+				t.Errorf("status = %v, want %v (body: %s)", status, tt.wantStatus, body)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func equalPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func deref(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
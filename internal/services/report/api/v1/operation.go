@@ -20,6 +20,8 @@ import (
 type operation struct {
 	// Идентификатор операции
 	ID int64 `json:"id" csv:"id" example:"1" validate:"required"`
+	// Идентификатор магазина
+	MerchantID string `json:"merchant_id" csv:"merchant_id" example:"default" validate:"required"`
 	// Идентификатор клиента
 	ClientID string `json:"client_id" csv:"client_id" example:"1" validate:"required"`
 	// Тип операции
@@ -30,11 +32,11 @@ type operation struct {
 	Amount float64 `json:"amount" csv:"amount" example:"121.01" validate:"required"`
 	// Внутренний статус операции
 	Status string `json:"status" csv:"status" example:"SUCCESS" validate:"required"`
-	// Идентификатор операции на стороне платежной системы
+	// Идентификатор операции на стороне платежной системы (маскируется для ролей viewer и support)
 	ExternalID string `json:"external_id,omitempty" csv:"external_id" example:"ew01r01w0gfw1fw1"`
 	// Статус операции на стороне платежной системы
 	ExternalStatus string `json:"external_status,omitempty" csv:"external_status" example:"PENDING"`
-	// Платежное средство, используемое в операции
+	// Платежное средство, используемое в операции (маскируется для ролей viewer и support)
 	ToolDisplayed string `json:"tool,omitempty" csv:"tool" example:"5748********4124"`
 	// Причина отклонения операции
 	FailReason string `json:"fail_reason,omitempty" csv:"fail_reason" example:"Technical error"`
@@ -57,6 +59,8 @@ type operationListRequest struct {
 	ID int64 `query:"id" example:"1"`
 	// Идентификатор операции на стороне платежной системы
 	ExternalID string `query:"external_id" example:"ew01r01w0gfw1fw1"`
+	// Идентификатор магазина
+	MerchantID string `query:"merchant_id" example:"default"`
 	// Идентификатор клиента
 	ClientID string `query:"client_id" example:"1"`
 	// Тип операции
@@ -97,6 +101,7 @@ type operationListResponse struct {
 //	@Param		lang_code		query		string					true	"Код языка, обозначение по RFC 5646"
 //	@Param		id				query		int						false	"Идентификатор операции"
 //	@Param		external_id		query		string					false	"Идентификатор операции на стороне платежной системы"
+//	@Param		merchant_id		query		string					false	"Идентификатор магазина"
 //	@Param		client_id		query		string					false	"Идентификатор клиента"
 //	@Param		type			query		string					false	"Тип операции"
 //	@Param		statuses		query		string					false	"Внутренние статусы операций, перечисленные через запятую"
@@ -109,7 +114,7 @@ type operationListResponse struct {
 //	@Failure	default			{object}	errorResponse			"Ответ с ошибкой"
 //	@Router		/operation [get]
 func (h *Handler) operationList(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req operationListRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -120,6 +125,8 @@ func (h *Handler) operationList(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
+	req.MerchantID = merchantFilter(c, req.MerchantID)
+
 	criteria, err := operationListCriteriaFromRequest(req)
 	if err != nil {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
@@ -135,6 +142,11 @@ func (h *Handler) operationList(c *fiber.Ctx) error {
 	operations = h.sortingService.SortReportOperations(operations, req.OrderField, req.OrderType)
 
 	respOperations := h.operations(operations)
+	if !hasPermission(c, permissionSensitiveView) {
+		for i := range respOperations {
+			maskOperation(&respOperations[i])
+		}
+	}
 
 	if req.CSV {
 		resp := &bytes.Buffer{}
@@ -170,6 +182,9 @@ func operationListCriteriaFromRequest(req operationListRequest) (model.Operation
 	if req.ID > 0 {
 		criteria.ID = &req.ID
 	}
+	if req.MerchantID != "" {
+		criteria.MerchantID = &req.MerchantID
+	}
 	if req.ClientID != "" {
 		criteria.UserID = &req.ClientID
 	}
@@ -239,7 +254,7 @@ type operationExternalStatusResponse struct {
 //	@Failure	default		{object}	errorResponse					"Ответ с ошибкой"
 //	@Router		/operation/{id}/external-status [get]
 func (h *Handler) operationExternalStatus(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req operationExternalStatusRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -256,7 +271,7 @@ func (h *Handler) operationExternalStatus(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	externalOperationStatus, err := h.operationService.GetExternalOperationStatus(ctx, opID)
+	externalOperationStatus, err := h.operationService.GetExternalOperationStatus(ctx, opID, merchantScope(c))
 	if err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
@@ -300,6 +315,8 @@ type operationChangeStatusRequest struct {
 	NewStatus string `json:"new_status" example:"FAILED" validate:"required"`
 	// Новый статус операции на стороне ПС
 	NewExternalStatus string `json:"new_external_status" example:"FAILED" validate:"required"`
+	// Причина изменения статуса, сохраняется в журнале аудита
+	Reason string `json:"reason" example:"Платежная система подтвердила отклонение платежа в ответе на обращение" validate:"required"`
 }
 
 type operationChangeStatusResponse struct {
@@ -319,10 +336,10 @@ type operationChangeStatusResponse struct {
 //	@Failure	default	{object}	errorResponse					"Ответ с ошибкой"
 //	@Router		/operation/{id}/change-status [put]
 func (h *Handler) operationChangeStatus(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req operationChangeStatusRequest
-	if err := c.QueryParser(&req); err != nil {
+	if err := c.BodyParser(&req); err != nil {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
@@ -339,7 +356,12 @@ func (h *Handler) operationChangeStatus(c *fiber.Ctx) error {
 	newStatus := model.OperationStatus(req.NewStatus)
 	newExternalStatus := model.OperationExternalStatus(req.NewExternalStatus)
 
-	if err = h.operationService.ChangeStatus(ctx, opID, newStatus, newExternalStatus); err != nil {
+	audit := model.AuditData{
+		ActorID: strconv.FormatInt(req.UserID, 10),
+		Reason:  req.Reason,
+	}
+
+	if err = h.operationService.ChangeStatus(ctx, opID, merchantScope(c), newStatus, newExternalStatus, audit); err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
 			if perr.Group == perror.GroupInternal && perr.Code == perror.CodeObjectNotFound {
@@ -357,6 +379,7 @@ func (h *Handler) operationChangeStatus(c *fiber.Ctx) error {
 func (h *Handler) operation(item model.ReportOperation) operation {
 	result := operation{
 		ID:             item.ID,
+		MerchantID:     item.MerchantID,
 		ClientID:       item.UserID,
 		Type:           string(item.Type),
 		Currency:       item.Currency,
@@ -0,0 +1,346 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocarina/gocsv"
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type reconciliationDiscrepancy struct {
+	// Тип расхождения:
+	// * Успешная операция отсутствует в реестре - "MISSING"
+	// * Строка реестра не соответствует ни одной операции - "EXTRA"
+	// * Не совпадает сумма или валюта - "AMOUNT_MISMATCH"
+	// * Не совпадает статус - "STATUS_MISMATCH"
+	Type string `json:"type" csv:"type" example:"AMOUNT_MISMATCH" validate:"required"`
+	// Идентификатор операции на стороне платежной системы
+	ExternalID string `json:"external_id" csv:"external_id" example:"2dc32aa0-000f-5000-8000-16d7bc6cd09f" validate:"required"`
+	// Идентификатор операции
+	OperationID int64 `json:"operation_id,omitempty" csv:"operation_id" example:"1"`
+	// Валюта операции
+	Currency string `json:"currency,omitempty" csv:"currency" example:"RUB"`
+	// Сумма операции
+	Amount float64 `json:"amount,omitempty" csv:"amount" example:"121.01"`
+	// Внутренний статус операции
+	Status string `json:"status,omitempty" csv:"status" example:"SUCCESS"`
+	// Валюта операции по данным реестра
+	RegistryCurrency string `json:"registry_currency,omitempty" csv:"registry_currency" example:"RUB"`
+	// Сумма операции по данным реестра
+	RegistryAmount float64 `json:"registry_amount,omitempty" csv:"registry_amount" example:"120.01"`
+	// Статус операции по данным реестра
+	RegistryStatus string `json:"registry_status,omitempty" csv:"registry_status" example:"SUCCESS"`
+}
+
+type reconciliationRun struct {
+	// Идентификатор запуска сверки
+	ID int64 `json:"id" example:"1" validate:"required"`
+	// Идентификатор магазина, операции которого сверялись
+	MerchantID string `json:"merchant_id" example:"default" validate:"required"`
+	// Внутренний код платежной системы
+	ExternalSystem string `json:"external_system" example:"yookassa" validate:"required"`
+	// Начало периода сверки в формате UNIX Timestamp
+	PeriodFrom int64 `json:"period_from" example:"1715904000" validate:"required"`
+	// Конец периода сверки в формате UNIX Timestamp
+	PeriodTo int64 `json:"period_to" example:"1715990400" validate:"required"`
+	// Количество строк реестра
+	RegistryCount int64 `json:"registry_count" example:"120" validate:"required"`
+	// Количество строк реестра, сопоставленных с операциями
+	MatchedCount int64 `json:"matched_count" example:"118" validate:"required"`
+	// Количество найденных расхождений
+	DiscrepancyCount int64 `json:"discrepancy_count" example:"3" validate:"required"`
+	// Время запуска сверки в формате UNIX Timestamp
+	CreatedAt int64 `json:"created_at" example:"1715990400" validate:"required"`
+	// Массив найденных расхождений (передается при запросе конкретного запуска)
+	Discrepancies []reconciliationDiscrepancy `json:"discrepancies,omitempty"`
+}
+
+type reconciliationCreateRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `form:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `form:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `form:"lang_code" example:"en" validate:"required"`
+	// Внутренний код платежной системы, реестр которой сверяется
+	ExternalSystem string `form:"external_system" example:"yookassa" validate:"required"`
+	// Начало периода сверки в формате UNIX Timestamp
+	PeriodFrom int64 `form:"period_from" example:"1715904000" validate:"required"`
+	// Конец периода сверки в формате UNIX Timestamp
+	PeriodTo int64 `form:"period_to" example:"1715990400" validate:"required"`
+	// Формат реестра: "csv" или "json" (по умолчанию определяется по расширению файла)
+	Format string `form:"format" example:"csv"`
+	// Идентификатор магазина, операции которого сверяются (по умолчанию - магазин по умолчанию)
+	MerchantID string `form:"merchant_id" example:"default"`
+}
+
+type reconciliationRunResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Результат сверки
+	Run reconciliationRun `json:"run" validate:"required"`
+}
+
+// reconciliationCreate godoc
+//
+//	@Summary	Сверить операции с реестром платежной системы
+//	@Tags		Сверка
+//	@Accept		multipart/form-data
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id			formData	string						true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id		formData	string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code		formData	string						true	"Код языка, обозначение по RFC 5646"
+//	@Param		external_system	formData	string						true	"Внутренний код платежной системы, реестр которой сверяется"
+//	@Param		period_from		formData	int							true	"Начало периода сверки в формате UNIX Timestamp"
+//	@Param		period_to		formData	int							true	"Конец периода сверки в формате UNIX Timestamp"
+//	@Param		format			formData	string						false	"Формат реестра: csv или json (по умолчанию определяется по расширению файла)"
+//	@Param		merchant_id		formData	string						false	"Идентификатор магазина, операции которого сверяются (по умолчанию - магазин по умолчанию)"
+//	@Param		registry		formData	file						true	"Файл реестра с колонками external_id, amount, currency, status"
+//	@Success	200				{object}	reconciliationRunResponse	"Успешный ответ"
+//	@Failure	default			{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/reconciliation [post]
+func (h *Handler) reconciliationCreate(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req reconciliationCreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if req.PeriodFrom > req.PeriodTo {
+		err := errors.New("period_from must not be greater than period_to")
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	fileHeader, err := c.FormFile("registry")
+	if err != nil {
+		err = fmt.Errorf("failed to get registry file: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	format := req.Format
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+	defer file.Close()
+
+	items, err := h.reconciliationService.ParseRegistry(format, file)
+	if err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	data := model.ReconcileData{
+		MerchantID:     model.MerchantIDOrDefault(merchantFilter(c, req.MerchantID)),
+		ExternalSystem: req.ExternalSystem,
+		PeriodFrom:     time.Unix(req.PeriodFrom, 0).UTC(),
+		PeriodTo:       time.Unix(req.PeriodTo, 0).UTC(),
+		Items:          items,
+	}
+
+	run, err := h.reconciliationService.Reconcile(ctx, data)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&reconciliationRunResponse{
+		Success: true,
+		Run:     h.reconciliationRun(run),
+	})
+}
+
+type reconciliationListRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Внутренний код платежной системы
+	ExternalSystem string `query:"external_system" example:"yookassa"`
+	// Идентификатор магазина
+	MerchantID string `query:"merchant_id" example:"default"`
+}
+
+type reconciliationListResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив последних запусков сверки
+	Runs []reconciliationRun `json:"runs" validate:"required"`
+}
+
+// reconciliationList godoc
+//
+//	@Summary	Получить список последних запусков сверки
+//	@Tags		Сверка
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id			query		string						true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id		query		string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code		query		string						true	"Код языка, обозначение по RFC 5646"
+//	@Param		external_system	query		string						false	"Внутренний код платежной системы"
+//	@Param		merchant_id		query		string						false	"Идентификатор магазина"
+//	@Success	200				{object}	reconciliationListResponse	"Успешный ответ"
+//	@Failure	default			{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/reconciliation [get]
+func (h *Handler) reconciliationList(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req reconciliationListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	var merchantID *string
+	if requested := merchantFilter(c, req.MerchantID); requested != "" {
+		merchantID = &requested
+	}
+
+	runs, err := h.reconciliationService.Runs(ctx, req.ExternalSystem, merchantID)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	respRuns := make([]reconciliationRun, 0, len(runs))
+	for _, run := range runs {
+		respRuns = append(respRuns, h.reconciliationRun(run))
+	}
+
+	return c.JSON(&reconciliationListResponse{
+		Success: true,
+		Runs:    respRuns,
+	})
+}
+
+type reconciliationGetRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Флаг о необходимости вернуть отчет о расхождениях в формате CSV (по умолчанию - false)
+	CSV bool `query:"csv" example:"true"`
+}
+
+// reconciliationGet godoc
+//
+//	@Summary	Получить результат запуска сверки с отчетом о расхождениях
+//	@Tags		Сверка
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		id			path		int							true	"Идентификатор запуска сверки"
+//	@Param		user_id		query		string						true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id	query		string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code	query		string						true	"Код языка, обозначение по RFC 5646"
+//	@Param		csv			query		boolean						false	"Флаг о необходимости вернуть отчет о расхождениях в формате CSV (по умолчанию - false)"
+//	@Success	200			{object}	reconciliationRunResponse	"Успешный ответ"
+//	@Failure	default		{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/reconciliation/{id} [get]
+func (h *Handler) reconciliationGet(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req reconciliationGetRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	runID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		err = fmt.Errorf("failed to parse id as int: %w", err)
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	run, err := h.reconciliationService.GetRun(ctx, runID, merchantScope(c))
+	if err != nil {
+		var perr *perror.Error
+		if errors.As(err, &perr) {
+			if perr.Group == perror.GroupInternal && perr.Code == perror.CodeObjectNotFound {
+				return h.objectNotFoundErrorResponse(c, req.LangCode, perr)
+			}
+		}
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	respRun := h.reconciliationRun(run)
+
+	if req.CSV {
+		resp := &bytes.Buffer{}
+
+		if err = gocsv.Marshal(&respRun.Discrepancies, resp); err != nil {
+			return h.internalErrorResponse(c, req.LangCode, err)
+		}
+
+		filename := fmt.Sprintf("reconciliation-%v-%v.csv", run.ID, run.ExternalSystem)
+
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%v", filename))
+		c.Set(fiber.HeaderContentType, "text/csv")
+
+		return c.Send(resp.Bytes())
+	}
+
+	return c.JSON(&reconciliationRunResponse{
+		Success: true,
+		Run:     respRun,
+	})
+}
+
+func (h *Handler) reconciliationRun(run *model.ReconciliationRun) reconciliationRun {
+	result := reconciliationRun{
+		ID:               run.ID,
+		MerchantID:       run.MerchantID,
+		ExternalSystem:   run.ExternalSystem,
+		PeriodFrom:       run.PeriodFrom.Unix(),
+		PeriodTo:         run.PeriodTo.Unix(),
+		RegistryCount:    run.RegistryCount,
+		MatchedCount:     run.MatchedCount,
+		DiscrepancyCount: run.DiscrepancyCount,
+		CreatedAt:        run.CreatedAt.Unix(),
+	}
+
+	if len(run.Discrepancies) > 0 {
+		result.Discrepancies = make([]reconciliationDiscrepancy, 0, len(run.Discrepancies))
+		for _, d := range run.Discrepancies {
+			result.Discrepancies = append(result.Discrepancies, reconciliationDiscrepancy{
+				Type:             string(d.Type),
+				ExternalID:       d.ExternalID,
+				OperationID:      d.OperationID,
+				Currency:         d.Currency,
+				Amount:           convert.CentsToBase(d.Amount),
+				Status:           string(d.Status),
+				RegistryCurrency: d.RegistryCurrency,
+				RegistryAmount:   convert.CentsToBase(d.RegistryAmount),
+				RegistryStatus:   string(d.RegistryStatus),
+			})
+		}
+	}
+
+	return result
+}
@@ -10,11 +10,15 @@ import (
 )
 
 const (
-	errorCodeInvalidRequest         = "InvalidRequest"
-	errorCodeInvalidAPIKey          = "InvalidAPIKey"
-	errorCodeInternalError          = "InternalError"
-	errorCodeObjectNotFound         = "ObjectNotFound"
-	errorCodeUnresolvedObjectStatus = "UnresolvedObjectStatus"
+	errorCodeInvalidRequest          = "InvalidRequest"
+	errorCodeInvalidAPIKey           = "InvalidAPIKey"
+	errorCodeInsufficientScope       = "InsufficientScope"
+	errorCodeInvalidSession          = "InvalidSession"
+	errorCodeSessionUserMismatch     = "SessionUserMismatch"
+	errorCodeInsufficientPermissions = "InsufficientPermissions"
+	errorCodeInternalError           = "InternalError"
+	errorCodeObjectNotFound          = "ObjectNotFound"
+	errorCodeUnresolvedObjectStatus  = "UnresolvedObjectStatus"
 )
 
 type errorContent struct {
@@ -44,6 +48,17 @@ func (h *Handler) requestValidationErrorResponse(c *fiber.Ctx, langCode string,
 	})
 }
 
+func (h *Handler) invalidRequestErrorResponse(c *fiber.Ctx, langCode string, perr *perror.Error) error {
+	return c.Status(http.StatusBadRequest).JSON(&errorResponse{
+		Success: false,
+		Error: errorContent{
+			Code:        errorCodeInvalidRequest,
+			Description: perr.Description,
+			Message:     h.translator.Translate(langCode, translate.KeyUnexpectedError),
+		},
+	})
+}
+
 func (h *Handler) internalErrorResponse(c *fiber.Ctx, langCode string, err error) error {
 	return c.Status(http.StatusInternalServerError).JSON(&errorResponse{
 		Success: false,
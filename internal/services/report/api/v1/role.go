@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+)
+
+// Роли специалистов, выдаваемые поставщиком сессий.
+const (
+	// roleViewer - просмотр операций и аналитики без чувствительных данных
+	roleViewer = "viewer"
+	// roleSupport - работа с обращениями клиентов: платежные средства, статусы у платежной системы, повторы операций
+	roleSupport = "support"
+	// roleFinance - финансовые действия: смена статусов операций, сверки, разбор недоставленных операций
+	roleFinance = "finance"
+	// roleAdmin - полный доступ, в том числе к управлению планировщиком
+	roleAdmin = "admin"
+)
+
+type permission string
+
+// Разрешения на действия с отчетностью.
+const (
+	permissionOperationView           permission = "operation:view"
+	permissionOperationExternalStatus permission = "operation:external_status"
+	permissionOperationChangeStatus   permission = "operation:change_status"
+	permissionDriftView               permission = "drift:view"
+	permissionDeadLetterView          permission = "dead_letter:view"
+	permissionDeadLetterRetry         permission = "dead_letter:retry"
+	permissionDeadLetterResolve       permission = "dead_letter:resolve"
+	permissionAnalyticsView           permission = "analytics:view"
+	permissionReconciliationView      permission = "reconciliation:view"
+	permissionReconciliationCreate    permission = "reconciliation:create"
+	permissionSchedulerView           permission = "scheduler:view"
+	permissionSchedulerManage         permission = "scheduler:manage"
+	permissionToolView                permission = "tool:view"
+	permissionToolManage              permission = "tool:manage"
+	permissionAuditView               permission = "audit:view"
+	permissionBlockListView           permission = "block_list:view"
+	permissionBlockListManage         permission = "block_list:manage"
+	// permissionSensitiveView - просмотр external_id и реквизитов платежных средств без маскирования
+	permissionSensitiveView permission = "sensitive:view"
+)
+
+var rolePermissions = map[string][]permission{
+	roleViewer: {
+		permissionOperationView,
+		permissionAnalyticsView,
+	},
+	roleSupport: {
+		permissionOperationView,
+		permissionOperationExternalStatus,
+		permissionDriftView,
+		permissionDeadLetterView,
+		permissionDeadLetterRetry,
+		permissionSchedulerView,
+		permissionToolView,
+		permissionToolManage,
+		permissionBlockListView,
+		permissionBlockListManage,
+	},
+	roleFinance: {
+		permissionOperationView,
+		permissionOperationExternalStatus,
+		permissionOperationChangeStatus,
+		permissionDriftView,
+		permissionDeadLetterView,
+		permissionDeadLetterRetry,
+		permissionDeadLetterResolve,
+		permissionAnalyticsView,
+		permissionReconciliationView,
+		permissionReconciliationCreate,
+		permissionToolView,
+		permissionAuditView,
+		permissionBlockListView,
+		permissionSensitiveView,
+	},
+	roleAdmin: {
+		permissionOperationView,
+		permissionOperationExternalStatus,
+		permissionOperationChangeStatus,
+		permissionDriftView,
+		permissionDeadLetterView,
+		permissionDeadLetterRetry,
+		permissionDeadLetterResolve,
+		permissionAnalyticsView,
+		permissionReconciliationView,
+		permissionReconciliationCreate,
+		permissionSchedulerView,
+		permissionSchedulerManage,
+		permissionToolView,
+		permissionToolManage,
+		permissionAuditView,
+		permissionBlockListView,
+		permissionBlockListManage,
+		permissionSensitiveView,
+	},
+}
+
+// hasPermission сообщает, разрешено ли действие хотя бы одной из ролей специалиста, сессия которого проверена.
+func hasPermission(c *fiber.Ctx, perm permission) bool {
+	specialist, ok := c.Locals(sessionLocalsKey).(session.Session)
+	if !ok {
+		return false
+	}
+
+	for _, role := range specialist.Roles {
+		for _, p := range rolePermissions[role] {
+			if p == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// permissionMiddleware пропускает запрос, только если ролям специалиста разрешено действие perm.
+func (h *Handler) permissionMiddleware(perm permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !hasPermission(c, perm) {
+			return h.insufficientPermissionsErrorResponse(c, perm)
+		}
+		return c.Next()
+	}
+}
+
+// maskValue скрывает все символы значения, кроме последних четырех.
+func maskValue(value string) string {
+	const visible = 4
+	if len(value) <= visible {
+		return strings.Repeat("*", len(value))
+	}
+	return strings.Repeat("*", len(value)-visible) + value[len(value)-visible:]
+}
+
+// maskOperation скрывает идентификатор операции у платежной системы и платежное средство.
+func maskOperation(op *operation) {
+	op.ExternalID = maskValue(op.ExternalID)
+	op.ToolDisplayed = maskValue(op.ToolDisplayed)
+}
+
+// maskTool скрывает значение платежного средства и реквизиты, по которым можно установить его владельца.
+func maskTool(t *tool) {
+	t.Caption = maskValue(t.Caption)
+	if t.Details == nil {
+		return
+	}
+
+	t.Details.CardHolder = ""
+	t.Details.ExpiryMonth = 0
+	t.Details.ExpiryYear = 0
+	t.Details.WalletNumber = ""
+	if *t.Details == (toolDetails{}) {
+		t.Details = nil
+	}
+}
@@ -0,0 +1,341 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type schedulerTask struct {
+	// Название фоновой задачи
+	Name string `json:"name" example:"request_payouts" validate:"required"`
+	// Флаг о том, что запуски фоновой задачи по расписанию приостановлены
+	IsPaused bool `json:"is_paused" example:"false" validate:"required"`
+	// Действующий интервал запуска в секундах
+	Interval int64 `json:"interval" example:"5" validate:"required"`
+	// Действующий размер пачки обрабатываемых операций
+	OperationBatchSize int64 `json:"operation_batch_size" example:"100" validate:"required"`
+	// Интервал запуска в секундах из конфигурации
+	DefaultInterval int64 `json:"default_interval" example:"5" validate:"required"`
+	// Размер пачки обрабатываемых операций из конфигурации
+	DefaultOperationBatchSize int64 `json:"default_operation_batch_size" example:"100" validate:"required"`
+}
+
+type schedulerTaskRun struct {
+	// Идентификатор запуска
+	ID int64 `json:"id" example:"1" validate:"required"`
+	// Идентификатор экземпляра сервиса, выполнившего запуск
+	InstanceID string `json:"instance_id" example:"engine-1-42" validate:"required"`
+	// Источник запуска: "SCHEDULE" - по расписанию, "MANUAL" - по запросу
+	Trigger string `json:"trigger" example:"SCHEDULE" validate:"required"`
+	// Количество операций, взятых в обработку
+	Processed int64 `json:"processed" example:"100" validate:"required"`
+	// Количество операций, обработанных без ошибок
+	Succeeded int64 `json:"succeeded" example:"98" validate:"required"`
+	// Количество операций, при обработке которых произошла ошибка
+	Errored int64 `json:"errored" example:"2" validate:"required"`
+	// Время начала запуска в формате UNIX Timestamp
+	StartedAt int64 `json:"started_at" example:"1715974447" validate:"required"`
+	// Время окончания запуска в формате UNIX Timestamp
+	FinishedAt int64 `json:"finished_at" example:"1715974449" validate:"required"`
+	// Длительность запуска в миллисекундах
+	Duration int64 `json:"duration" example:"1520" validate:"required"`
+}
+
+type schedulerTaskListRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+}
+
+type schedulerTaskListResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив фоновых задач
+	Tasks []schedulerTask `json:"tasks" validate:"required"`
+}
+
+// schedulerTaskList godoc
+//
+//	@Summary	Получить список фоновых задач с их действующими параметрами
+//	@Tags		Планировщик
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		user_id		query		string						true	"Идентификатор специалиста техподдержки"
+//	@Param		session_id	query		string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param		lang_code	query		string						true	"Код языка, обозначение по RFC 5646"
+//	@Success	200			{object}	schedulerTaskListResponse	"Успешный ответ"
+//	@Failure	default		{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/scheduler/task [get]
+func (h *Handler) schedulerTaskList(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req schedulerTaskListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	tasks, err := h.schedulerService.Tasks(ctx)
+	if err != nil {
+		return h.internalErrorResponse(c, req.LangCode, err)
+	}
+
+	respTasks := make([]schedulerTask, 0, len(tasks))
+	for _, task := range tasks {
+		respTasks = append(respTasks, schedulerTask{
+			Name:                      task.Name,
+			IsPaused:                  task.IsPaused,
+			Interval:                  int64(task.Interval.Seconds()),
+			OperationBatchSize:        task.OperationBatchSize,
+			DefaultInterval:           int64(task.DefaultInterval.Seconds()),
+			DefaultOperationBatchSize: task.DefaultOperationBatchSize,
+		})
+	}
+
+	return c.JSON(&schedulerTaskListResponse{
+		Success: true,
+		Tasks:   respTasks,
+	})
+}
+
+type schedulerTaskActionRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+}
+
+type schedulerTaskActionResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+}
+
+// schedulerTaskPause godoc
+//
+//	@Summary	Приостановить запуски фоновой задачи по расписанию
+//	@Tags		Планировщик
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		name	path		string						true	"Название фоновой задачи"
+//	@Param		input	body		schedulerTaskActionRequest	true	"Тело запроса"
+//	@Success	200		{object}	schedulerTaskActionResponse	"Успешный ответ"
+//	@Failure	default	{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/scheduler/task/{name}/pause [put]
+func (h *Handler) schedulerTaskPause(c *fiber.Ctx) error {
+	return h.schedulerTaskAction(c, h.schedulerService.Pause)
+}
+
+// schedulerTaskResume godoc
+//
+//	@Summary	Возобновить запуски фоновой задачи по расписанию
+//	@Tags		Планировщик
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		name	path		string						true	"Название фоновой задачи"
+//	@Param		input	body		schedulerTaskActionRequest	true	"Тело запроса"
+//	@Success	200		{object}	schedulerTaskActionResponse	"Успешный ответ"
+//	@Failure	default	{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/scheduler/task/{name}/resume [put]
+func (h *Handler) schedulerTaskResume(c *fiber.Ctx) error {
+	return h.schedulerTaskAction(c, h.schedulerService.Resume)
+}
+
+// schedulerTaskTrigger godoc
+//
+//	@Summary	Запустить фоновую задачу вне очереди
+//	@Tags		Планировщик
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		name	path		string						true	"Название фоновой задачи"
+//	@Param		input	body		schedulerTaskActionRequest	true	"Тело запроса"
+//	@Success	200		{object}	schedulerTaskActionResponse	"Успешный ответ"
+//	@Failure	default	{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/scheduler/task/{name}/trigger [put]
+func (h *Handler) schedulerTaskTrigger(c *fiber.Ctx) error {
+	return h.schedulerTaskAction(c, h.schedulerService.Trigger)
+}
+
+func (h *Handler) schedulerTaskAction(c *fiber.Ctx, action func(ctx context.Context, name string) error) error {
+	ctx := c.UserContext()
+
+	var req schedulerTaskActionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := action(ctx, c.Params("name")); err != nil {
+		return h.schedulerTaskErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&schedulerTaskActionResponse{
+		Success: true,
+	})
+}
+
+type schedulerTaskUpdateRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `json:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `json:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `json:"lang_code" example:"en" validate:"required"`
+	// Новый интервал запуска в секундах
+	Interval *int64 `json:"interval" example:"10" validate:"omitempty,gte=1"`
+	// Новый размер пачки обрабатываемых операций
+	OperationBatchSize *int64 `json:"operation_batch_size" example:"50" validate:"omitempty,gte=1"`
+}
+
+// schedulerTaskUpdate godoc
+//
+//	@Summary	Изменить интервал запуска и размер пачки операций фоновой задачи
+//	@Tags		Планировщик
+//	@Accept		json
+//	@Produce	json
+//	@Security	ApiKeyAuth
+//	@Param		name	path		string						true	"Название фоновой задачи"
+//	@Param		input	body		schedulerTaskUpdateRequest	true	"Тело запроса"
+//	@Success	200		{object}	schedulerTaskActionResponse	"Успешный ответ"
+//	@Failure	default	{object}	errorResponse				"Ответ с ошибкой"
+//	@Router		/scheduler/task/{name} [put]
+func (h *Handler) schedulerTaskUpdate(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req schedulerTaskUpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if req.Interval == nil && req.OperationBatchSize == nil {
+		err := errors.New("interval or operation_batch_size must be stated")
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	var interval *time.Duration
+	if req.Interval != nil {
+		d := time.Duration(*req.Interval) * time.Second
+		interval = &d
+	}
+
+	if err := h.schedulerService.Update(ctx, c.Params("name"), interval, req.OperationBatchSize); err != nil {
+		return h.schedulerTaskErrorResponse(c, req.LangCode, err)
+	}
+
+	return c.JSON(&schedulerTaskActionResponse{
+		Success: true,
+	})
+}
+
+type schedulerTaskRunsRequest struct {
+	// Идентификатор специалиста поддержки
+	UserID string `query:"user_id" example:"1" validate:"required"`
+	// Идентификатор сессии специалиста техподдержки
+	SessionID string `query:"session_id" example:"LRXZmXPGusPCfys48LadjFew" validate:"required"`
+	// Код языка, обозначение по RFC 5646
+	LangCode string `query:"lang_code" example:"en" validate:"required"`
+	// Количество последних запусков (по умолчанию - 100)
+	MaxCount int64 `query:"max_count" example:"20" validate:"omitempty,gte=1,lte=1000"`
+}
+
+type schedulerTaskRunsResponse struct {
+	// Результат обработки запроса (всегда true)
+	Success bool `json:"success" example:"true" validate:"required"`
+	// Массив последних запусков фоновой задачи
+	Runs []schedulerTaskRun `json:"runs" validate:"required"`
+}
+
+// schedulerTaskRuns godoc
+//
+//	@Summary		Получить статистику последних запусков фоновой задачи
+//	@Description	Запуски по расписанию, в которых не было обработано ни одной операции, не сохраняются
+//	@Tags			Планировщик
+//	@Produce		json
+//	@Security		ApiKeyAuth
+//	@Param			name		path		string						true	"Название фоновой задачи"
+//	@Param			user_id		query		string						true	"Идентификатор специалиста техподдержки"
+//	@Param			session_id	query		string						true	"Идентификатор сессии специалиста техподдержки"
+//	@Param			lang_code	query		string						true	"Код языка, обозначение по RFC 5646"
+//	@Param			max_count	query		int							false	"Количество последних запусков (по умолчанию - 100)"
+//	@Success		200			{object}	schedulerTaskRunsResponse	"Успешный ответ"
+//	@Failure		default		{object}	errorResponse				"Ответ с ошибкой"
+//	@Router			/scheduler/task/{name}/runs [get]
+func (h *Handler) schedulerTaskRuns(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req schedulerTaskRunsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	if err := h.validate.Struct(req); err != nil {
+		return h.requestValidationErrorResponse(c, req.LangCode, err)
+	}
+
+	runs, err := h.schedulerService.Runs(ctx, c.Params("name"), req.MaxCount)
+	if err != nil {
+		return h.schedulerTaskErrorResponse(c, req.LangCode, err)
+	}
+
+	respRuns := make([]schedulerTaskRun, 0, len(runs))
+	for _, run := range runs {
+		respRuns = append(respRuns, h.schedulerTaskRun(run))
+	}
+
+	return c.JSON(&schedulerTaskRunsResponse{
+		Success: true,
+		Runs:    respRuns,
+	})
+}
+
+func (h *Handler) schedulerTaskRun(run *model.SchedulerTaskRun) schedulerTaskRun {
+	return schedulerTaskRun{
+		ID:         run.ID,
+		InstanceID: run.InstanceID,
+		Trigger:    string(run.Trigger),
+		Processed:  run.Processed,
+		Succeeded:  run.Succeeded,
+		Errored:    run.Errored,
+		StartedAt:  run.StartedAt.Unix(),
+		FinishedAt: run.FinishedAt.Unix(),
+		Duration:   run.Duration().Milliseconds(),
+	}
+}
+
+func (h *Handler) schedulerTaskErrorResponse(c *fiber.Ctx, langCode string, err error) error {
+	var perr *perror.Error
+	if errors.As(err, &perr) {
+		if perr.Group == perror.GroupInternal {
+			switch perr.Code {
+			case perror.CodeObjectNotFound:
+				return h.objectNotFoundErrorResponse(c, langCode, perr)
+			case perror.CodeInvalidRequest:
+				return h.invalidRequestErrorResponse(c, langCode, perr)
+			}
+		}
+	}
+	return h.internalErrorResponse(c, langCode, err)
+}
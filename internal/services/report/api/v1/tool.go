@@ -45,6 +45,7 @@ type tool struct {
 	// * Удален клиентом - "REMOVED_BY_CLIENT"
 	// * Ожидает восстановления - "PENDING_RECOVERY"
 	// * Заблокирован техподдержкой - "REMOVED_BY_ADMINISTRATOR"
+	// * Истек срок действия - "EXPIRED"
 	Status string `json:"status" example:"ACTIVE" validate:"required"`
 	// Название платежного средства
 	Name string `json:"name" example:"Карта брата" validate:"required"`
@@ -56,7 +57,7 @@ type tool struct {
 	CreatedAt int64 `json:"created_at" example:"1715974447" validate:"required"`
 	// Время последнего обновления платежного средства в формате UNIX Timestamp
 	UpdatedAt int64 `json:"updated_at" example:"1715974447" validate:"required"`
-	// Дополнительная информация о платежном средстве
+	// Дополнительная информация о платежном средстве (для ролей viewer и support без данных владельца, срока действия и номера кошелька)
 	Details *toolDetails `json:"details,omitempty"`
 }
 
@@ -69,6 +70,8 @@ type toolListRequest struct {
 	LangCode string `query:"lang_code" example:"en" validate:"required"`
 	// Идентификатор клиента
 	ClientID string `query:"client_id" example:"1" validate:"required"`
+	// Идентификатор магазина клиента (по умолчанию - "default")
+	MerchantID string `query:"merchant_id" example:"default"`
 }
 
 type toolListResponse struct {
@@ -88,11 +91,12 @@ type toolListResponse struct {
 //	@Param		session_id	query		string				true	"Идентификатор сессии специалиста техподдержки"
 //	@Param		lang_code	query		string				true	"Код языка, обозначение по RFC 5646"
 //	@Param		client_id	query		string				true	"Идентификатор клиента"
+//	@Param		merchant_id	query		string				false	"Идентификатор магазина клиента (по умолчанию - default)"
 //	@Success	200			{object}	toolListResponse	"Успешный ответ"
 //	@Failure	default		{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/tool [get]
 func (h *Handler) toolList(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req toolListRequest
 	if err := c.QueryParser(&req); err != nil {
@@ -103,14 +107,21 @@ func (h *Handler) toolList(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	tools, err := h.toolService.AllTools(ctx, req.ClientID)
+	tools, err := h.toolService.AllTools(ctx, model.MerchantIDOrDefault(merchantFilter(c, req.MerchantID)), req.ClientID)
 	if err != nil {
 		return h.internalErrorResponse(c, req.LangCode, err)
 	}
 
+	respTools := h.tools(tools)
+	if !hasPermission(c, permissionSensitiveView) {
+		for i := range respTools {
+			maskTool(&respTools[i])
+		}
+	}
+
 	resp := &toolListResponse{
 		Success: true,
-		Tools:   h.tools(tools),
+		Tools:   respTools,
 	}
 
 	return c.JSON(resp)
@@ -129,6 +140,10 @@ type toolRecoverRequest struct {
 	ExternalMethod string `json:"external_method" example:"yookassa_bank_card" validate:"required"`
 	// Идентификатор клиента
 	ClientID string `json:"client_id" example:"1" validate:"required"`
+	// Идентификатор магазина клиента (по умолчанию - "default")
+	MerchantID string `json:"merchant_id" example:"default"`
+	// Причина восстановления, сохраняется в журнале аудита
+	Reason string `json:"reason" example:"Клиент подтвердил владение картой" validate:"required"`
 }
 
 type toolRecoverResponse struct {
@@ -150,7 +165,7 @@ type toolRecoverResponse struct {
 //	@Failure	default	{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/tool/recover [put]
 func (h *Handler) toolRecover(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req toolRecoverRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -161,7 +176,12 @@ func (h *Handler) toolRecover(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	if err := h.toolService.RecoverTool(ctx, req.ID, req.ClientID, req.ExternalMethod); err != nil {
+	audit := model.AuditData{
+		ActorID: req.UserID,
+		Reason:  req.Reason,
+	}
+
+	if err := h.toolService.RecoverTool(ctx, req.ID, model.MerchantIDOrDefault(merchantFilter(c, req.MerchantID)), req.ClientID, req.ExternalMethod, audit); err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
 			if perr.Group == perror.GroupInternal {
@@ -197,6 +217,10 @@ type toolRemoveRequest struct {
 	ExternalMethod string `json:"external_method" example:"yookassa_bank_card" validate:"required"`
 	// Идентификатор клиента
 	ClientID string `json:"client_id" example:"1" validate:"required"`
+	// Идентификатор магазина клиента (по умолчанию - "default")
+	MerchantID string `json:"merchant_id" example:"default"`
+	// Причина удаления, сохраняется в журнале аудита
+	Reason string `json:"reason" example:"Подозрение на мошенничество" validate:"required"`
 }
 
 type toolRemoveResponse struct {
@@ -218,7 +242,7 @@ type toolRemoveResponse struct {
 //	@Failure	default	{object}	errorResponse		"Ответ с ошибкой"
 //	@Router		/tool/delete [delete]
 func (h *Handler) toolDelete(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	var req toolRemoveRequest
 	if err := c.BodyParser(&req); err != nil {
@@ -229,7 +253,12 @@ func (h *Handler) toolDelete(c *fiber.Ctx) error {
 		return h.requestValidationErrorResponse(c, req.LangCode, err)
 	}
 
-	if err := h.toolService.RemoveTool(ctx, req.ID, req.ClientID, req.ExternalMethod); err != nil {
+	audit := model.AuditData{
+		ActorID: req.UserID,
+		Reason:  req.Reason,
+	}
+
+	if err := h.toolService.RemoveTool(ctx, req.ID, model.MerchantIDOrDefault(merchantFilter(c, req.MerchantID)), req.ClientID, req.ExternalMethod, audit); err != nil {
 		var perr *perror.Error
 		if errors.As(err, &perr) {
 			if perr.Group == perror.GroupInternal {
@@ -2,36 +2,47 @@ package app
 
 import (
 	"context"
-	"io"
 	"log"
 	"log/slog"
 	"os"
 
 	"github.com/gofiber/fiber/v2"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/health"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/lifecycle"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/metrics"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/middleware"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/service/sorting"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/service/summary"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/translate"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/report/api"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/report/api/v1"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/report/client/engine"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/report/config"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/report/service/audit"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/report/service/blocklist"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/report/service/operation"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/report/service/reconciliation"
+	"github.com/tmrrwnxtsn/ecomway/internal/services/report/service/scheduler"
 	"github.com/tmrrwnxtsn/ecomway/internal/services/report/service/tool"
 )
 
 type App struct {
-	app     *fiber.App
-	config  config.ReportConfig
-	closers []io.Closer
+	app           *fiber.App
+	metricsServer *metrics.Server
+	config        config.ReportConfig
+	lifecycle     *lifecycle.Manager
 }
 
 func New(configPath string) *App {
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	logger := slog.New(tracing.NewLogHandler(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})))
 	slog.SetDefault(logger)
 
 	cfg, err := config.Load(configPath)
@@ -39,7 +50,14 @@ func New(configPath string) *App {
 		log.Fatalf("loading config: %v", err)
 	}
 
-	engineConn, err := grpc.Dial(cfg.Services.Engine.GRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	redact.SetDefault(redact.New(cfg.Report.Redaction))
+
+	shutdownTracing, err := tracing.Init(context.Background(), "report", cfg.Report.Tracing)
+	if err != nil {
+		log.Fatalf("initializing tracing: %v", err)
+	}
+
+	engineConn, err := pkggrpc.Dial(cfg.Services.Engine.GRPCAddress, cfg.Services.Engine.TLS)
 	if err != nil {
 		log.Fatalf("connecting engine service: %v", err)
 	}
@@ -50,25 +68,68 @@ func New(configPath string) *App {
 	sortingService := sorting.NewService()
 	summaryService := summary.NewService()
 	toolService := tool.NewService(engineClient)
+	reconciliationService := reconciliation.NewService(engineClient)
+	schedulerService := scheduler.NewService(engineClient)
+	auditService := audit.NewService(engineClient)
+	blockListService := blocklist.NewService(engineClient)
 	translator := translate.NewTranslator("en", "ru")
 
+	apiKeys, err := cfg.Report.Auth.Keys()
+	if err != nil {
+		log.Fatalf("loading API keys: %v", err)
+	}
+	requestVerifier := auth.NewVerifier(
+		auth.NewMemoryStore(apiKeys...),
+		// подписи хранятся в памяти: защита от повторной отправки действует в пределах одного экземпляра
+		auth.NewMemoryReplayCache(),
+		cfg.Report.Auth.ReplayWindowDuration(),
+		cfg.Report.APIKey,
+	)
+
+	sessionVerifier, err := session.NewVerifier(cfg.Report.Session)
+	if err != nil {
+		log.Fatalf("creating session verifier: %v", err)
+	}
+
 	apiHandlerV1 := v1.NewHandler(v1.HandlerOptions{
-		OperationService: operationService,
-		SortingService:   sortingService,
-		SummaryService:   summaryService,
-		ToolService:      toolService,
-		Translator:       translator,
-		APIKey:           cfg.Report.APIKey,
+		OperationService:      operationService,
+		SortingService:        sortingService,
+		SummaryService:        summaryService,
+		ToolService:           toolService,
+		ReconciliationService: reconciliationService,
+		SchedulerService:      schedulerService,
+		AuditService:          auditService,
+		BlockListService:      blockListService,
+		Translator:            translator,
+		RequestVerifier:       requestVerifier,
+		SessionVerifier:       sessionVerifier,
 	})
 	apiServer := api.NewServer(apiHandlerV1)
 
+	healthChecker := health.NewChecker(0)
+	healthChecker.Add("engine", health.GRPCCheck(engineConn))
+
 	app := fiber.New()
+	app.Use(middleware.NewMetrics())
+	app.Get("/healthz", health.LivenessHandler())
+	app.Get("/readyz", healthChecker.ReadinessHandler())
+	app.Use(middleware.NewTracing())
 	apiServer.Init(app)
 
+	// метрики отдаются на отдельном внутреннем адресе, чтобы не публиковать их вместе с API
+	metricsServer := metrics.NewServer(cfg.Report.MetricsAddress)
+
+	lifecycleManager := lifecycle.NewManager()
+	lifecycleManager.Add("http server", app.ShutdownWithContext)
+	lifecycleManager.Add("metrics server", metricsServer.Shutdown)
+	lifecycleManager.AddCloser("engine connection", engineConn)
+	lifecycleManager.Add("tracing", shutdownTracing)
+
 	return &App{
-		app:     app,
-		config:  cfg.Report,
-		closers: []io.Closer{engineConn},
+		app:           app,
+		metricsServer: metricsServer,
+		config:        cfg.Report,
+		lifecycle:     lifecycleManager,
 	}
 }
 
@@ -79,19 +140,15 @@ func (a *App) Start() {
 		return a.app.Listen(a.config.HTTPAddress)
 	})
 
+	group.Go(func() error {
+		return a.metricsServer.Serve()
+	})
+
 	if err := group.Wait(); err != nil {
 		log.Fatalf("app: %v", err)
 	}
 }
 
-func (a *App) Stop(_ context.Context) {
-	if err := a.app.Shutdown(); err != nil {
-		log.Printf("failed to shutdown the app: %v", err)
-	}
-
-	for _, c := range a.closers {
-		if err := c.Close(); err != nil {
-			log.Printf("failed to close resource: %v", err)
-		}
-	}
+func (a *App) Stop(ctx context.Context) {
+	a.lifecycle.Stop(ctx)
 }
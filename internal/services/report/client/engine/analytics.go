@@ -0,0 +1,78 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	pb "github.com/tmrrwnxtsn/ecomway/api/proto/shared"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) OperationsAnalytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error) {
+	request := reportOperationsAnalyticsRequestFromCriteria(criteria)
+
+	response, err := c.client.ReportOperationsAnalytics(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+
+	items := make([]model.OperationAnalyticsItem, 0, len(response.GetItems()))
+	for _, pbItem := range response.GetItems() {
+		items = append(items, operationsAnalyticsItemFromProto(pbItem))
+	}
+	return items, nil
+}
+
+func reportOperationsAnalyticsRequestFromCriteria(criteria model.OperationAnalyticsCriteria) *pbEngine.ReportOperationsAnalyticsRequest {
+	request := &pbEngine.ReportOperationsAnalyticsRequest{
+		Granularity:   string(criteria.Granularity),
+		TimeZone:      criteria.TimeZone,
+		CreatedAtFrom: criteria.CreatedAtFrom.UTC().Unix(),
+		CreatedAtTo:   criteria.CreatedAtTo.UTC().Unix(),
+		MerchantId:    criteria.MerchantID,
+	}
+	if len(criteria.GroupBy) > 0 {
+		groupBy := make([]string, 0, len(criteria.GroupBy))
+		for _, field := range criteria.GroupBy {
+			groupBy = append(groupBy, string(field))
+		}
+		request.GroupBy = groupBy
+	}
+	if criteria.Types != nil {
+		pbTypes := make([]pb.OperationType, 0, len(*criteria.Types))
+		for _, operationType := range *criteria.Types {
+			pbTypes = append(pbTypes, convert.OperationTypeToProto(operationType))
+		}
+		request.Types = pbTypes
+	}
+	if criteria.ExternalSystems != nil {
+		request.ExternalSystems = *criteria.ExternalSystems
+	}
+	return request
+}
+
+func operationsAnalyticsItemFromProto(item *pbEngine.OperationsAnalyticsItem) model.OperationAnalyticsItem {
+	result := model.OperationAnalyticsItem{
+		PeriodStart:          time.Unix(item.GetPeriodStart(), 0).UTC(),
+		ExternalSystem:       item.GetExternalSystem(),
+		ExternalMethod:       item.GetExternalMethod(),
+		Currency:             item.GetCurrency(),
+		CreatedCount:         item.GetCreatedCount(),
+		SucceededCount:       item.GetSucceededCount(),
+		FailedCount:          item.GetFailedCount(),
+		SucceededAmount:      item.GetSucceededAmount(),
+		MedianProcessingTime: time.Duration(item.GetMedianProcessingTime()) * time.Second,
+	}
+
+	if item.Type != nil {
+		result.Type = convert.OperationTypeFromProto(item.GetType())
+	}
+
+	return result
+}
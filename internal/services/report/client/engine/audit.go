@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) AuditLog(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error) {
+	request := &pbEngine.ReportAuditLogRequest{
+		ActorId:    criteria.ActorID,
+		TargetId:   criteria.TargetID,
+		ClientId:   criteria.ClientID,
+		MerchantId: criteria.MerchantID,
+		MaxCount:   criteria.MaxCount,
+	}
+	if criteria.Action != nil {
+		action := string(*criteria.Action)
+		request.Action = &action
+	}
+	if criteria.TargetType != nil {
+		targetType := string(*criteria.TargetType)
+		request.TargetType = &targetType
+	}
+	if !criteria.CreatedAtFrom.IsZero() {
+		createdAtFromUnix := criteria.CreatedAtFrom.UTC().Unix()
+		request.CreatedAtFrom = &createdAtFromUnix
+	}
+	if !criteria.CreatedAtTo.IsZero() {
+		createdAtToUnix := criteria.CreatedAtTo.UTC().Unix()
+		request.CreatedAtTo = &createdAtToUnix
+	}
+
+	response, err := c.client.ReportAuditLog(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.AuditEntry, 0, len(response.GetEntries()))
+	for _, pbEntry := range response.GetEntries() {
+		entries = append(entries, auditEntryFromProto(pbEntry))
+	}
+	return entries, nil
+}
+
+func auditToProto(audit model.AuditData) *pbEngine.Audit {
+	return &pbEngine.Audit{
+		ActorId: audit.ActorID,
+		Reason:  audit.Reason,
+	}
+}
+
+func auditEntryFromProto(entry *pbEngine.AuditEntry) *model.AuditEntry {
+	return &model.AuditEntry{
+		ID:         entry.GetId(),
+		ActorID:    entry.GetActorId(),
+		Action:     model.AuditAction(entry.GetAction()),
+		TargetType: model.AuditTargetType(entry.GetTargetType()),
+		TargetID:   entry.GetTargetId(),
+		ClientID:   entry.GetClientId(),
+		MerchantID: entry.GetMerchantId(),
+		Before:     entry.GetBefore(),
+		After:      entry.GetAfter(),
+		Reason:     entry.GetReason(),
+		CreatedAt:  time.Unix(entry.GetCreatedAt(), 0).UTC(),
+	}
+}
@@ -0,0 +1,118 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) BlockList(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error) {
+	request := &pbEngine.ReportBlockListRequest{
+		MerchantId: criteria.MerchantID,
+		Value:      criteria.Value,
+		ActiveOnly: criteria.ActiveOnly,
+		MaxCount:   criteria.MaxCount,
+	}
+	if criteria.Type != nil {
+		entryType := string(*criteria.Type)
+		request.Type = &entryType
+	}
+
+	response, err := c.client.ReportBlockList(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.BlockEntry, 0, len(response.GetEntries()))
+	for _, pbEntry := range response.GetEntries() {
+		entries = append(entries, blockEntryFromProto(pbEntry))
+	}
+	return entries, nil
+}
+
+func (c *Client) CreateBlockEntry(ctx context.Context, entry *model.BlockEntry, audit model.AuditData) (*model.BlockEntry, error) {
+	request := &pbEngine.CreateBlockEntryRequest{
+		MerchantId: entry.MerchantID,
+		Type:       string(entry.Type),
+		Value:      entry.Value,
+		ValueTo:    entry.ValueTo,
+		ExpiresAt:  unixOrNil(entry.ExpiresAt),
+		Audit:      auditToProto(audit),
+	}
+
+	response, err := c.client.CreateBlockEntry(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+
+	return blockEntryFromProto(response.GetEntry()), nil
+}
+
+func (c *Client) UpdateBlockEntry(ctx context.Context, id int64, merchantID *string, expiresAt time.Time, audit model.AuditData) (*model.BlockEntry, error) {
+	request := &pbEngine.UpdateBlockEntryRequest{
+		Id:         id,
+		MerchantId: merchantID,
+		ExpiresAt:  unixOrNil(expiresAt),
+		Audit:      auditToProto(audit),
+	}
+
+	response, err := c.client.UpdateBlockEntry(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+
+	return blockEntryFromProto(response.GetEntry()), nil
+}
+
+func (c *Client) DeleteBlockEntry(ctx context.Context, id int64, merchantID *string, audit model.AuditData) error {
+	request := &pbEngine.DeleteBlockEntryRequest{
+		Id:         id,
+		MerchantId: merchantID,
+		Audit:      auditToProto(audit),
+	}
+
+	_, err := c.client.DeleteBlockEntry(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return perr
+		}
+		return err
+	}
+
+	return nil
+}
+
+func unixOrNil(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	unix := t.UTC().Unix()
+	return &unix
+}
+
+func blockEntryFromProto(entry *pbEngine.BlockEntry) *model.BlockEntry {
+	e := &model.BlockEntry{
+		ID:         entry.GetId(),
+		MerchantID: entry.GetMerchantId(),
+		Type:       model.BlockEntryType(entry.GetType()),
+		Value:      entry.GetValue(),
+		ValueTo:    entry.GetValueTo(),
+		Reason:     entry.GetReason(),
+		CreatedBy:  entry.GetCreatedBy(),
+		CreatedAt:  time.Unix(entry.GetCreatedAt(), 0).UTC(),
+		UpdatedAt:  time.Unix(entry.GetUpdatedAt(), 0).UTC(),
+	}
+	if entry.ExpiresAt != nil {
+		e.ExpiresAt = time.Unix(entry.GetExpiresAt(), 0).UTC()
+	}
+	return e
+}
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) DeadLetterOperations(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error) {
+	request := &pbEngine.DeadLetterOperationsRequest{
+		OperationId: criteria.OperationID,
+		MerchantId:  criteria.MerchantID,
+		MaxCount:    criteria.MaxCount,
+	}
+
+	response, err := c.client.DeadLetterOperations(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*model.OperationAttempt, 0, len(response.GetOperations()))
+	for _, pbOperation := range response.GetOperations() {
+		attempts = append(attempts, deadLetterOperationFromProto(pbOperation))
+	}
+	return attempts, nil
+}
+
+func (c *Client) RetryDeadLetterOperation(ctx context.Context, operationID int64, merchantID *string) error {
+	request := &pbEngine.RetryDeadLetterOperationRequest{
+		OperationId: operationID,
+		MerchantId:  merchantID,
+	}
+
+	_, err := c.client.RetryDeadLetterOperation(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return perr
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) ResolveDeadLetterOperation(ctx context.Context, operationID int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error {
+	request := &pbEngine.ResolveDeadLetterOperationRequest{
+		OperationId:       operationID,
+		MerchantId:        merchantID,
+		NewStatus:         convert.OperationStatusToProto(newStatus),
+		NewExternalStatus: convert.OperationExternalStatusToProto(newExternalStatus),
+	}
+
+	_, err := c.client.ResolveDeadLetterOperation(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return perr
+		}
+		return err
+	}
+
+	return nil
+}
+
+func deadLetterOperationFromProto(operation *pbEngine.DeadLetterOperation) *model.OperationAttempt {
+	return &model.OperationAttempt{
+		OperationID:     operation.GetOperationId(),
+		TaskName:        operation.GetTaskName(),
+		Attempts:        int(operation.GetAttempts()),
+		LastError:       operation.GetLastError(),
+		LastAttemptAt:   time.Unix(operation.GetLastAttemptAt(), 0).UTC(),
+		DeadLetteredAt:  time.Unix(operation.GetDeadLetteredAt(), 0).UTC(),
+		OperationType:   convert.OperationTypeFromProto(operation.GetOperationType()),
+		OperationStatus: convert.OperationStatusFromProto(operation.GetOperationStatus()),
+		ExternalSystem:  operation.GetExternalSystem(),
+		ExternalMethod:  operation.GetExternalMethod(),
+	}
+}
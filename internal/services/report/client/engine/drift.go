@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) StatusDrifts(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error) {
+	request := &pbEngine.ReportStatusDriftsRequest{
+		OperationId: criteria.OperationID,
+		MerchantId:  criteria.MerchantID,
+		Fixed:       criteria.Fixed,
+	}
+	if !criteria.DetectedAtFrom.IsZero() {
+		detectedAtFromUnix := criteria.DetectedAtFrom.UTC().Unix()
+		request.DetectedAtFrom = &detectedAtFromUnix
+	}
+	if !criteria.DetectedAtTo.IsZero() {
+		detectedAtToUnix := criteria.DetectedAtTo.UTC().Unix()
+		request.DetectedAtTo = &detectedAtToUnix
+	}
+
+	response, err := c.client.ReportStatusDrifts(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]*model.OperationStatusDrift, 0, len(response.GetDrifts()))
+	for _, pbDrift := range response.GetDrifts() {
+		drifts = append(drifts, statusDriftFromProto(pbDrift))
+	}
+	return drifts, nil
+}
+
+func statusDriftFromProto(drift *pbEngine.StatusDrift) *model.OperationStatusDrift {
+	result := &model.OperationStatusDrift{
+		ID:             drift.GetId(),
+		OperationID:    drift.GetOperationId(),
+		OperationType:  convert.OperationTypeFromProto(drift.GetOperationType()),
+		ExternalSystem: drift.GetExternalSystem(),
+		ExternalMethod: drift.GetExternalMethod(),
+		Status:         convert.OperationStatusFromProto(drift.GetStatus()),
+		ProviderStatus: convert.OperationExternalStatusFromProto(drift.GetProviderStatus()),
+		Fixed:          drift.GetFixed(),
+		FixError:       drift.GetFixError(),
+		DetectedAt:     time.Unix(drift.GetDetectedAt(), 0).UTC(),
+	}
+
+	if drift.ExternalStatus != nil {
+		result.ExternalStatus = convert.OperationExternalStatusFromProto(drift.GetExternalStatus())
+	}
+
+	return result
+}
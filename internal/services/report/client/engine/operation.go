@@ -26,9 +26,10 @@ func (c *Client) ReportOperations(ctx context.Context, criteria model.OperationC
 	return operations, nil
 }
 
-func (c *Client) GetExternalOperationStatus(ctx context.Context, id int64) (model.OperationExternalStatus, error) {
+func (c *Client) GetExternalOperationStatus(ctx context.Context, id int64, merchantID *string) (model.OperationExternalStatus, error) {
 	request := &pbEngine.GetOperationExternalStatusRequest{
 		OperationId: id,
+		MerchantId:  merchantID,
 	}
 
 	response, err := c.client.GetOperationExternalStatus(ctx, request)
@@ -43,11 +44,13 @@ func (c *Client) GetExternalOperationStatus(ctx context.Context, id int64) (mode
 	return externalStatus, nil
 }
 
-func (c *Client) ChangeStatus(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error {
+func (c *Client) ChangeStatus(ctx context.Context, id int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus, audit model.AuditData) error {
 	request := &pbEngine.ChangeOperationStatusRequest{
 		Id:                id,
+		MerchantId:        merchantID,
 		NewStatus:         convert.OperationStatusToProto(newStatus),
 		NewExternalStatus: convert.OperationExternalStatusToProto(newExternalStatus),
+		Audit:             auditToProto(audit),
 	}
 
 	_, err := c.client.ChangeOperationStatus(ctx, request)
@@ -64,6 +67,7 @@ func (c *Client) ChangeStatus(ctx context.Context, id int64, newStatus model.Ope
 func reportOperationRequestFromCriteria(criteria model.OperationCriteria) *pbEngine.ReportOperationsRequest {
 	request := &pbEngine.ReportOperationsRequest{
 		Id:         criteria.ID,
+		MerchantId: criteria.MerchantID,
 		UserId:     criteria.UserID,
 		ExternalId: criteria.ExternalID,
 	}
@@ -95,6 +99,7 @@ func reportOperationRequestFromCriteria(criteria model.OperationCriteria) *pbEng
 func reportOperationFromProto(op *pbEngine.ReportOperation) model.ReportOperation {
 	result := model.ReportOperation{
 		ID:             op.GetId(),
+		MerchantID:     op.GetMerchantId(),
 		UserID:         op.GetUserId(),
 		Type:           convert.OperationTypeFromProto(op.GetType()),
 		Currency:       op.GetCurrency(),
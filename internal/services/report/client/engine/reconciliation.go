@@ -0,0 +1,121 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) Reconcile(ctx context.Context, data model.ReconcileData) (*model.ReconciliationRun, error) {
+	request := &pbEngine.ReconcileRequest{
+		MerchantId:     data.MerchantID,
+		ExternalSystem: data.ExternalSystem,
+		PeriodFrom:     data.PeriodFrom.UTC().Unix(),
+		PeriodTo:       data.PeriodTo.UTC().Unix(),
+		Items:          make([]*pbEngine.RegistryItem, 0, len(data.Items)),
+	}
+	for _, item := range data.Items {
+		request.Items = append(request.Items, &pbEngine.RegistryItem{
+			ExternalId: item.ExternalID,
+			Currency:   item.Currency,
+			Amount:     item.Amount,
+			Status:     convert.OperationExternalStatusToProto(item.Status),
+		})
+	}
+
+	response, err := c.client.Reconcile(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+
+	return reconciliationRunFromProto(response.GetRun()), nil
+}
+
+func (c *Client) ReconciliationRuns(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error) {
+	request := &pbEngine.ReconciliationRunsRequest{
+		MerchantId: merchantID,
+	}
+	if externalSystem != "" {
+		request.ExternalSystem = &externalSystem
+	}
+
+	response, err := c.client.ReconciliationRuns(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*model.ReconciliationRun, 0, len(response.GetRuns()))
+	for _, pbRun := range response.GetRuns() {
+		runs = append(runs, reconciliationRunFromProto(pbRun))
+	}
+	return runs, nil
+}
+
+func (c *Client) GetReconciliationRun(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error) {
+	request := &pbEngine.GetReconciliationRunRequest{
+		Id:         id,
+		MerchantId: merchantID,
+	}
+
+	response, err := c.client.GetReconciliationRun(ctx, request)
+	if err != nil {
+		if perr := perror.FromProto(err); perr != nil {
+			return nil, perr
+		}
+		return nil, err
+	}
+
+	return reconciliationRunFromProto(response.GetRun()), nil
+}
+
+func reconciliationRunFromProto(run *pbEngine.ReconciliationRun) *model.ReconciliationRun {
+	result := &model.ReconciliationRun{
+		ID:               run.GetId(),
+		MerchantID:       run.GetMerchantId(),
+		ExternalSystem:   run.GetExternalSystem(),
+		PeriodFrom:       time.Unix(run.GetPeriodFrom(), 0).UTC(),
+		PeriodTo:         time.Unix(run.GetPeriodTo(), 0).UTC(),
+		RegistryCount:    run.GetRegistryCount(),
+		MatchedCount:     run.GetMatchedCount(),
+		DiscrepancyCount: run.GetDiscrepancyCount(),
+		CreatedAt:        time.Unix(run.GetCreatedAt(), 0).UTC(),
+	}
+
+	if len(run.GetDiscrepancies()) > 0 {
+		result.Discrepancies = make([]model.ReconciliationDiscrepancy, 0, len(run.GetDiscrepancies()))
+		for _, d := range run.GetDiscrepancies() {
+			result.Discrepancies = append(result.Discrepancies, reconciliationDiscrepancyFromProto(d))
+		}
+	}
+
+	return result
+}
+
+func reconciliationDiscrepancyFromProto(d *pbEngine.ReconciliationDiscrepancy) model.ReconciliationDiscrepancy {
+	result := model.ReconciliationDiscrepancy{
+		Type:             model.ReconciliationDiscrepancyType(d.GetType()),
+		ExternalID:       d.GetExternalId(),
+		OperationID:      d.GetOperationId(),
+		Currency:         d.GetCurrency(),
+		Amount:           d.GetAmount(),
+		RegistryCurrency: d.GetRegistryCurrency(),
+		RegistryAmount:   d.GetRegistryAmount(),
+	}
+
+	if d.Status != nil {
+		result.Status = convert.OperationStatusFromProto(d.GetStatus())
+	}
+
+	if d.RegistryStatus != nil {
+		result.RegistryStatus = convert.OperationExternalStatusFromProto(d.GetRegistryStatus())
+	}
+
+	return result
+}
@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	pbEngine "github.com/tmrrwnxtsn/ecomway/api/proto/engine"
+	perror "github.com/tmrrwnxtsn/ecomway/internal/pkg/error"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+func (c *Client) SchedulerTasks(ctx context.Context) ([]*model.SchedulerTask, error) {
+	response, err := c.client.SchedulerTasks(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.SchedulerTask, 0, len(response.GetTasks()))
+	for _, pbTask := range response.GetTasks() {
+		tasks = append(tasks, schedulerTaskFromProto(pbTask))
+	}
+	return tasks, nil
+}
+
+func (c *Client) PauseSchedulerTask(ctx context.Context, name string) error {
+	_, err := c.client.PauseSchedulerTask(ctx, &pbEngine.SchedulerTaskRequest{Name: name})
+	return schedulerTaskError(err)
+}
+
+func (c *Client) ResumeSchedulerTask(ctx context.Context, name string) error {
+	_, err := c.client.ResumeSchedulerTask(ctx, &pbEngine.SchedulerTaskRequest{Name: name})
+	return schedulerTaskError(err)
+}
+
+func (c *Client) TriggerSchedulerTask(ctx context.Context, name string) error {
+	_, err := c.client.TriggerSchedulerTask(ctx, &pbEngine.SchedulerTaskRequest{Name: name})
+	return schedulerTaskError(err)
+}
+
+func (c *Client) UpdateSchedulerTask(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error {
+	request := &pbEngine.UpdateSchedulerTaskRequest{
+		Name:               name,
+		OperationBatchSize: operationBatchSize,
+	}
+	if interval != nil {
+		intervalSec := int64(interval.Seconds())
+		request.Interval = &intervalSec
+	}
+
+	_, err := c.client.UpdateSchedulerTask(ctx, request)
+	return schedulerTaskError(err)
+}
+
+func (c *Client) SchedulerTaskRuns(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error) {
+	request := &pbEngine.SchedulerTaskRunsRequest{
+		Name:     name,
+		MaxCount: maxCount,
+	}
+
+	response, err := c.client.SchedulerTaskRuns(ctx, request)
+	if err != nil {
+		return nil, schedulerTaskError(err)
+	}
+
+	runs := make([]*model.SchedulerTaskRun, 0, len(response.GetRuns()))
+	for _, pbRun := range response.GetRuns() {
+		runs = append(runs, schedulerTaskRunFromProto(pbRun))
+	}
+	return runs, nil
+}
+
+func schedulerTaskError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if perr := perror.FromProto(err); perr != nil {
+		return perr
+	}
+	return err
+}
+
+func schedulerTaskFromProto(task *pbEngine.SchedulerTask) *model.SchedulerTask {
+	return &model.SchedulerTask{
+		Name:                      task.GetName(),
+		IsPaused:                  task.GetIsPaused(),
+		Interval:                  time.Duration(task.GetInterval()) * time.Second,
+		OperationBatchSize:        task.GetOperationBatchSize(),
+		DefaultInterval:           time.Duration(task.GetDefaultInterval()) * time.Second,
+		DefaultOperationBatchSize: task.GetDefaultOperationBatchSize(),
+	}
+}
+
+func schedulerTaskRunFromProto(run *pbEngine.SchedulerTaskRun) *model.SchedulerTaskRun {
+	startedAt := time.Unix(run.GetStartedAt(), 0).UTC()
+
+	return &model.SchedulerTaskRun{
+		SchedulerTaskRunResult: model.SchedulerTaskRunResult{
+			Processed: run.GetProcessed(),
+			Succeeded: run.GetSucceeded(),
+			Errored:   run.GetErrored(),
+		},
+		ID:         run.GetId(),
+		TaskName:   run.GetTaskName(),
+		InstanceID: run.GetInstanceId(),
+		Trigger:    model.SchedulerTaskTrigger(run.GetTrigger()),
+		StartedAt:  startedAt,
+		// время окончания восстанавливаем по длительности, чтобы не терять точность до миллисекунд
+		FinishedAt: startedAt.Add(time.Duration(run.GetDurationMs()) * time.Millisecond),
+	}
+}
@@ -9,9 +9,10 @@ import (
 	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
 )
 
-func (c *Client) AvailableTools(ctx context.Context, userID string) ([]*model.Tool, error) {
+func (c *Client) AvailableTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error) {
 	request := &pb.AvailableToolsRequest{
-		UserId: userID,
+		MerchantId: merchantID,
+		UserId:     userID,
 	}
 
 	response, err := c.client.AvailableTools(ctx, request)
@@ -26,11 +27,13 @@ func (c *Client) AvailableTools(ctx context.Context, userID string) ([]*model.To
 	return tools, nil
 }
 
-func (c *Client) RecoverTool(ctx context.Context, id string, userID string, externalMethod string) error {
+func (c *Client) RecoverTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error {
 	request := &pb.RecoverToolRequest{
 		Id:             id,
+		MerchantId:     merchantID,
 		UserId:         userID,
 		ExternalMethod: externalMethod,
+		Audit:          auditToProto(audit),
 	}
 
 	_, err := c.client.RecoverTool(ctx, request)
@@ -44,12 +47,14 @@ func (c *Client) RecoverTool(ctx context.Context, id string, userID string, exte
 	return nil
 }
 
-func (c *Client) RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error {
+func (c *Client) RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error {
 	request := &pb.RemoveToolRequest{
 		Id:             id,
+		MerchantId:     merchantID,
 		UserId:         userID,
 		ExternalMethod: externalMethod,
 		ActionSource:   pb.ActionSource_ACTION_SOURCE_ADMINISTRATOR,
+		Audit:          auditToProto(audit),
 	}
 
 	_, err := c.client.RemoveTool(ctx, request)
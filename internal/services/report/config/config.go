@@ -5,6 +5,12 @@ import (
 	"os"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/auth"
+	pkggrpc "github.com/tmrrwnxtsn/ecomway/internal/pkg/grpc"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/redact"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/session"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/tracing"
 )
 
 const apiKeyEnvKey = "API_KEY"
@@ -16,7 +22,18 @@ type Config struct {
 
 type ReportConfig struct {
 	HTTPAddress string `yaml:"http_address"`
-	APIKey      string
+	// MetricsAddress - адрес отдельного внутреннего HTTP-сервера, отдающего метрики в формате Prometheus
+	MetricsAddress string `yaml:"metrics_address"`
+	// APIKey - статический ключ для режима совместимости; подгружается из переменной среды окружения
+	APIKey string
+	// Auth - клиенты API и их ключи подписи запросов
+	Auth auth.Config `yaml:"auth"`
+	// Session - способ проверки сессий специалистов; роли специалистов передаются в сессии
+	Session session.Config `yaml:"session"`
+	// Tracing - настройки экспорта трассировок OpenTelemetry
+	Tracing tracing.Config `yaml:"tracing"`
+	// Redaction - правила скрытия чувствительных данных в журналах
+	Redaction redact.Config `yaml:"redaction"`
 }
 
 type ServicesConfig struct {
@@ -25,6 +42,8 @@ type ServicesConfig struct {
 
 type ServiceConfig struct {
 	GRPCAddress string `yaml:"grpc_address"`
+	// TLS - настройки mTLS для соединения с сервисом
+	TLS pkggrpc.TLSConfig `yaml:"tls"`
 }
 
 func Load(configPath string) (Config, error) {
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type EngineClient interface {
+	AuditLog(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error)
+}
+
+type Service struct {
+	engineClient EngineClient
+}
+
+func NewService(engineClient EngineClient) *Service {
+	return &Service{
+		engineClient: engineClient,
+	}
+}
+
+func (s *Service) Entries(ctx context.Context, criteria model.AuditEntryCriteria) ([]*model.AuditEntry, error) {
+	return s.engineClient.AuditLog(ctx, criteria)
+}
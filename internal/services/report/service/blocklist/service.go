@@ -0,0 +1,41 @@
+package blocklist
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type EngineClient interface {
+	BlockList(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error)
+	CreateBlockEntry(ctx context.Context, entry *model.BlockEntry, audit model.AuditData) (*model.BlockEntry, error)
+	UpdateBlockEntry(ctx context.Context, id int64, merchantID *string, expiresAt time.Time, audit model.AuditData) (*model.BlockEntry, error)
+	DeleteBlockEntry(ctx context.Context, id int64, merchantID *string, audit model.AuditData) error
+}
+
+type Service struct {
+	engineClient EngineClient
+}
+
+func NewService(engineClient EngineClient) *Service {
+	return &Service{
+		engineClient: engineClient,
+	}
+}
+
+func (s *Service) Entries(ctx context.Context, criteria model.BlockEntryCriteria) ([]*model.BlockEntry, error) {
+	return s.engineClient.BlockList(ctx, criteria)
+}
+
+func (s *Service) Create(ctx context.Context, entry *model.BlockEntry, audit model.AuditData) (*model.BlockEntry, error) {
+	return s.engineClient.CreateBlockEntry(ctx, entry, audit)
+}
+
+func (s *Service) Update(ctx context.Context, id int64, merchantID *string, expiresAt time.Time, audit model.AuditData) (*model.BlockEntry, error) {
+	return s.engineClient.UpdateBlockEntry(ctx, id, merchantID, expiresAt, audit)
+}
+
+func (s *Service) Delete(ctx context.Context, id int64, merchantID *string, audit model.AuditData) error {
+	return s.engineClient.DeleteBlockEntry(ctx, id, merchantID, audit)
+}
@@ -8,8 +8,13 @@ import (
 
 type EngineClient interface {
 	ReportOperations(ctx context.Context, criteria model.OperationCriteria) ([]model.ReportOperation, error)
-	GetExternalOperationStatus(ctx context.Context, id int64) (model.OperationExternalStatus, error)
-	ChangeStatus(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error
+	GetExternalOperationStatus(ctx context.Context, id int64, merchantID *string) (model.OperationExternalStatus, error)
+	ChangeStatus(ctx context.Context, id int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus, audit model.AuditData) error
+	OperationsAnalytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error)
+	StatusDrifts(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error)
+	DeadLetterOperations(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error)
+	RetryDeadLetterOperation(ctx context.Context, operationID int64, merchantID *string) error
+	ResolveDeadLetterOperation(ctx context.Context, operationID int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error
 }
 
 type Service struct {
@@ -26,10 +31,30 @@ func (s *Service) ReportOperations(ctx context.Context, criteria model.Operation
 	return s.engineClient.ReportOperations(ctx, criteria)
 }
 
-func (s *Service) GetExternalOperationStatus(ctx context.Context, id int64) (model.OperationExternalStatus, error) {
-	return s.engineClient.GetExternalOperationStatus(ctx, id)
+func (s *Service) GetExternalOperationStatus(ctx context.Context, id int64, merchantID *string) (model.OperationExternalStatus, error) {
+	return s.engineClient.GetExternalOperationStatus(ctx, id, merchantID)
 }
 
-func (s *Service) ChangeStatus(ctx context.Context, id int64, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error {
-	return s.engineClient.ChangeStatus(ctx, id, newStatus, newExternalStatus)
+func (s *Service) ChangeStatus(ctx context.Context, id int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus, audit model.AuditData) error {
+	return s.engineClient.ChangeStatus(ctx, id, merchantID, newStatus, newExternalStatus, audit)
+}
+
+func (s *Service) Analytics(ctx context.Context, criteria model.OperationAnalyticsCriteria) ([]model.OperationAnalyticsItem, error) {
+	return s.engineClient.OperationsAnalytics(ctx, criteria)
+}
+
+func (s *Service) StatusDrifts(ctx context.Context, criteria model.OperationStatusDriftCriteria) ([]*model.OperationStatusDrift, error) {
+	return s.engineClient.StatusDrifts(ctx, criteria)
+}
+
+func (s *Service) DeadLetters(ctx context.Context, criteria model.OperationAttemptCriteria) ([]*model.OperationAttempt, error) {
+	return s.engineClient.DeadLetterOperations(ctx, criteria)
+}
+
+func (s *Service) RetryDeadLetter(ctx context.Context, id int64, merchantID *string) error {
+	return s.engineClient.RetryDeadLetterOperation(ctx, id, merchantID)
+}
+
+func (s *Service) ResolveDeadLetter(ctx context.Context, id int64, merchantID *string, newStatus model.OperationStatus, newExternalStatus model.OperationExternalStatus) error {
+	return s.engineClient.ResolveDeadLetterOperation(ctx, id, merchantID, newStatus, newExternalStatus)
 }
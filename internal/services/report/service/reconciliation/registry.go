@@ -0,0 +1,77 @@
+package reconciliation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gocarina/gocsv"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/convert"
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+const (
+	RegistryFormatCSV  = "csv"
+	RegistryFormatJSON = "json"
+)
+
+// registryRow описывает строку реестра ПС: сумма передается в дробных единицах валюты.
+type registryRow struct {
+	ExternalID string  `csv:"external_id" json:"external_id"`
+	Amount     float64 `csv:"amount" json:"amount"`
+	Currency   string  `csv:"currency" json:"currency"`
+	Status     string  `csv:"status" json:"status"`
+}
+
+// ParseRegistry разбирает реестр ПС в формате CSV или JSON.
+func (s *Service) ParseRegistry(format string, r io.Reader) ([]model.RegistryItem, error) {
+	var rows []registryRow
+
+	switch format {
+	case RegistryFormatCSV:
+		if err := gocsv.Unmarshal(r, &rows); err != nil {
+			return nil, fmt.Errorf("unmarshal csv registry: %w", err)
+		}
+	case RegistryFormatJSON:
+		if err := json.NewDecoder(r).Decode(&rows); err != nil {
+			return nil, fmt.Errorf("unmarshal json registry: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unresolved registry format: %v", format)
+	}
+
+	items := make([]model.RegistryItem, 0, len(rows))
+	for i, row := range rows {
+		if row.ExternalID == "" {
+			return nil, fmt.Errorf("registry row %v: external_id is required", i+1)
+		}
+
+		status, err := registryStatus(row.Status)
+		if err != nil {
+			return nil, fmt.Errorf("registry row %v: %w", i+1, err)
+		}
+
+		items = append(items, model.RegistryItem{
+			ExternalID: row.ExternalID,
+			Currency:   strings.ToUpper(row.Currency),
+			Amount:     convert.BaseToCents(row.Amount),
+			Status:     status,
+		})
+	}
+	return items, nil
+}
+
+func registryStatus(status string) (model.OperationExternalStatus, error) {
+	switch strings.ToLower(status) {
+	case "success", "succeeded":
+		return model.OperationExternalStatusSuccess, nil
+	case "failed", "canceled", "cancelled":
+		return model.OperationExternalStatusFailed, nil
+	case "pending", "waiting_for_capture":
+		return model.OperationExternalStatusPending, nil
+	default:
+		return "", fmt.Errorf("unresolved registry status: %v", status)
+	}
+}
@@ -0,0 +1,35 @@
+package reconciliation
+
+import (
+	"context"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type EngineClient interface {
+	Reconcile(ctx context.Context, data model.ReconcileData) (*model.ReconciliationRun, error)
+	ReconciliationRuns(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error)
+	GetReconciliationRun(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error)
+}
+
+type Service struct {
+	engineClient EngineClient
+}
+
+func NewService(engineClient EngineClient) *Service {
+	return &Service{
+		engineClient: engineClient,
+	}
+}
+
+func (s *Service) Reconcile(ctx context.Context, data model.ReconcileData) (*model.ReconciliationRun, error) {
+	return s.engineClient.Reconcile(ctx, data)
+}
+
+func (s *Service) Runs(ctx context.Context, externalSystem string, merchantID *string) ([]*model.ReconciliationRun, error) {
+	return s.engineClient.ReconciliationRuns(ctx, externalSystem, merchantID)
+}
+
+func (s *Service) GetRun(ctx context.Context, id int64, merchantID *string) (*model.ReconciliationRun, error) {
+	return s.engineClient.GetReconciliationRun(ctx, id, merchantID)
+}
@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmrrwnxtsn/ecomway/internal/pkg/model"
+)
+
+type EngineClient interface {
+	SchedulerTasks(ctx context.Context) ([]*model.SchedulerTask, error)
+	PauseSchedulerTask(ctx context.Context, name string) error
+	ResumeSchedulerTask(ctx context.Context, name string) error
+	TriggerSchedulerTask(ctx context.Context, name string) error
+	UpdateSchedulerTask(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error
+	SchedulerTaskRuns(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error)
+}
+
+type Service struct {
+	engineClient EngineClient
+}
+
+func NewService(engineClient EngineClient) *Service {
+	return &Service{
+		engineClient: engineClient,
+	}
+}
+
+func (s *Service) Tasks(ctx context.Context) ([]*model.SchedulerTask, error) {
+	return s.engineClient.SchedulerTasks(ctx)
+}
+
+func (s *Service) Pause(ctx context.Context, name string) error {
+	return s.engineClient.PauseSchedulerTask(ctx, name)
+}
+
+func (s *Service) Resume(ctx context.Context, name string) error {
+	return s.engineClient.ResumeSchedulerTask(ctx, name)
+}
+
+func (s *Service) Trigger(ctx context.Context, name string) error {
+	return s.engineClient.TriggerSchedulerTask(ctx, name)
+}
+
+func (s *Service) Update(ctx context.Context, name string, interval *time.Duration, operationBatchSize *int64) error {
+	return s.engineClient.UpdateSchedulerTask(ctx, name, interval, operationBatchSize)
+}
+
+func (s *Service) Runs(ctx context.Context, name string, maxCount int64) ([]*model.SchedulerTaskRun, error) {
+	return s.engineClient.SchedulerTaskRuns(ctx, name, maxCount)
+}
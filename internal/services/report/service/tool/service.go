@@ -7,9 +7,9 @@ import (
 )
 
 type EngineClient interface {
-	AvailableTools(ctx context.Context, userID string) ([]*model.Tool, error)
-	RecoverTool(ctx context.Context, id string, userID string, externalMethod string) error
-	RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error
+	AvailableTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error)
+	RecoverTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error
+	RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error
 }
 
 type Service struct {
@@ -22,14 +22,14 @@ func NewService(engineClient EngineClient) *Service {
 	}
 }
 
-func (s *Service) AllTools(ctx context.Context, userID string) ([]*model.Tool, error) {
-	return s.engineClient.AvailableTools(ctx, userID)
+func (s *Service) AllTools(ctx context.Context, merchantID, userID string) ([]*model.Tool, error) {
+	return s.engineClient.AvailableTools(ctx, merchantID, userID)
 }
 
-func (s *Service) RecoverTool(ctx context.Context, id string, userID string, externalMethod string) error {
-	return s.engineClient.RecoverTool(ctx, id, userID, externalMethod)
+func (s *Service) RecoverTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error {
+	return s.engineClient.RecoverTool(ctx, id, merchantID, userID, externalMethod, audit)
 }
 
-func (s *Service) RemoveTool(ctx context.Context, id string, userID string, externalMethod string) error {
-	return s.engineClient.RemoveTool(ctx, id, userID, externalMethod)
+func (s *Service) RemoveTool(ctx context.Context, id string, merchantID, userID string, externalMethod string, audit model.AuditData) error {
+	return s.engineClient.RemoveTool(ctx, id, merchantID, userID, externalMethod, audit)
 }